@@ -1,6 +1,85 @@
 import fs from 'fs';
 import path from 'path';
-import { printSchema } from 'graphql';
+import { astFromValue, print, printSchema } from 'graphql';
 import { getRootSchema } from '../src';
 
-fs.writeFileSync(path.resolve(__dirname, '../../schema/schema.graphql'), printSchema(getRootSchema()));
+// printSchema does not print the directives applied to the fields, so the directives declared in the field extensions as
+// { directives: { directiveName: { argumentName: value } } } are appended to the printed field definitions
+const printAppliedDirectives = (schema, typeName, field) =>
+	Object.entries((field.extensions && field.extensions.directives) || {})
+		.map(([name, args]) => {
+			const directive = schema.getDirective(name);
+			if (!directive) {
+				throw new Error(`Unknown directive @${name} applied to ${typeName}.${field.name}`);
+			}
+
+			const printedArgs = directive.args
+				.filter((arg) => args[arg.name] !== undefined)
+				.map((arg) => `${arg.name}: ${print(astFromValue(args[arg.name], arg.type))}`);
+
+			return printedArgs.length > 0 ? ` @${name}(${printedArgs.join(', ')})` : ` @${name}`;
+		})
+		.join('');
+
+const printSchemaWithAppliedDirectives = (schema) => {
+	let type = null;
+	let fieldWithArgs = null;
+	let inBlockString = false;
+
+	return printSchema(schema)
+		.split('\n')
+		.map((line) => {
+			const trimmedLine = line.trim();
+
+			if (inBlockString) {
+				inBlockString = !trimmedLine.endsWith('"""');
+
+				return line;
+			}
+
+			if (trimmedLine.startsWith('"""')) {
+				inBlockString = trimmedLine === '"""' || !trimmedLine.endsWith('"""');
+
+				return line;
+			}
+
+			if (!type) {
+				const match = line.match(/^(?:type|interface) (\w+)/);
+				if (match) {
+					type = schema.getType(match[1]);
+				}
+
+				return line;
+			}
+
+			if (line === '}') {
+				type = null;
+
+				return line;
+			}
+
+			if (fieldWithArgs && line.startsWith('  ): ')) {
+				const field = fieldWithArgs;
+				fieldWithArgs = null;
+
+				return line + printAppliedDirectives(schema, type.name, field);
+			}
+
+			const match = line.match(/^ {2}(\w+)(\(?)/);
+			if (!match || fieldWithArgs) {
+				return line;
+			}
+
+			const field = type.getFields()[match[1]];
+			if (match[2] && line.endsWith('(')) {
+				fieldWithArgs = field;
+
+				return line;
+			}
+
+			return line + printAppliedDirectives(schema, type.name, field);
+		})
+		.join('\n');
+};
+
+fs.writeFileSync(path.resolve(__dirname, '../../schema/schema.graphql'), printSchemaWithAppliedDirectives(getRootSchema()));
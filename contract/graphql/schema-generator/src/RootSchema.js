@@ -1,10 +1,14 @@
-import { GraphQLSchema } from 'graphql';
+import { GraphQLSchema, specifiedDirectives } from 'graphql';
+import { CacheControlDirective, RequiresScopeDirective } from './directive';
 import { RootMutation } from './mutation';
+import { RootSubscription } from './subscription';
 import { RootQuery } from './type';
 
 export default function getRootSchema() {
 	return new GraphQLSchema({
 		query: RootQuery,
 		mutation: RootMutation,
+		subscription: RootSubscription,
+		directives: [...specifiedDirectives, RequiresScopeDirective, CacheControlDirective],
 	});
 }
@@ -0,0 +1,11 @@
+import { DirectiveLocation, GraphQLDirective, GraphQLInt, GraphQLNonNull } from 'graphql';
+
+export default new GraphQLDirective({
+	name: 'cacheControl',
+	description:
+		'Sets the number of seconds the response of a query selecting the field can be cached for. The response is cached for the smallest max-age of the selected fields. The fields without the directive inherit the max-age of their parent field.',
+	locations: [DirectiveLocation.FIELD_DEFINITION],
+	args: {
+		maxAge: { type: new GraphQLNonNull(GraphQLInt) },
+	},
+});
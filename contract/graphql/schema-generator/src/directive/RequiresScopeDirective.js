@@ -0,0 +1,11 @@
+import { DirectiveLocation, GraphQLDirective, GraphQLNonNull, GraphQLString } from 'graphql';
+
+export default new GraphQLDirective({
+	name: 'requiresScope',
+	description:
+		'Restricts the access to the field to the callers that have been granted the given scope. If the caller has not been granted the scope, the field resolves to null with a FORBIDDEN error that reports the required scope, and the rest of the operation is still executed.',
+	locations: [DirectiveLocation.FIELD_DEFINITION],
+	args: {
+		scope: { type: new GraphQLNonNull(GraphQLString) },
+	},
+});
@@ -0,0 +1,2 @@
+export { default as RequiresScopeDirective } from './RequiresScopeDirective';
+export { default as CacheControlDirective } from './CacheControlDirective';
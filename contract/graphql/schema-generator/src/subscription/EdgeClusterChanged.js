@@ -0,0 +1,10 @@
+import { GraphQLNonNull, GraphQLID } from 'graphql';
+import { EdgeCluster } from '../type';
+
+export default {
+	type: new GraphQLNonNull(EdgeCluster),
+	description: 'Notifies every time the edge cluster or its provision details change',
+	args: {
+		edgeClusterID: { type: new GraphQLNonNull(GraphQLID) },
+	},
+};
@@ -0,0 +1,10 @@
+import { GraphQLNonNull, GraphQLID, GraphQLList } from 'graphql';
+import EdgeClusterNode from '../type/EdgeClusterNode';
+
+export default {
+	type: new GraphQLNonNull(new GraphQLList(new GraphQLNonNull(EdgeClusterNode))),
+	description: 'Notifies every time the edge cluster nodes change',
+	args: {
+		edgeClusterID: { type: new GraphQLNonNull(GraphQLID) },
+	},
+};
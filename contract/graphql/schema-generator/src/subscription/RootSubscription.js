@@ -0,0 +1,11 @@
+import { GraphQLObjectType } from 'graphql';
+import edgeClusterChanged from './EdgeClusterChanged';
+import edgeClusterNodesChanged from './EdgeClusterNodesChanged';
+
+export default new GraphQLObjectType({
+	name: 'Subscription',
+	fields: {
+		edgeClusterChanged,
+		edgeClusterNodesChanged,
+	},
+});
@@ -0,0 +1 @@
+export { default as RootSubscription } from './RootSubscription';
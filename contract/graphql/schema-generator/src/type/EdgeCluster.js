@@ -6,6 +6,10 @@ import EdgeClusterType from './EdgeClusterType';
 import EdgeClusterNode from './EdgeClusterNode';
 import EdgeClusterPod from './EdgeClusterPod';
 import EdgeClusterService from './EdgeClusterService';
+import EdgeClusterNamespace from './EdgeClusterNamespace';
+import EdgeClusterDeployment from './EdgeClusterDeployment';
+import EdgeClusterEvent from './EdgeClusterEvent';
+import EdgeClusterConfigMap from './EdgeClusterConfigMap';
 
 export default new GraphQLObjectType({
 	name: 'EdgeCluster',
@@ -13,13 +17,18 @@ export default new GraphQLObjectType({
 	fields: {
 		id: { type: new GraphQLNonNull(GraphQLID), description: 'The unique edge cluster ID' },
 		name: { type: new GraphQLNonNull(GraphQLString), description: 'The edge cluster name' },
-		clusterSecret: { type: new GraphQLNonNull(GraphQLString), description: 'The cluster secrect value' },
+		clusterSecret: {
+			type: GraphQLString,
+			description: 'The cluster secrect value',
+			extensions: { directives: { requiresScope: { scope: 'edgecluster:secrets:read' }, cacheControl: { maxAge: 0 } } },
+		},
 		clusterType: { type: new GraphQLNonNull(EdgeClusterType), description: 'The cluster type' },
 		project: { type: new GraphQLNonNull(Project), description: 'The project that owns the edge cluster' },
 		provisionDetails: { type: new GraphQLNonNull(ProvisionDetails), description: 'The edge cluster provision details' },
 		nodes: {
 			type: new GraphQLNonNull(new GraphQLList(new GraphQLNonNull(EdgeClusterNode))),
 			description: 'The list of edge cluster nodes details',
+			extensions: { directives: { cacheControl: { maxAge: 10 } } },
 		},
 		pods: {
 			type: new GraphQLNonNull(new GraphQLList(new GraphQLNonNull(EdgeClusterPod))),
@@ -28,6 +37,7 @@ export default new GraphQLObjectType({
 				nodeName: { type: GraphQLString },
 				namespace: { type: GraphQLString },
 			},
+			extensions: { directives: { cacheControl: { maxAge: 10 } } },
 		},
 		services: {
 			type: new GraphQLNonNull(new GraphQLList(new GraphQLNonNull(EdgeClusterService))),
@@ -35,6 +45,40 @@ export default new GraphQLObjectType({
 			args: {
 				namespace: { type: GraphQLString },
 			},
+			extensions: { directives: { cacheControl: { maxAge: 10 } } },
+		},
+		namespaces: {
+			type: new GraphQLNonNull(new GraphQLList(new GraphQLNonNull(EdgeClusterNamespace))),
+			description:
+				'The list of edge cluster namespaces read directly from the edge cluster Kubernetes API. A list truncated to the configured maximum number of objects is reported with a LIST_TRUNCATED warning in the response extensions',
+			extensions: { directives: { cacheControl: { maxAge: 10 } } },
+		},
+		deployments: {
+			type: new GraphQLNonNull(new GraphQLList(new GraphQLNonNull(EdgeClusterDeployment))),
+			description:
+				'The list of edge cluster deployments read directly from the edge cluster Kubernetes API. A list truncated to the configured maximum number of objects is reported with a LIST_TRUNCATED warning in the response extensions',
+			args: {
+				namespace: { type: GraphQLString },
+			},
+			extensions: { directives: { cacheControl: { maxAge: 10 } } },
+		},
+		events: {
+			type: new GraphQLNonNull(new GraphQLList(new GraphQLNonNull(EdgeClusterEvent))),
+			description:
+				'The list of edge cluster events read directly from the edge cluster Kubernetes API. A list truncated to the configured maximum number of objects is reported with a LIST_TRUNCATED warning in the response extensions',
+			args: {
+				namespace: { type: GraphQLString },
+			},
+			extensions: { directives: { cacheControl: { maxAge: 10 } } },
+		},
+		configMaps: {
+			type: new GraphQLNonNull(new GraphQLList(new GraphQLNonNull(EdgeClusterConfigMap))),
+			description:
+				'The list of edge cluster config maps read directly from the edge cluster Kubernetes API, only the config map names are returned. A list truncated to the configured maximum number of objects is reported with a LIST_TRUNCATED warning in the response extensions',
+			args: {
+				namespace: { type: GraphQLString },
+			},
+			extensions: { directives: { cacheControl: { maxAge: 10 } } },
 		},
 	},
 	interfaces: [NodeInterface],
@@ -0,0 +1,13 @@
+import { GraphQLObjectType, GraphQLNonNull } from 'graphql';
+import ObjectMeta from './ObjectMeta';
+
+export default new GraphQLObjectType({
+	name: 'EdgeClusterConfigMap',
+	description: 'Contains information about the edge cluster config map',
+	fields: {
+		metadata: {
+			type: new GraphQLNonNull(ObjectMeta),
+			description: 'The config map metadata',
+		},
+	},
+});
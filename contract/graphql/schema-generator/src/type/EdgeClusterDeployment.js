@@ -0,0 +1,29 @@
+import { GraphQLInt, GraphQLObjectType, GraphQLNonNull } from 'graphql';
+import ObjectMeta from './ObjectMeta';
+
+export default new GraphQLObjectType({
+	name: 'EdgeClusterDeployment',
+	description: 'Contains information about the edge cluster deployment',
+	fields: {
+		metadata: {
+			type: new GraphQLNonNull(ObjectMeta),
+			description: 'The deployment metadata',
+		},
+		replicas: {
+			type: new GraphQLNonNull(GraphQLInt),
+			description: 'The number of desired pods',
+		},
+		readyReplicas: {
+			type: new GraphQLNonNull(GraphQLInt),
+			description: 'The number of pods targeted by the deployment with a ready condition',
+		},
+		availableReplicas: {
+			type: new GraphQLNonNull(GraphQLInt),
+			description: 'The number of pods targeted by the deployment that are available',
+		},
+		updatedReplicas: {
+			type: new GraphQLNonNull(GraphQLInt),
+			description: 'The number of pods targeted by the deployment that have the desired template spec',
+		},
+	},
+});
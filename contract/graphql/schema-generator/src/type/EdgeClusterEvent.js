@@ -0,0 +1,38 @@
+import { GraphQLInt, GraphQLObjectType, GraphQLNonNull, GraphQLString } from 'graphql';
+import ObjectMeta from './ObjectMeta';
+import ObjectReference from './ObjectReference';
+
+export default new GraphQLObjectType({
+	name: 'EdgeClusterEvent',
+	description: 'Contains information about the edge cluster event',
+	fields: {
+		metadata: {
+			type: new GraphQLNonNull(ObjectMeta),
+			description: 'The event metadata',
+		},
+		type: {
+			type: new GraphQLNonNull(GraphQLString),
+			description: 'The type of the event, either Normal or Warning',
+		},
+		reason: {
+			type: new GraphQLNonNull(GraphQLString),
+			description: 'Unique, one-word, CamelCase reason for the event',
+		},
+		message: {
+			type: new GraphQLNonNull(GraphQLString),
+			description: 'Human-readable description of the event',
+		},
+		involvedObject: {
+			type: new GraphQLNonNull(ObjectReference),
+			description: 'The object the event is about',
+		},
+		count: {
+			type: new GraphQLNonNull(GraphQLInt),
+			description: 'The number of times the event has occurred',
+		},
+		lastTimestamp: {
+			type: GraphQLString,
+			description: 'The time the most recent occurrence of the event was recorded',
+		},
+	},
+});
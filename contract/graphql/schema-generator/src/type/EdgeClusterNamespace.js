@@ -0,0 +1,17 @@
+import { GraphQLObjectType, GraphQLNonNull, GraphQLString } from 'graphql';
+import ObjectMeta from './ObjectMeta';
+
+export default new GraphQLObjectType({
+	name: 'EdgeClusterNamespace',
+	description: 'Contains information about the edge cluster namespace',
+	fields: {
+		metadata: {
+			type: new GraphQLNonNull(ObjectMeta),
+			description: 'The namespace metadata',
+		},
+		phase: {
+			type: new GraphQLNonNull(GraphQLString),
+			description: 'The current lifecycle phase of the namespace, either Active or Terminating',
+		},
+	},
+});
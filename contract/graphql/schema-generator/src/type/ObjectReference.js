@@ -0,0 +1,11 @@
+import { GraphQLObjectType, GraphQLNonNull, GraphQLString } from 'graphql';
+
+export default new GraphQLObjectType({
+	name: 'ObjectReference',
+	description: 'Contains enough information to identify the referred edge cluster object',
+	fields: {
+		kind: { type: new GraphQLNonNull(GraphQLString), description: 'The kind of the referred object' },
+		name: { type: new GraphQLNonNull(GraphQLString), description: 'The name of the referred object' },
+		namespace: { type: new GraphQLNonNull(GraphQLString), description: 'The namespace of the referred object' },
+	},
+});
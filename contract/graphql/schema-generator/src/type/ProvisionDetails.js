@@ -13,6 +13,7 @@ export default new GraphQLObjectType({
 		kubeconfigContent: {
 			type: GraphQLString,
 			description: 'The provisioned edge cluster kubeconfig content',
+			extensions: { directives: { requiresScope: { scope: 'edgecluster:secrets:read' }, cacheControl: { maxAge: 0 } } },
 		},
 		ports: {
 			type: new GraphQLNonNull(new GraphQLList(new GraphQLNonNull(GraphQLInt))),
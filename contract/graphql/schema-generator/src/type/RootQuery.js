@@ -1,9 +1,26 @@
-import { GraphQLObjectType } from 'graphql';
+import { GraphQLID, GraphQLList, GraphQLNonNull, GraphQLObjectType } from 'graphql';
+import { NodeInterface } from '../interface';
 import UserType from './User';
 
 export default new GraphQLObjectType({
 	name: 'Query',
 	fields: {
-		user: { type: UserType },
+		user: { type: UserType, extensions: { directives: { cacheControl: { maxAge: 30 } } } },
+		node: {
+			type: NodeInterface,
+			description: 'Fetches the object with the given global ID. Returns null if the object does not exist.',
+			args: {
+				id: { type: new GraphQLNonNull(GraphQLID) },
+			},
+			extensions: { directives: { cacheControl: { maxAge: 30 } } },
+		},
+		nodes: {
+			type: new GraphQLNonNull(new GraphQLList(NodeInterface)),
+			description: 'Fetches the objects with the given global IDs. The objects that do not exist are returned as null, and so are the objects that fail to load, together with an error reported at their id. The number of IDs is bounded by the maximum list size.',
+			args: {
+				ids: { type: new GraphQLNonNull(new GraphQLList(new GraphQLNonNull(GraphQLID))) },
+			},
+			extensions: { directives: { cacheControl: { maxAge: 30 } } },
+		},
 	},
 });
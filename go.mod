@@ -5,6 +5,7 @@ go 1.16
 require (
 	github.com/decentralized-cloud/edge-cluster v0.10.2
 	github.com/decentralized-cloud/project v0.8.4
+	github.com/fasthttp/websocket v1.4.3
 	github.com/friendsofgo/graphiql v0.2.2
 	github.com/go-kit/kit v0.10.0
 	github.com/gobuffalo/envy v1.9.0 // indirect
@@ -12,16 +13,30 @@ require (
 	github.com/gobuffalo/packr v1.30.1
 	github.com/golang/mock v1.6.0
 	github.com/graph-gophers/graphql-go v1.1.0
-	github.com/lucsky/cuid v1.2.0
+	github.com/grpc-ecosystem/go-grpc-prometheus v1.2.0
+	github.com/lestrrat-go/jwx v1.2.1
 	github.com/micro-business/go-core v0.6.2
 	github.com/prometheus/client_golang v1.11.0
 	github.com/rogpeppe/go-internal v1.8.0 // indirect
 	github.com/savsgio/atreugo/v11 v11.7.2
+	github.com/sony/gobreaker v0.5.0
 	github.com/spf13/cobra v1.1.3
 	github.com/thoas/go-funk v0.8.0
 	github.com/valyala/fasthttp v1.26.0
+	github.com/vektah/gqlparser/v2 v2.2.0
+	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.25.0
+	go.opentelemetry.io/otel v1.0.1
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.0.1
+	go.opentelemetry.io/otel/sdk v1.0.1
+	go.opentelemetry.io/otel/trace v1.0.1
+	go.opentelemetry.io/proto/otlp v0.9.0
 	go.uber.org/zap v1.17.0
 	golang.org/x/crypto v0.0.0-20210616213533-5ff15b29337e // indirect
 	golang.org/x/sys v0.0.0-20210616094352-59db8d763f22 // indirect
-	google.golang.org/grpc v1.38.0
+	google.golang.org/grpc v1.41.0
+	google.golang.org/protobuf v1.27.1
+	gopkg.in/yaml.v2 v2.4.0
+	k8s.io/api v0.21.2
+	k8s.io/apimachinery v0.21.2
+	k8s.io/client-go v0.21.2
 )
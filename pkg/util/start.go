@@ -2,13 +2,29 @@
 package util
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
+	"syscall"
 
+	"github.com/decentralized-cloud/api-gateway/services/audit"
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
 	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"github.com/decentralized-cloud/api-gateway/services/dataloader"
 	"github.com/decentralized-cloud/api-gateway/services/endpoint"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	"github.com/decentralized-cloud/api-gateway/services/graphql"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	"github.com/decentralized-cloud/api-gateway/services/health"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/decentralized-cloud/api-gateway/services/kubeclient"
+	"github.com/decentralized-cloud/api-gateway/services/persistedquery"
+	"github.com/decentralized-cloud/api-gateway/services/policy"
+	"github.com/decentralized-cloud/api-gateway/services/ratelimit"
+	"github.com/decentralized-cloud/api-gateway/services/responsecache"
+	"github.com/decentralized-cloud/api-gateway/services/tracing"
 	"github.com/decentralized-cloud/api-gateway/services/transport/https"
 	"github.com/micro-business/go-core/gokit/middleware"
 	"go.uber.org/zap"
@@ -17,6 +33,14 @@ import (
 var configurationService configuration.ConfigurationContract
 var endpointCreatorService endpoint.EndpointCreatorContract
 var middlewareProviderService middleware.MiddlewareProviderContract
+var identityService identity.IdentityContract
+var projectClientService project.ProjectClientContract
+var edgeClusterClientService edgecluster.EdgeClusterClientContract
+var healthCheckService health.HealthCheckContract
+var tracingService tracing.TracingContract
+var persistedQueryService persistedquery.PersistedQueryContract
+var rateLimitService ratelimit.RateLimitContract
+var auditSink audit.AuditSinkContract
 
 // StartService setups all dependecies required to start the API Gateway service and
 // start the service
@@ -39,14 +63,20 @@ func StartService() {
 		logger,
 		configurationService,
 		endpointCreatorService,
-		middlewareProviderService)
+		middlewareProviderService,
+		identityService,
+		projectClientService,
+		edgeClusterClientService,
+		healthCheckService,
+		persistedQueryService,
+		rateLimitService)
 	if err != nil {
 		logger.Fatal("Failed to create GraphQL transport service", zap.Error(err))
 	}
 
 	signalChan := make(chan os.Signal, 1)
 	cleanupDone := make(chan struct{})
-	signal.Notify(signalChan, os.Interrupt)
+	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
 		if serviceErr := httpsTransportService.Start(); serviceErr != nil {
@@ -55,13 +85,21 @@ func StartService() {
 	}()
 
 	go func() {
-		<-signalChan
-		logger.Info("Received an interrupt, stopping services...")
+		receivedSignal := <-signalChan
+		logger.Info("Received a signal, stopping services...", zap.String("signal", receivedSignal.String()))
 
 		if err := httpsTransportService.Stop(); err != nil {
 			logger.Error("Failed to stop HTTPS transport service", zap.Error(err))
 		}
 
+		if err := auditSink.Close(); err != nil {
+			logger.Error("Failed to flush the audit events", zap.Error(err))
+		}
+
+		if err := shutdownTracing(configurationService); err != nil {
+			logger.Error("Failed to export the remaining spans", zap.Error(err))
+		}
+
 		close(cleanupDone)
 	}()
 	<-cleanupDone
@@ -76,27 +114,147 @@ func setupDependencies(logger *zap.Logger) (err error) {
 		return
 	}
 
-	projectClientService, err := graphql.NewProjectClientService(configurationService)
+	if tracingService, err = tracing.NewOtlpTracingService(logger, configurationService); err != nil {
+		return
+	}
+
+	if identityService, err = identity.NewJwtIdentityService(configurationService); err != nil {
+		return
+	}
+
+	if projectClientService, err = graphql.NewProjectClientService(configurationService); err != nil {
+		return
+	}
+
+	if edgeClusterClientService, err = graphql.NewEdgeClusterClientService(configurationService); err != nil {
+		return
+	}
+
+	if healthCheckService, err = health.NewGrpcHealthCheckService(
+		configurationService,
+		projectClientService,
+		edgeClusterClientService); err != nil {
+		return
+	}
+
+	dataLoaderFactory, err := dataloader.NewGrpcDataLoaderFactory(
+		configurationService,
+		projectClientService,
+		edgeClusterClientService)
+	if err != nil {
+		return
+	}
+
+	fieldAuthorizationService, err := authorization.NewDirectiveFieldAuthorizationService(logger)
+	if err != nil {
+		return
+	}
+
+	roleBindingStore, err := policy.NewFileRoleBindingStore(logger, configurationService)
+	if err != nil {
+		return
+	}
+
+	policyEngine, err := policy.NewRoleBindingPolicyEngine(roleBindingStore)
+	if err != nil {
+		return
+	}
+
+	projectAuthorizationService, err := authorization.NewPolicyProjectAuthorizationService(logger, policyEngine)
+	if err != nil {
+		return
+	}
+
+	globalIDService, err := globalid.NewBase64GlobalIDService(configurationService)
 	if err != nil {
 		return
 	}
 
-	edgeClusterClientService, err := graphql.NewEdgeClusterClientService(configurationService)
+	if auditSink, err = audit.NewAuditSink(logger, configurationService); err != nil {
+		return
+	}
+
+	auditService, err := audit.NewAuditService(logger, configurationService, globalIDService, auditSink)
+	if err != nil {
+		return
+	}
+
+	kubeClientService, err := kubeclient.NewCachedKubeClientService(configurationService)
 	if err != nil {
 		return
 	}
 
 	resolverCreator, err := graphql.NewResolverCreator(
 		logger,
+		configurationService,
 		projectClientService,
-		edgeClusterClientService)
+		edgeClusterClientService,
+		dataLoaderFactory,
+		fieldAuthorizationService,
+		projectAuthorizationService,
+		globalIDService,
+		auditService,
+		kubeClientService)
+	if err != nil {
+		return
+	}
+
+	persistedQueryStore, err := persistedquery.NewLruPersistedQueryStore(configurationService)
+	if err != nil {
+		return
+	}
+
+	if persistedQueryService, err = persistedquery.NewPersistedQueryService(
+		logger,
+		configurationService,
+		persistedQueryStore); err != nil {
+		return
+	}
+
+	responseCacheStore, err := responsecache.NewLruResponseCacheStore(configurationService)
+	if err != nil {
+		return
+	}
+
+	responseCacheService, err := responsecache.NewResponseCacheService(
+		logger,
+		configurationService,
+		globalIDService,
+		responseCacheStore)
+	if err != nil {
+		return
+	}
+
+	if endpointCreatorService, err = endpoint.NewEndpointCreatorService(
+		logger,
+		configurationService,
+		resolverCreator,
+		dataLoaderFactory,
+		persistedQueryService,
+		responseCacheService); err != nil {
+		return
+	}
+
+	rateLimitStore, err := ratelimit.NewInMemoryRateLimitStore()
 	if err != nil {
 		return
 	}
 
-	if endpointCreatorService, err = endpoint.NewEndpointCreatorService(resolverCreator); err != nil {
+	if rateLimitService, err = ratelimit.NewTokenBucketRateLimitService(configurationService, rateLimitStore); err != nil {
 		return
 	}
 
 	return
 }
+
+func shutdownTracing(configurationService configuration.ConfigurationContract) error {
+	shutdownTimeout, err := configurationService.GetShutdownTimeout()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return tracingService.Shutdown(ctx)
+}
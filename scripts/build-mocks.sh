@@ -22,3 +22,20 @@ docker create --name extract-mock-builder mock-builder
 docker cp extract-mock-builder:/src/services/transport/mock/mock-contract.go ./services/transport/mock/mock-contract.go
 docker cp extract-mock-builder:/src/services/configuration/mock/mock-contract.go ./services/configuration/mock/mock-contract.go
 docker cp extract-mock-builder:/src/services/endpoint/mock/mock-contract.go ./services/endpoint/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/identity/mock/mock-contract.go ./services/identity/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/certificate/mock/mock-contract.go ./services/certificate/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/health/mock/mock-contract.go ./services/health/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/dataloader/mock/mock-contract.go ./services/dataloader/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/querylimit/mock/mock-contract.go ./services/querylimit/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/persistedquery/mock/mock-contract.go ./services/persistedquery/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/authorization/mock/mock-contract.go ./services/authorization/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/policy/mock/mock-contract.go ./services/policy/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/tracing/mock/mock-contract.go ./services/tracing/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/globalid/mock/mock-contract.go ./services/globalid/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/ratelimit/mock/mock-contract.go ./services/ratelimit/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/responsecache/mock/mock-contract.go ./services/responsecache/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/audit/mock/mock-contract.go ./services/audit/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/kubeclient/mock/mock-contract.go ./services/kubeclient/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/warning/mock/mock-contract.go ./services/warning/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/graphql/types/project/mock/mock-project-client-contract.go ./services/graphql/types/project/mock/mock-project-client-contract.go
+docker cp extract-mock-builder:/src/services/graphql/types/edgecluster/mock/mock-edge-cluster-client-contract.go ./services/graphql/types/edgecluster/mock/mock-edge-cluster-client-contract.go
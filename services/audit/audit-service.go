@@ -0,0 +1,176 @@
+// Package audit implements the audit log of the mutations and the sinks the audit events are written to
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+type auditService struct {
+	logger          *zap.Logger
+	globalIDService globalid.GlobalIDContract
+	sink            AuditSinkContract
+	redactedFields  map[string]bool
+}
+
+// NewAuditService creates new instance of the auditService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// globalIDService: Mandatory. Reference to the service that decodes the global identifiers of the target objects
+// sink: Mandatory. Reference to the sink the audit events are written to
+// Returns the new service or error if something goes wrong
+func NewAuditService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	globalIDService globalid.GlobalIDContract,
+	sink AuditSinkContract) (AuditContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
+	if sink == nil {
+		return nil, commonErrors.NewArgumentNilError("sink", "sink is required")
+	}
+
+	fields, err := configurationService.GetAuditRedactedFields()
+	if err != nil {
+		return nil, err
+	}
+
+	redactedFields := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		redactedFields[strings.ToLower(field)] = true
+	}
+
+	return &auditService{
+		logger:          logger.Named("audit"),
+		globalIDService: globalIDService,
+		sink:            sink,
+		redactedFields:  redactedFields,
+	}, nil
+}
+
+// RecordMutation writes the audit event of the mutation attempt to the configured sink. The values of the
+// configured input fields are redacted. The events that can not be written are logged instead.
+// ctx: Mandatory. Reference to the context
+// mutation: Mandatory. The details of the mutation attempt
+func (service *auditService) RecordMutation(ctx context.Context, mutation Mutation) {
+	event := &Event{
+		Timestamp: time.Now().UTC(),
+		Operation: mutation.Operation,
+		Input:     service.redactInput(mutation.Input),
+		TargetIDs: make([]string, 0, len(mutation.TargetIDs)),
+		Result:    ResultSuccess,
+		LatencyMs: float64(mutation.Latency) / float64(time.Millisecond),
+	}
+
+	if principal, ok := identity.FromContext(ctx); ok {
+		event.Principal = Principal{Subject: principal.Subject, Email: principal.Email}
+	}
+
+	for _, id := range mutation.TargetIDs {
+		if id == "" {
+			continue
+		}
+
+		if _, backendID, err := service.globalIDService.ParseGlobalID(id); err == nil {
+			event.TargetIDs = append(event.TargetIDs, backendID)
+		} else {
+			event.TargetIDs = append(event.TargetIDs, string(id))
+		}
+	}
+
+	if mutation.Err != nil {
+		event.Result = ResultFailure
+		event.ErrorCode = errorCode(mutation.Err)
+	}
+
+	// The audit event is written even if the request is cancelled, the mutation might have been applied already
+	if err := service.sink.Write(context.Background(), event); err != nil {
+		service.logger.Error(
+			"Failed to write the audit event",
+			zap.Error(err),
+			zap.String("subject", event.Principal.Subject),
+			zap.String("operation", event.Operation),
+			zap.Strings("targetIDs", event.TargetIDs),
+			zap.String("result", event.Result),
+			zap.String("errorCode", event.ErrorCode))
+	}
+}
+
+// redactInput converts the mutation input to the map of the GraphQL input field names to their values, replacing the
+// values of the redacted fields
+func (service *auditService) redactInput(input interface{}) map[string]interface{} {
+	encoded, err := json.Marshal(input)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return map[string]interface{}{}
+	}
+
+	return service.redactFields(fields)
+}
+
+func (service *auditService) redactFields(fields map[string]interface{}) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(fields))
+
+	for name, value := range fields {
+		// The input structs have no JSON tags, so the Go field names are converted to the GraphQL input field names
+		fieldName := lowerFirst(name)
+
+		switch {
+		case service.redactedFields[strings.ToLower(fieldName)]:
+			redacted[fieldName] = redactedValue
+		case isObject(value):
+			redacted[fieldName] = service.redactFields(value.(map[string]interface{}))
+		default:
+			redacted[fieldName] = value
+		}
+	}
+
+	return redacted
+}
+
+func errorCode(err error) string {
+	if extendedErr, ok := errortranslation.FromError(err).(interface{ Extensions() map[string]interface{} }); ok {
+		if code, ok := extendedErr.Extensions()["code"].(string); ok {
+			return code
+		}
+	}
+
+	return errortranslation.CodeInternal
+}
+
+func isObject(value interface{}) bool {
+	_, ok := value.(map[string]interface{})
+
+	return ok
+}
+
+func lowerFirst(name string) string {
+	first, size := utf8.DecodeRuneInString(name)
+
+	return string(unicode.ToLower(first)) + name[size:]
+}
@@ -0,0 +1,130 @@
+package audit_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/audit"
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/golang/mock/gomock"
+	"github.com/graph-gophers/graphql-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAuditSink keeps the audit events written to it
+type fakeAuditSink struct {
+	audit.AuditSinkContract
+	events []*audit.Event
+}
+
+func (sink *fakeAuditSink) Write(ctx context.Context, event *audit.Event) error {
+	sink.events = append(sink.events, event)
+
+	return nil
+}
+
+type credentialsInput struct {
+	Token    string
+	Username string
+}
+
+type mutationInput struct {
+	Name             string
+	ClientMutationId *string
+	Credentials      credentialsInput
+}
+
+func TestAuditService_RedactsTheConfiguredInputFields(t *testing.T) {
+	sink := &fakeAuditSink{}
+	service, _ := newAuditService(t, sink, []string{"TOKEN", "name"})
+	clientMutationID := "m1"
+
+	service.RecordMutation(context.Background(), audit.Mutation{
+		Operation: "createProject",
+		Input: mutationInput{
+			Name:             "secret project name",
+			ClientMutationId: &clientMutationID,
+			Credentials:      credentialsInput{Token: "secret token", Username: "user"},
+		},
+	})
+
+	if len(sink.events) != 1 {
+		t.Fatalf("expected a single audit event, got %d", len(sink.events))
+	}
+
+	expectedInput := map[string]interface{}{
+		"name":             "[REDACTED]",
+		"clientMutationId": "m1",
+		"credentials": map[string]interface{}{
+			"token":    "[REDACTED]",
+			"username": "user",
+		},
+	}
+
+	if !reflect.DeepEqual(sink.events[0].Input, expectedInput) {
+		t.Fatalf("expected the input %v, got %v", expectedInput, sink.events[0].Input)
+	}
+}
+
+func TestAuditService_RecordsTheMutationAttempt(t *testing.T) {
+	sink := &fakeAuditSink{}
+	service, globalIDService := newAuditService(t, sink, []string{})
+	ctx := identity.NewContext(context.Background(), &identity.Principal{Subject: "alice", Email: "alice@example.com"})
+
+	service.RecordMutation(ctx, audit.Mutation{
+		Operation: "deleteProject",
+		Input:     mutationInput{Name: "project"},
+		TargetIDs: []graphql.ID{globalIDService.ToGlobalID(globalid.TypeProject, "p1"), "", "raw-id"},
+		Latency:   1500 * time.Microsecond,
+		Err:       status.Error(codes.NotFound, "project not found"),
+	})
+
+	if len(sink.events) != 1 {
+		t.Fatalf("expected a single audit event, got %d", len(sink.events))
+	}
+
+	event := sink.events[0]
+
+	if event.Principal != (audit.Principal{Subject: "alice", Email: "alice@example.com"}) {
+		t.Fatalf("expected the caller to be recorded, got %v", event.Principal)
+	}
+
+	if !reflect.DeepEqual(event.TargetIDs, []string{"p1", "raw-id"}) {
+		t.Fatalf("expected the decoded target identifiers, got %v", event.TargetIDs)
+	}
+
+	if event.Result != audit.ResultFailure || event.ErrorCode != "NOT_FOUND" {
+		t.Fatalf("expected the failure to be recorded with the NOT_FOUND error code, got %s %s", event.Result, event.ErrorCode)
+	}
+
+	if event.LatencyMs != 1.5 {
+		t.Fatalf("expected the latency 1.5ms, got %v", event.LatencyMs)
+	}
+}
+
+func newAuditService(t *testing.T, sink audit.AuditSinkContract, redactedFields []string) (audit.AuditContract, globalid.GlobalIDContract) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetGraphQLRawIDs().Return(false, nil)
+	configurationService.EXPECT().GetAuditRedactedFields().Return(redactedFields, nil)
+
+	globalIDService, err := globalid.NewBase64GlobalIDService(configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service, err := audit.NewAuditService(zap.NewNop(), configurationService, globalIDService, sink)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return service, globalIDService
+}
@@ -0,0 +1,39 @@
+// Package audit implements the audit log of the mutations and the sinks the audit events are written to
+package audit
+
+import (
+	"fmt"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+// NewAuditSink creates the audit sink selected by the AUDIT_SINK configuration
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new sink or error if something goes wrong
+func NewAuditSink(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract) (AuditSinkContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	sink, err := configurationService.GetAuditSink()
+	if err != nil {
+		return nil, err
+	}
+
+	switch sink {
+	case SinkStdout:
+		return NewStdoutAuditSink()
+	case SinkFile:
+		return NewFileAuditSink(configurationService)
+	case SinkWebhook:
+		return NewWebhookAuditSink(logger, configurationService)
+	default:
+		return nil, commonErrors.NewUnknownError(
+			fmt.Sprintf("Unknown audit sink %s, expected %s, %s or %s", sink, SinkStdout, SinkFile, SinkWebhook))
+	}
+}
@@ -0,0 +1,87 @@
+// Package audit implements the audit log of the mutations and the sinks the audit events are written to
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+const (
+	// SinkStdout writes the audit events as JSON lines to the standard output
+	SinkStdout = "stdout"
+
+	// SinkFile writes the audit events as JSON lines to a file that is rotated by size
+	SinkFile = "file"
+
+	// SinkWebhook posts the audit events to a HTTP webhook
+	SinkWebhook = "webhook"
+
+	// ResultSuccess is reported when the mutation succeeded
+	ResultSuccess = "success"
+
+	// ResultFailure is reported when the mutation failed
+	ResultFailure = "failure"
+
+	// redactedValue replaces the values of the redacted input fields
+	redactedValue = "[REDACTED]"
+)
+
+// Mutation contains the details of the mutation attempt to audit
+type Mutation struct {
+	// Operation is the name of the mutation field, e.g. createProject
+	Operation string
+
+	// Input is the input argument of the mutation
+	Input interface{}
+
+	// TargetIDs are the global identifiers of the objects the mutation changed or tried to change
+	TargetIDs []graphql.ID
+
+	// Latency is the time taken to execute the mutation
+	Latency time.Duration
+
+	// Err is the error the mutation failed with, nil if the mutation succeeded
+	Err error
+}
+
+// Principal contains the details of the caller that sent the mutation
+type Principal struct {
+	Subject string `json:"subject"`
+	Email   string `json:"email,omitempty"`
+}
+
+// Event is the audit event written to the sink for every mutation attempt
+type Event struct {
+	Timestamp time.Time              `json:"timestamp"`
+	Principal Principal              `json:"principal"`
+	Operation string                 `json:"operation"`
+	Input     map[string]interface{} `json:"input"`
+	TargetIDs []string               `json:"targetIDs"`
+	Result    string                 `json:"result"`
+	ErrorCode string                 `json:"errorCode,omitempty"`
+	LatencyMs float64                `json:"latencyMs"`
+}
+
+// AuditContract declares the service that records the audit events of the mutations
+type AuditContract interface {
+	// RecordMutation writes the audit event of the mutation attempt to the configured sink. The values of the
+	// configured input fields are redacted. The events that can not be written are logged instead.
+	// ctx: Mandatory. Reference to the context
+	// mutation: Mandatory. The details of the mutation attempt
+	RecordMutation(ctx context.Context, mutation Mutation)
+}
+
+// AuditSinkContract declares the sink the audit events are written to
+type AuditSinkContract interface {
+	// Write writes the audit event to the sink
+	// ctx: Mandatory. Reference to the context
+	// event: Mandatory. The audit event
+	// Returns error if something goes wrong
+	Write(ctx context.Context, event *Event) error
+
+	// Close flushes the pending audit events and releases the resources held by the sink
+	// Returns error if something goes wrong
+	Close() error
+}
@@ -0,0 +1,177 @@
+// Package audit implements the audit log of the mutations and the sinks the audit events are written to
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// backupTimeFormat is the format of the time appended to the name of the rotated audit files, it sorts the rotated
+// files from the oldest to the newest
+const backupTimeFormat = "20060102T150405.000000000"
+
+type fileAuditSink struct {
+	lock       sync.Mutex
+	path       string
+	maxBytes   int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// NewFileAuditSink creates new instance of the fileAuditSink, setting up all dependencies and returns the instance.
+// The sink writes every audit event as a JSON line to the configured file. The file is rotated once it reaches the
+// configured size and only the configured number of the rotated files are kept.
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new sink or error if something goes wrong
+func NewFileAuditSink(configurationService configuration.ConfigurationContract) (AuditSinkContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	path, err := configurationService.GetAuditFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.Trim(path, " ") == "" {
+		return nil, commonErrors.NewUnknownError("AUDIT_FILE_PATH is required when the file audit sink is used")
+	}
+
+	maxBytes, err := configurationService.GetAuditFileMaxBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if maxBytes <= 0 {
+		return nil, commonErrors.NewUnknownError("AUDIT_FILE_MAX_BYTES must be greater than zero")
+	}
+
+	maxBackups, err := configurationService.GetAuditFileMaxBackups()
+	if err != nil {
+		return nil, err
+	}
+
+	sink := &fileAuditSink{
+		path:       path,
+		maxBytes:   int64(maxBytes),
+		maxBackups: maxBackups,
+	}
+
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+// Write writes the audit event to the sink
+// ctx: Mandatory. Reference to the context
+// event: Mandatory. The audit event
+// Returns error if something goes wrong
+func (sink *fileAuditSink) Write(ctx context.Context, event *Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	line = append(line, '\n')
+
+	sink.lock.Lock()
+	defer sink.lock.Unlock()
+
+	if sink.file == nil {
+		return commonErrors.NewUnknownError("The audit file is closed")
+	}
+
+	if sink.size > 0 && sink.size+int64(len(line)) > sink.maxBytes {
+		if err := sink.rotate(); err != nil {
+			return err
+		}
+	}
+
+	written, err := sink.file.Write(line)
+	sink.size += int64(written)
+
+	return err
+}
+
+// Close flushes the pending audit events and releases the resources held by the sink
+// Returns error if something goes wrong
+func (sink *fileAuditSink) Close() error {
+	sink.lock.Lock()
+	defer sink.lock.Unlock()
+
+	if sink.file == nil {
+		return nil
+	}
+
+	err := sink.file.Close()
+	sink.file = nil
+
+	return err
+}
+
+func (sink *fileAuditSink) open() error {
+	if err := os.MkdirAll(filepath.Dir(sink.path), 0700); err != nil {
+		return commonErrors.NewUnknownErrorWithError("Failed to create the audit file directory", err)
+	}
+
+	file, err := os.OpenFile(sink.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("Failed to open the audit file", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return commonErrors.NewUnknownErrorWithError("Failed to read the audit file size", err)
+	}
+
+	sink.file = file
+	sink.size = info.Size()
+
+	return nil
+}
+
+// rotate renames the current audit file, removes the oldest rotated files and opens a new audit file
+func (sink *fileAuditSink) rotate() error {
+	if err := sink.file.Close(); err != nil {
+		return err
+	}
+
+	sink.file = nil
+
+	backupPath := fmt.Sprintf("%s.%s", sink.path, time.Now().UTC().Format(backupTimeFormat))
+	if err := os.Rename(sink.path, backupPath); err != nil {
+		return commonErrors.NewUnknownErrorWithError("Failed to rotate the audit file", err)
+	}
+
+	backups, err := filepath.Glob(sink.path + ".*")
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(backups)
+
+	for len(backups) > sink.maxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			return commonErrors.NewUnknownErrorWithError("Failed to remove the rotated audit file", err)
+		}
+
+		backups = backups[1:]
+	}
+
+	return sink.open()
+}
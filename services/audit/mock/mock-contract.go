@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/audit/contract.go
+
+// Package mock_audit is a generated GoMock package.
+package mock_audit
+
+import (
+	context "context"
+	reflect "reflect"
+
+	audit "github.com/decentralized-cloud/api-gateway/services/audit"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditContract is a mock of AuditContract interface.
+type MockAuditContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditContractMockRecorder
+}
+
+// MockAuditContractMockRecorder is the mock recorder for MockAuditContract.
+type MockAuditContractMockRecorder struct {
+	mock *MockAuditContract
+}
+
+// NewMockAuditContract creates a new mock instance.
+func NewMockAuditContract(ctrl *gomock.Controller) *MockAuditContract {
+	mock := &MockAuditContract{ctrl: ctrl}
+	mock.recorder = &MockAuditContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditContract) EXPECT() *MockAuditContractMockRecorder {
+	return m.recorder
+}
+
+// RecordMutation mocks base method.
+func (m *MockAuditContract) RecordMutation(ctx context.Context, mutation audit.Mutation) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordMutation", ctx, mutation)
+}
+
+// RecordMutation indicates an expected call of RecordMutation.
+func (mr *MockAuditContractMockRecorder) RecordMutation(ctx, mutation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMutation", reflect.TypeOf((*MockAuditContract)(nil).RecordMutation), ctx, mutation)
+}
+
+// MockAuditSinkContract is a mock of AuditSinkContract interface.
+type MockAuditSinkContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditSinkContractMockRecorder
+}
+
+// MockAuditSinkContractMockRecorder is the mock recorder for MockAuditSinkContract.
+type MockAuditSinkContractMockRecorder struct {
+	mock *MockAuditSinkContract
+}
+
+// NewMockAuditSinkContract creates a new mock instance.
+func NewMockAuditSinkContract(ctrl *gomock.Controller) *MockAuditSinkContract {
+	mock := &MockAuditSinkContract{ctrl: ctrl}
+	mock.recorder = &MockAuditSinkContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditSinkContract) EXPECT() *MockAuditSinkContractMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockAuditSinkContract) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAuditSinkContractMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAuditSinkContract)(nil).Close))
+}
+
+// Write mocks base method.
+func (m *MockAuditSinkContract) Write(ctx context.Context, event *audit.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockAuditSinkContractMockRecorder) Write(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockAuditSinkContract)(nil).Write), ctx, event)
+}
@@ -0,0 +1,48 @@
+// Package audit implements the audit log of the mutations and the sinks the audit events are written to
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+)
+
+type stdoutAuditSink struct {
+	lock   sync.Mutex
+	writer io.Writer
+}
+
+// NewStdoutAuditSink creates new instance of the stdoutAuditSink, setting up all dependencies and returns the instance.
+// The sink writes every audit event as a JSON line to the standard output.
+// Returns the new sink or error if something goes wrong
+func NewStdoutAuditSink() (AuditSinkContract, error) {
+	return &stdoutAuditSink{
+		writer: os.Stdout,
+	}, nil
+}
+
+// Write writes the audit event to the sink
+// ctx: Mandatory. Reference to the context
+// event: Mandatory. The audit event
+// Returns error if something goes wrong
+func (sink *stdoutAuditSink) Write(ctx context.Context, event *Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	sink.lock.Lock()
+	defer sink.lock.Unlock()
+
+	_, err = sink.writer.Write(append(line, '\n'))
+
+	return err
+}
+
+// Close flushes the pending audit events and releases the resources held by the sink
+// Returns error if something goes wrong
+func (sink *stdoutAuditSink) Close() error {
+	return nil
+}
@@ -0,0 +1,202 @@
+// Package audit implements the audit log of the mutations and the sinks the audit events are written to
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// webhookQueueSize is the number of the audit events waiting to be posted to the webhook
+	webhookQueueSize = 1000
+
+	// webhookInitialBackoff is the time waited before the first retry, it doubles with every retry
+	webhookInitialBackoff = 500 * time.Millisecond
+)
+
+type webhookAuditSink struct {
+	logger     *zap.Logger
+	url        string
+	client     *http.Client
+	maxRetries int
+	lock       sync.Mutex
+	closed     bool
+	events     chan *Event
+	closing    chan struct{}
+	done       chan struct{}
+}
+
+// NewWebhookAuditSink creates new instance of the webhookAuditSink, setting up all dependencies and returns the instance.
+// The sink posts every audit event as a JSON object to the configured webhook. The events are posted in the background
+// so the mutations are not delayed by the webhook, and failed posts are retried with exponential backoff.
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new sink or error if something goes wrong
+func NewWebhookAuditSink(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract) (AuditSinkContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	url, err := configurationService.GetAuditWebhookURL()
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.Trim(url, " ") == "" {
+		return nil, commonErrors.NewUnknownError("AUDIT_WEBHOOK_URL is required when the webhook audit sink is used")
+	}
+
+	timeout, err := configurationService.GetAuditWebhookTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	maxRetries, err := configurationService.GetAuditWebhookMaxRetries()
+	if err != nil {
+		return nil, err
+	}
+
+	sink := &webhookAuditSink{
+		logger:     logger.Named("audit"),
+		url:        url,
+		client:     &http.Client{Timeout: timeout},
+		maxRetries: maxRetries,
+		events:     make(chan *Event, webhookQueueSize),
+		closing:    make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+
+	go sink.run()
+
+	return sink, nil
+}
+
+// Write queues the audit event to be posted to the webhook
+// ctx: Mandatory. Reference to the context
+// event: Mandatory. The audit event
+// Returns error if the queue is full or the sink is closed
+func (sink *webhookAuditSink) Write(ctx context.Context, event *Event) error {
+	sink.lock.Lock()
+	defer sink.lock.Unlock()
+
+	// The mutations that outlive the shutdown timeout write their events after the sink is closed, the audit service logs them instead
+	if sink.closed {
+		return commonErrors.NewUnknownError("The audit webhook sink is closed")
+	}
+
+	select {
+	case sink.events <- event:
+		return nil
+	default:
+		return commonErrors.NewUnknownError("The audit webhook queue is full")
+	}
+}
+
+// Close waits until the queued audit events are posted to the webhook. The audit events written after the sink is closed are rejected.
+// Returns error if something goes wrong
+func (sink *webhookAuditSink) Close() error {
+	sink.lock.Lock()
+	if !sink.closed {
+		sink.closed = true
+		close(sink.closing)
+	}
+	sink.lock.Unlock()
+
+	<-sink.done
+
+	return nil
+}
+
+// run posts the queued audit events until the sink is closed, then posts the events still in the queue and exits
+func (sink *webhookAuditSink) run() {
+	defer close(sink.done)
+
+	for {
+		select {
+		case event := <-sink.events:
+			sink.postOrLog(event)
+		case <-sink.closing:
+			for {
+				select {
+				case event := <-sink.events:
+					sink.postOrLog(event)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (sink *webhookAuditSink) postOrLog(event *Event) {
+	if err := sink.post(event); err != nil {
+		sink.logger.Error(
+			"Failed to post the audit event to the webhook",
+			zap.Error(err),
+			zap.String("subject", event.Principal.Subject),
+			zap.String("operation", event.Operation),
+			zap.Strings("targetIDs", event.TargetIDs),
+			zap.String("result", event.Result),
+			zap.String("errorCode", event.ErrorCode))
+	}
+}
+
+// post posts the audit event to the webhook, retrying the posts that fail because of network errors, rate limiting
+// or server errors
+func (sink *webhookAuditSink) post(event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	backoff := webhookInitialBackoff
+
+	for attempt := 0; ; attempt++ {
+		retryable, err := sink.send(body)
+		if err == nil {
+			return nil
+		}
+
+		if !retryable || attempt >= sink.maxRetries {
+			return err
+		}
+
+		sink.logger.Warn("Retrying to post the audit event to the webhook", zap.Error(err), zap.Int("attempt", attempt+1))
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (sink *webhookAuditSink) send(body []byte) (bool, error) {
+	response, err := sink.client.Post(sink.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return true, err
+	}
+
+	_ = response.Body.Close()
+
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+
+	retryable := response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError
+
+	return retryable, fmt.Errorf("the audit webhook responded with status code %d", response.StatusCode)
+}
@@ -0,0 +1,171 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/audit"
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+)
+
+// webhookAttempt contains the time an audit event was posted to the webhook and the posted event
+type webhookAttempt struct {
+	time  time.Time
+	event audit.Event
+}
+
+// fakeWebhook responds to the posts with the given status codes in order, and with 200 once they are used up
+type fakeWebhook struct {
+	mutex       sync.Mutex
+	statusCodes []int
+	attempts    []webhookAttempt
+}
+
+func (webhook *fakeWebhook) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	var event audit.Event
+	_ = json.NewDecoder(request.Body).Decode(&event)
+
+	webhook.mutex.Lock()
+	defer webhook.mutex.Unlock()
+
+	webhook.attempts = append(webhook.attempts, webhookAttempt{time: time.Now(), event: event})
+
+	statusCode := http.StatusOK
+	if len(webhook.statusCodes) > 0 {
+		statusCode = webhook.statusCodes[0]
+		webhook.statusCodes = webhook.statusCodes[1:]
+	}
+
+	writer.WriteHeader(statusCode)
+}
+
+func (webhook *fakeWebhook) getAttempts() []webhookAttempt {
+	webhook.mutex.Lock()
+	defer webhook.mutex.Unlock()
+
+	return webhook.attempts
+}
+
+func TestWebhookAuditSink_RetriesTheFailedPostsWithExponentialBackoff(t *testing.T) {
+	webhook := &fakeWebhook{statusCodes: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}}
+	postEvent(t, webhook, 2)
+
+	attempts := webhook.getAttempts()
+	if len(attempts) != 3 {
+		t.Fatalf("expected the event to be posted until the webhook accepts it, got %d attempts", len(attempts))
+	}
+
+	for _, attempt := range attempts {
+		if attempt.event.Operation != "createProject" || attempt.event.Principal.Subject != "alice" {
+			t.Fatalf("expected the audit event to be posted, got %v", attempt.event)
+		}
+	}
+
+	if backoff := attempts[1].time.Sub(attempts[0].time); backoff < 500*time.Millisecond {
+		t.Fatalf("expected the first retry to wait at least 500ms, waited %v", backoff)
+	}
+
+	if backoff := attempts[2].time.Sub(attempts[1].time); backoff < time.Second {
+		t.Fatalf("expected the backoff to double, waited %v", backoff)
+	}
+}
+
+func TestWebhookAuditSink_StopsRetrying(t *testing.T) {
+	tests := []struct {
+		name             string
+		statusCodes      []int
+		maxRetries       int
+		expectedAttempts int
+	}{
+		{name: "client error", statusCodes: []int{http.StatusBadRequest}, maxRetries: 2, expectedAttempts: 1},
+		{name: "max retries", statusCodes: []int{http.StatusInternalServerError, http.StatusBadGateway}, maxRetries: 1, expectedAttempts: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			webhook := &fakeWebhook{statusCodes: test.statusCodes}
+			postEvent(t, webhook, test.maxRetries)
+
+			if attempts := webhook.getAttempts(); len(attempts) != test.expectedAttempts {
+				t.Fatalf("expected %d attempts, got %d", test.expectedAttempts, len(attempts))
+			}
+		})
+	}
+}
+
+func TestWebhookAuditSink_RejectsTheEventsWrittenAfterClose(t *testing.T) {
+	webhook := &fakeWebhook{}
+	sink := newWebhookAuditSink(t, webhook, 0)
+
+	for i := 0; i < 3; i++ {
+		if err := sink.Write(context.Background(), &audit.Event{Operation: "createProject"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if attempts := webhook.getAttempts(); len(attempts) != 3 {
+		t.Fatalf("expected the queued events to be posted before the sink is closed, got %d posts", len(attempts))
+	}
+
+	if err := sink.Write(context.Background(), &audit.Event{Operation: "deleteProject"}); err == nil {
+		t.Fatal("expected the event written after the sink is closed to be rejected")
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newWebhookAuditSink creates a webhook sink that posts to the given webhook
+func newWebhookAuditSink(t *testing.T, webhook *fakeWebhook, maxRetries int) audit.AuditSinkContract {
+	t.Helper()
+
+	server := httptest.NewServer(webhook)
+	t.Cleanup(server.Close)
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetAuditWebhookURL().Return(server.URL, nil)
+	configurationService.EXPECT().GetAuditWebhookTimeout().Return(5*time.Second, nil)
+	configurationService.EXPECT().GetAuditWebhookMaxRetries().Return(maxRetries, nil)
+
+	sink, err := audit.NewWebhookAuditSink(zap.NewNop(), configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return sink
+}
+
+// postEvent writes an audit event to a webhook sink that posts to the given webhook, and waits until the sink is done
+func postEvent(t *testing.T, webhook *fakeWebhook, maxRetries int) {
+	t.Helper()
+
+	sink := newWebhookAuditSink(t, webhook, maxRetries)
+
+	event := &audit.Event{
+		Timestamp: time.Now().UTC(),
+		Principal: audit.Principal{Subject: "alice"},
+		Operation: "createProject",
+		Result:    audit.ResultSuccess,
+	}
+
+	if err := sink.Write(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,49 @@
+// Package authorization implements the services that authorize the access to the GraphQL fields and the actions performed on the projects
+package authorization
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/api-gateway/services/policy"
+)
+
+const (
+	// ErrorCodeForbidden is reported when the caller is not granted the access to the requested field or action
+	ErrorCodeForbidden = "FORBIDDEN"
+)
+
+// FieldAuthorizationContract declares the service that authorizes the access to the GraphQL fields using the
+// requirements declared on the schema fields
+type FieldAuthorizationContract interface {
+	// AuthorizeField verifies the principal attached to the context satisfies the requirements declared on the field. The
+	// resolvers of the restricted fields call it while the field is resolved, so a denied field resolves to null with the
+	// returned error while the rest of the operation is still executed.
+	// ctx: Mandatory. Reference to the context
+	// typeName: Mandatory. The name of the GraphQL type that declares the field
+	// fieldName: Mandatory. The name of the GraphQL field
+	// Returns error if the access to the field is denied
+	AuthorizeField(ctx context.Context, typeName string, fieldName string) error
+}
+
+// ProjectAuthorizationContract declares the service that authorizes the actions the caller performs on the projects
+type ProjectAuthorizationContract interface {
+	// AuthorizeProjectAction verifies the principal attached to the context is allowed to perform the action on the project.
+	// Denied actions are audit logged.
+	// ctx: Mandatory. Reference to the context
+	// projectID: Optional. The project unique identifier, empty if the action does not target an existing project
+	// action: Mandatory. The action the caller performs
+	// Returns error if the action is denied or something goes wrong
+	AuthorizeProjectAction(ctx context.Context, projectID string, action policy.Action) error
+
+	// GrantProjectOwnership grants the owner role on the newly created project to the principal attached to the context
+	// ctx: Mandatory. Reference to the context
+	// projectID: Mandatory. The project unique identifier
+	// Returns error if something goes wrong
+	GrantProjectOwnership(ctx context.Context, projectID string) error
+
+	// RevokeProjectRoles removes the roles granted to all the users on the deleted project
+	// ctx: Mandatory. Reference to the context
+	// projectID: Mandatory. The project unique identifier
+	// Returns error if something goes wrong
+	RevokeProjectRoles(ctx context.Context, projectID string) error
+}
@@ -0,0 +1,100 @@
+// Package authorization implements the services that authorize the access to the GraphQL fields and the actions performed on the projects
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/gobuffalo/packr"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.uber.org/zap"
+)
+
+// requiresScopeDirective is the name of the schema directive that declares the scope the caller must be granted to read the field
+const requiresScopeDirective = "requiresScope"
+
+type directiveFieldAuthorizationService struct {
+	logger         *zap.Logger
+	requiredScopes map[string]string
+}
+
+// NewDirectiveFieldAuthorizationService creates new instance of the directiveFieldAuthorizationService, setting up all dependencies and returns the instance.
+// The required scopes are read from the @requiresScope directives declared on the fields of the GraphQL schema.
+// logger: Mandatory. Reference to the logger service
+// Returns the new service or error if something goes wrong
+func NewDirectiveFieldAuthorizationService(logger *zap.Logger) (FieldAuthorizationContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	box := packr.NewBox("../../contract/graphql/schema")
+	schemaDocument, err := box.FindString("schema.graphql")
+	if err != nil {
+		return nil, err
+	}
+
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaDocument})
+	if gqlErr != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("Failed to load the GraphQL schema", gqlErr)
+	}
+
+	requiredScopes := map[string]string{}
+	for _, definition := range schema.Types {
+		for _, field := range definition.Fields {
+			directive := field.Directives.ForName(requiresScopeDirective)
+			if directive == nil {
+				continue
+			}
+
+			scope := directive.Arguments.ForName("scope")
+			if scope == nil || strings.Trim(scope.Value.Raw, " ") == "" {
+				return nil, commonErrors.NewUnknownError(fmt.Sprintf("The @%s directive on %s.%s requires the scope argument", requiresScopeDirective, definition.Name, field.Name))
+			}
+
+			requiredScopes[definition.Name+"."+field.Name] = scope.Value.Raw
+		}
+	}
+
+	return &directiveFieldAuthorizationService{
+		logger:         logger,
+		requiredScopes: requiredScopes,
+	}, nil
+}
+
+// AuthorizeField verifies the principal attached to the context satisfies the requirements declared on the field. The
+// resolvers of the restricted fields call it while the field is resolved, so a denied field resolves to null with the
+// returned error while the rest of the operation is still executed.
+// ctx: Mandatory. Reference to the context
+// typeName: Mandatory. The name of the GraphQL type that declares the field
+// fieldName: Mandatory. The name of the GraphQL field
+// Returns error if the access to the field is denied
+func (service *directiveFieldAuthorizationService) AuthorizeField(ctx context.Context, typeName string, fieldName string) error {
+	requiredScope, ok := service.requiredScopes[typeName+"."+fieldName]
+	if !ok {
+		return nil
+	}
+
+	principal, ok := identity.FromContext(ctx)
+	if ok && principal.HasScope(requiredScope) {
+		return nil
+	}
+
+	subject := ""
+	if ok {
+		subject = principal.Subject
+	}
+
+	service.logger.Info(
+		"Access to the field denied",
+		zap.String("field", typeName+"."+fieldName),
+		zap.String("requiredScope", requiredScope),
+		zap.String("subject", subject))
+
+	return newForbiddenError(
+		fmt.Sprintf("Access to %s.%s requires the %s scope", typeName, fieldName, requiredScope),
+		map[string]interface{}{"requiredScope": requiredScope})
+}
@@ -0,0 +1,126 @@
+package authorization_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/graph-gophers/graphql-go"
+	"go.uber.org/zap"
+)
+
+const secretsScope = "edgecluster:secrets:read"
+
+// testSchema declares a restricted field next to an unrestricted sibling, the resolvers enforce the scope declared on
+// the restricted field of the api-gateway schema
+const testSchema = `
+	schema {
+	  query: Query
+	}
+
+	type Query {
+	  edgeCluster: EdgeCluster
+	}
+
+	type EdgeCluster {
+	  name: String!
+	  clusterSecret: String
+	}
+`
+
+type queryResolver struct {
+	service authorization.FieldAuthorizationContract
+}
+
+func (r *queryResolver) EdgeCluster() *edgeClusterResolver {
+	return &edgeClusterResolver{service: r.service}
+}
+
+type edgeClusterResolver struct {
+	service authorization.FieldAuthorizationContract
+}
+
+func (r *edgeClusterResolver) Name() string {
+	return "edge"
+}
+
+func (r *edgeClusterResolver) ClusterSecret(ctx context.Context) (*string, error) {
+	if err := r.service.AuthorizeField(ctx, "EdgeCluster", "clusterSecret"); err != nil {
+		return nil, err
+	}
+
+	secret := "secret"
+
+	return &secret, nil
+}
+
+func TestDirectiveFieldAuthorizationService_DeniedFieldsResolveToNull(t *testing.T) {
+	service := newFieldAuthorizationService(t)
+	schema := graphql.MustParseSchema(testSchema, &queryResolver{service: service})
+
+	for name, ctx := range map[string]context.Context{
+		"anonymous caller":          context.Background(),
+		"caller without scopes":     identity.NewContext(context.Background(), &identity.Principal{Subject: "user1"}),
+		"caller with another scope": identity.NewContext(context.Background(), &identity.Principal{Subject: "user1", Scopes: []string{"other"}}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			response := schema.Exec(ctx, `{ edgeCluster { name clusterSecret } }`, "", nil)
+
+			if string(response.Data) != `{"edgeCluster":{"name":"edge","clusterSecret":null}}` {
+				t.Fatalf("expected the sibling field to be resolved and the denied field to be null, got %s", response.Data)
+			}
+
+			if len(response.Errors) != 1 {
+				t.Fatalf("expected a single error, got %v", response.Errors)
+			}
+
+			if path := fmt.Sprint(response.Errors[0].Path); path != "[edgeCluster clusterSecret]" {
+				t.Fatalf("expected the error to be reported at the denied field, got %s", path)
+			}
+
+			extensions := response.Errors[0].Extensions
+			if extensions["code"] != authorization.ErrorCodeForbidden || extensions["requiredScope"] != secretsScope {
+				t.Fatalf("expected the FORBIDDEN error code and the required scope, got %v", extensions)
+			}
+		})
+	}
+
+	ctx := identity.NewContext(context.Background(), &identity.Principal{Subject: "user1", Scopes: []string{secretsScope}})
+	response := schema.Exec(ctx, `{ edgeCluster { name clusterSecret } }`, "", nil)
+
+	if len(response.Errors) > 0 || string(response.Data) != `{"edgeCluster":{"name":"edge","clusterSecret":"secret"}}` {
+		t.Fatalf("expected the caller granted the scope to read the field, got %s %v", response.Data, response.Errors)
+	}
+}
+
+func TestDirectiveFieldAuthorizationService_AuthorizeField(t *testing.T) {
+	service := newFieldAuthorizationService(t)
+	anonymous := context.Background()
+
+	for _, field := range [][]string{{"EdgeCluster", "clusterSecret"}, {"ProvisionDetails", "kubeconfigContent"}} {
+		err := service.AuthorizeField(anonymous, field[0], field[1])
+
+		var extendedErr interface{ Extensions() map[string]interface{} }
+		if !errors.As(err, &extendedErr) || extendedErr.Extensions()["code"] != authorization.ErrorCodeForbidden {
+			t.Fatalf("expected the access to %s.%s to be denied, got %v", field[0], field[1], err)
+		}
+	}
+
+	if err := service.AuthorizeField(anonymous, "EdgeCluster", "name"); err != nil {
+		t.Fatalf("expected the access to the unrestricted field to be allowed, got %v", err)
+	}
+}
+
+func newFieldAuthorizationService(t *testing.T) authorization.FieldAuthorizationContract {
+	t.Helper()
+
+	service, err := authorization.NewDirectiveFieldAuthorizationService(zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return service
+}
@@ -0,0 +1,30 @@
+// Package authorization implements the services that authorize the access to the GraphQL fields and the actions performed on the projects
+package authorization
+
+type forbiddenError struct {
+	message    string
+	extensions map[string]interface{}
+}
+
+func newForbiddenError(message string, extensions map[string]interface{}) error {
+	if extensions == nil {
+		extensions = map[string]interface{}{}
+	}
+
+	extensions["code"] = ErrorCodeForbidden
+
+	return &forbiddenError{
+		message:    message,
+		extensions: extensions,
+	}
+}
+
+// Error returns the message explaining why the access is denied
+func (err *forbiddenError) Error() string {
+	return err.message
+}
+
+// Extensions returns the extensions reported along with the GraphQL error
+func (err *forbiddenError) Extensions() map[string]interface{} {
+	return err.extensions
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/authorization/contract.go
+
+// Package mock_authorization is a generated GoMock package.
+package mock_authorization
+
+import (
+	context "context"
+	reflect "reflect"
+
+	policy "github.com/decentralized-cloud/api-gateway/services/policy"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFieldAuthorizationContract is a mock of FieldAuthorizationContract interface.
+type MockFieldAuthorizationContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockFieldAuthorizationContractMockRecorder
+}
+
+// MockFieldAuthorizationContractMockRecorder is the mock recorder for MockFieldAuthorizationContract.
+type MockFieldAuthorizationContractMockRecorder struct {
+	mock *MockFieldAuthorizationContract
+}
+
+// NewMockFieldAuthorizationContract creates a new mock instance.
+func NewMockFieldAuthorizationContract(ctrl *gomock.Controller) *MockFieldAuthorizationContract {
+	mock := &MockFieldAuthorizationContract{ctrl: ctrl}
+	mock.recorder = &MockFieldAuthorizationContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFieldAuthorizationContract) EXPECT() *MockFieldAuthorizationContractMockRecorder {
+	return m.recorder
+}
+
+// AuthorizeField mocks base method.
+func (m *MockFieldAuthorizationContract) AuthorizeField(ctx context.Context, typeName, fieldName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeField", ctx, typeName, fieldName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthorizeField indicates an expected call of AuthorizeField.
+func (mr *MockFieldAuthorizationContractMockRecorder) AuthorizeField(ctx, typeName, fieldName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeField", reflect.TypeOf((*MockFieldAuthorizationContract)(nil).AuthorizeField), ctx, typeName, fieldName)
+}
+
+// MockProjectAuthorizationContract is a mock of ProjectAuthorizationContract interface.
+type MockProjectAuthorizationContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectAuthorizationContractMockRecorder
+}
+
+// MockProjectAuthorizationContractMockRecorder is the mock recorder for MockProjectAuthorizationContract.
+type MockProjectAuthorizationContractMockRecorder struct {
+	mock *MockProjectAuthorizationContract
+}
+
+// NewMockProjectAuthorizationContract creates a new mock instance.
+func NewMockProjectAuthorizationContract(ctrl *gomock.Controller) *MockProjectAuthorizationContract {
+	mock := &MockProjectAuthorizationContract{ctrl: ctrl}
+	mock.recorder = &MockProjectAuthorizationContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectAuthorizationContract) EXPECT() *MockProjectAuthorizationContractMockRecorder {
+	return m.recorder
+}
+
+// AuthorizeProjectAction mocks base method.
+func (m *MockProjectAuthorizationContract) AuthorizeProjectAction(ctx context.Context, projectID string, action policy.Action) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeProjectAction", ctx, projectID, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthorizeProjectAction indicates an expected call of AuthorizeProjectAction.
+func (mr *MockProjectAuthorizationContractMockRecorder) AuthorizeProjectAction(ctx, projectID, action interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeProjectAction", reflect.TypeOf((*MockProjectAuthorizationContract)(nil).AuthorizeProjectAction), ctx, projectID, action)
+}
+
+// GrantProjectOwnership mocks base method.
+func (m *MockProjectAuthorizationContract) GrantProjectOwnership(ctx context.Context, projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantProjectOwnership", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantProjectOwnership indicates an expected call of GrantProjectOwnership.
+func (mr *MockProjectAuthorizationContractMockRecorder) GrantProjectOwnership(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantProjectOwnership", reflect.TypeOf((*MockProjectAuthorizationContract)(nil).GrantProjectOwnership), ctx, projectID)
+}
+
+// RevokeProjectRoles mocks base method.
+func (m *MockProjectAuthorizationContract) RevokeProjectRoles(ctx context.Context, projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeProjectRoles", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeProjectRoles indicates an expected call of RevokeProjectRoles.
+func (mr *MockProjectAuthorizationContractMockRecorder) RevokeProjectRoles(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeProjectRoles", reflect.TypeOf((*MockProjectAuthorizationContract)(nil).RevokeProjectRoles), ctx, projectID)
+}
@@ -0,0 +1,119 @@
+// Package authorization implements the services that authorize the access to the GraphQL fields and the actions performed on the projects
+package authorization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/decentralized-cloud/api-gateway/services/policy"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+type policyProjectAuthorizationService struct {
+	auditLogger  *zap.Logger
+	policyEngine policy.PolicyEngineContract
+}
+
+// NewPolicyProjectAuthorizationService creates new instance of the policyProjectAuthorizationService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// policyEngine: Mandatory. Reference to the policy engine that decides whether the actions are allowed
+// Returns the new service or error if something goes wrong
+func NewPolicyProjectAuthorizationService(
+	logger *zap.Logger,
+	policyEngine policy.PolicyEngineContract) (ProjectAuthorizationContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if policyEngine == nil {
+		return nil, commonErrors.NewArgumentNilError("policyEngine", "policyEngine is required")
+	}
+
+	return &policyProjectAuthorizationService{
+		auditLogger:  logger.Named("audit"),
+		policyEngine: policyEngine,
+	}, nil
+}
+
+// AuthorizeProjectAction verifies the principal attached to the context is allowed to perform the action on the project.
+// Denied actions are audit logged.
+// ctx: Mandatory. Reference to the context
+// projectID: Optional. The project unique identifier, empty if the action does not target an existing project
+// action: Mandatory. The action the caller performs
+// Returns error if the action is denied or something goes wrong
+func (service *policyProjectAuthorizationService) AuthorizeProjectAction(
+	ctx context.Context,
+	projectID string,
+	action policy.Action) error {
+	principal, ok := identity.FromContext(ctx)
+	if !ok {
+		service.auditDenial(projectID, action, "", "", "unauthenticated")
+
+		return newForbiddenError(fmt.Sprintf("Action %s requires an authenticated user", action), map[string]interface{}{"action": string(action)})
+	}
+
+	allowed, err := service.policyEngine.IsAllowed(ctx, principal.Subject, projectID, action)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		service.auditDenial(projectID, action, principal.Subject, principal.Email, "policy")
+
+		return newForbiddenError(
+			fmt.Sprintf("Action %s is not allowed on project %s", action, projectID),
+			map[string]interface{}{"action": string(action), "projectID": projectID})
+	}
+
+	return nil
+}
+
+// GrantProjectOwnership grants the owner role on the newly created project to the principal attached to the context
+// ctx: Mandatory. Reference to the context
+// projectID: Mandatory. The project unique identifier
+// Returns error if something goes wrong
+func (service *policyProjectAuthorizationService) GrantProjectOwnership(ctx context.Context, projectID string) error {
+	principal, ok := identity.FromContext(ctx)
+	if !ok {
+		return newForbiddenError("Granting the project ownership requires an authenticated user", map[string]interface{}{"projectID": projectID})
+	}
+
+	if err := service.policyEngine.GrantProjectOwner(ctx, principal.Subject, projectID); err != nil {
+		return err
+	}
+
+	service.auditLogger.Info(
+		"Project ownership granted",
+		zap.String("subject", principal.Subject),
+		zap.String("email", principal.Email),
+		zap.String("projectID", projectID),
+		zap.String("role", string(policy.RoleOwner)))
+
+	return nil
+}
+
+// RevokeProjectRoles removes the roles granted to all the users on the deleted project
+// ctx: Mandatory. Reference to the context
+// projectID: Mandatory. The project unique identifier
+// Returns error if something goes wrong
+func (service *policyProjectAuthorizationService) RevokeProjectRoles(ctx context.Context, projectID string) error {
+	return service.policyEngine.RemoveProject(ctx, projectID)
+}
+
+func (service *policyProjectAuthorizationService) auditDenial(
+	projectID string,
+	action policy.Action,
+	subject string,
+	email string,
+	reason string) {
+	service.auditLogger.Warn(
+		"Project action denied",
+		zap.String("subject", subject),
+		zap.String("email", email),
+		zap.String("projectID", projectID),
+		zap.String("action", string(action)),
+		zap.String("decision", "deny"),
+		zap.String("reason", reason))
+}
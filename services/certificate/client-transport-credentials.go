@@ -0,0 +1,94 @@
+// Package certificate implements services that load TLS certificates from disk and reload them when the files change
+package certificate
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"google.golang.org/grpc/credentials"
+)
+
+type clientTransportCredentials struct {
+	reloader           CertificateReloaderContract
+	serverNameOverride string
+}
+
+// NewClientTransportCredentials creates new gRPC client transport credentials that build the TLS configuration from
+// the latest certificates provided by the reloader on every handshake, so rotated certificates are picked up by new connections
+// reloader: Mandatory. Reference to the service that provides the client key pair and the CA bundle
+// serverNameOverride: Optional. The server name used to verify the backend service certificate instead of the dialed host name
+// Returns the new transport credentials or error if something goes wrong
+func NewClientTransportCredentials(
+	reloader CertificateReloaderContract,
+	serverNameOverride string) (credentials.TransportCredentials, error) {
+	if reloader == nil {
+		return nil, commonErrors.NewArgumentNilError("reloader", "reloader is required")
+	}
+
+	return &clientTransportCredentials{
+		reloader:           reloader,
+		serverNameOverride: serverNameOverride,
+	}, nil
+}
+
+// ClientHandshake does the TLS handshake with the backend service using the latest certificates
+func (creds *clientTransportCredentials) ClientHandshake(
+	ctx context.Context,
+	authority string,
+	rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	certPool, err := creds.reloader.GetCertPool()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    certPool,
+		ServerName: creds.serverNameOverride,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			certificate, err := creds.reloader.GetCertificate()
+			if err != nil {
+				return nil, err
+			}
+
+			if certificate == nil {
+				return &tls.Certificate{}, nil
+			}
+
+			return certificate, nil
+		},
+	}
+
+	return credentials.NewTLS(config).ClientHandshake(ctx, authority, rawConn)
+}
+
+// ServerHandshake is not supported as the credentials are only used to dial the backend services
+func (creds *clientTransportCredentials) ServerHandshake(net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, commonErrors.NewUnknownError("server handshake is not supported by the client transport credentials")
+}
+
+// Info provides the protocol information of the transport credentials
+func (creds *clientTransportCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{
+		SecurityProtocol: "tls",
+		SecurityVersion:  "1.2",
+		ServerName:       creds.serverNameOverride,
+	}
+}
+
+// Clone makes a copy of the transport credentials
+func (creds *clientTransportCredentials) Clone() credentials.TransportCredentials {
+	return &clientTransportCredentials{
+		reloader:           creds.reloader,
+		serverNameOverride: creds.serverNameOverride,
+	}
+}
+
+// OverrideServerName overrides the server name used to verify the backend service certificate
+func (creds *clientTransportCredentials) OverrideServerName(serverNameOverride string) error {
+	creds.serverNameOverride = serverNameOverride
+
+	return nil
+}
@@ -0,0 +1,18 @@
+// Package certificate implements services that load TLS certificates from disk and reload them when the files change
+package certificate
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+)
+
+// CertificateReloaderContract declares the service that provides the latest TLS key pair and CA bundle loaded from disk
+type CertificateReloaderContract interface {
+	// GetCertificate returns the latest key pair loaded from disk. The files are reloaded if they changed since the last call.
+	// Returns the key pair, nil if no key pair is configured, or error if something goes wrong
+	GetCertificate() (*tls.Certificate, error)
+
+	// GetCertPool returns the latest CA bundle loaded from disk. The file is reloaded if it changed since the last call.
+	// Returns the CA bundle, nil if no CA bundle is configured, or error if something goes wrong
+	GetCertPool() (*x509.CertPool, error)
+}
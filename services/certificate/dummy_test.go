@@ -0,0 +1 @@
+package certificate_test
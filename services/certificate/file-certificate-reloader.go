@@ -0,0 +1,138 @@
+// Package certificate implements services that load TLS certificates from disk and reload them when the files change
+package certificate
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+type fileCertificateReloader struct {
+	certFile              string
+	keyFile               string
+	caFile                string
+	lock                  sync.Mutex
+	certificate           *tls.Certificate
+	certificateModTime    time.Time
+	certificateKeyModTime time.Time
+	certPool              *x509.CertPool
+	certPoolModTime       time.Time
+}
+
+// NewFileCertificateReloader creates new instance of the fileCertificateReloader, setting up all dependencies and returns the instance
+// certFile: Optional. The path to the PEM encoded certificate file. Must be provided together with keyFile
+// keyFile: Optional. The path to the PEM encoded private key file. Must be provided together with certFile
+// caFile: Optional. The path to the PEM encoded CA bundle file
+// Returns the new service or error if something goes wrong
+func NewFileCertificateReloader(certFile, keyFile, caFile string) (CertificateReloaderContract, error) {
+	if (strings.Trim(certFile, " ") == "") != (strings.Trim(keyFile, " ") == "") {
+		return nil, commonErrors.NewArgumentError("certFile", "certFile and keyFile must be provided together")
+	}
+
+	reloader := &fileCertificateReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+	}
+
+	if _, err := reloader.GetCertificate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := reloader.GetCertPool(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// GetCertificate returns the latest key pair loaded from disk. The files are reloaded if they changed since the last call.
+// Returns the key pair, nil if no key pair is configured, or error if something goes wrong
+func (reloader *fileCertificateReloader) GetCertificate() (*tls.Certificate, error) {
+	if strings.Trim(reloader.certFile, " ") == "" {
+		return nil, nil
+	}
+
+	certModTime, err := getModTime(reloader.certFile)
+	if err != nil {
+		return nil, err
+	}
+
+	keyModTime, err := getModTime(reloader.keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	reloader.lock.Lock()
+	defer reloader.lock.Unlock()
+
+	if reloader.certificate != nil &&
+		certModTime.Equal(reloader.certificateModTime) &&
+		keyModTime.Equal(reloader.certificateKeyModTime) {
+		return reloader.certificate, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(reloader.certFile, reloader.keyFile)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError(
+			fmt.Sprintf("Failed to load key pair. Certificate file: %s, key file: %s", reloader.certFile, reloader.keyFile),
+			err)
+	}
+
+	reloader.certificate = &certificate
+	reloader.certificateModTime = certModTime
+	reloader.certificateKeyModTime = keyModTime
+
+	return reloader.certificate, nil
+}
+
+// GetCertPool returns the latest CA bundle loaded from disk. The file is reloaded if it changed since the last call.
+// Returns the CA bundle, nil if no CA bundle is configured, or error if something goes wrong
+func (reloader *fileCertificateReloader) GetCertPool() (*x509.CertPool, error) {
+	if strings.Trim(reloader.caFile, " ") == "" {
+		return nil, nil
+	}
+
+	caModTime, err := getModTime(reloader.caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	reloader.lock.Lock()
+	defer reloader.lock.Unlock()
+
+	if reloader.certPool != nil && caModTime.Equal(reloader.certPoolModTime) {
+		return reloader.certPool, nil
+	}
+
+	content, err := ioutil.ReadFile(reloader.caFile)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to read CA file: %s", reloader.caFile), err)
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(content) {
+		return nil, commonErrors.NewUnknownError(fmt.Sprintf("CA file does not contain any valid PEM encoded certificate: %s", reloader.caFile))
+	}
+
+	reloader.certPool = certPool
+	reloader.certPoolModTime = caModTime
+
+	return reloader.certPool, nil
+}
+
+func getModTime(path string) (time.Time, error) {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to stat file: %s", path), err)
+	}
+
+	return fileInfo.ModTime(), nil
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/certificate/contract.go
+
+// Package mock_certificate is a generated GoMock package.
+package mock_certificate
+
+import (
+	tls "crypto/tls"
+	x509 "crypto/x509"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCertificateReloaderContract is a mock of CertificateReloaderContract interface.
+type MockCertificateReloaderContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockCertificateReloaderContractMockRecorder
+}
+
+// MockCertificateReloaderContractMockRecorder is the mock recorder for MockCertificateReloaderContract.
+type MockCertificateReloaderContractMockRecorder struct {
+	mock *MockCertificateReloaderContract
+}
+
+// NewMockCertificateReloaderContract creates a new mock instance.
+func NewMockCertificateReloaderContract(ctrl *gomock.Controller) *MockCertificateReloaderContract {
+	mock := &MockCertificateReloaderContract{ctrl: ctrl}
+	mock.recorder = &MockCertificateReloaderContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCertificateReloaderContract) EXPECT() *MockCertificateReloaderContractMockRecorder {
+	return m.recorder
+}
+
+// GetCertPool mocks base method.
+func (m *MockCertificateReloaderContract) GetCertPool() (*x509.CertPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertPool")
+	ret0, _ := ret[0].(*x509.CertPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertPool indicates an expected call of GetCertPool.
+func (mr *MockCertificateReloaderContractMockRecorder) GetCertPool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertPool", reflect.TypeOf((*MockCertificateReloaderContract)(nil).GetCertPool))
+}
+
+// GetCertificate mocks base method.
+func (m *MockCertificateReloaderContract) GetCertificate() (*tls.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificate")
+	ret0, _ := ret[0].(*tls.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificate indicates an expected call of GetCertificate.
+func (mr *MockCertificateReloaderContractMockRecorder) GetCertificate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificate", reflect.TypeOf((*MockCertificateReloaderContract)(nil).GetCertificate))
+}
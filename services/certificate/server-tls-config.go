@@ -0,0 +1,50 @@
+// Package certificate implements services that load TLS certificates from disk and reload them when the files change
+package certificate
+
+import (
+	"crypto/tls"
+
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// NewServerTLSConfig creates new TLS server configuration that uses the latest certificates provided by the reloader
+// on every handshake, so rotated certificates are picked up without restarting the server.
+// Clients are required to present a certificate signed by the CA bundle if the reloader provides one.
+// reloader: Mandatory. Reference to the service that provides the server key pair and the optional client CA bundle
+// Returns the new TLS configuration or error if something goes wrong
+func NewServerTLSConfig(reloader CertificateReloaderContract) (*tls.Config, error) {
+	if reloader == nil {
+		return nil, commonErrors.NewArgumentNilError("reloader", "reloader is required")
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			certificate, err := reloader.GetCertificate()
+			if err != nil {
+				return nil, err
+			}
+
+			if certificate == nil {
+				return nil, commonErrors.NewUnknownError("server key pair is not configured")
+			}
+
+			certPool, err := reloader.GetCertPool()
+			if err != nil {
+				return nil, err
+			}
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*certificate},
+			}
+
+			if certPool != nil {
+				config.ClientCAs = certPool
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+
+			return config, nil
+		},
+	}, nil
+}
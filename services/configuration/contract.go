@@ -1,6 +1,8 @@
 // Package configuration implements configuration service required by the api-gateway service
 package configuration
 
+import "time"
+
 // ConfigurationContract declares the service that provides configuration required by different Tenat modules
 type ConfigurationContract interface {
 	// GetHttpHost retrieves HTTP host name
@@ -11,6 +13,46 @@ type ConfigurationContract interface {
 	// Returns the HTTP port number or error if something goes wrong
 	GetHttpPort() (int, error)
 
+	// GetHttpsPort retrieves HTTPS port number
+	// Returns the HTTPS port number or error if something goes wrong
+	GetHttpsPort() (int, error)
+
+	// GetHttpsCertFile retrieves the path to the certificate used to terminate TLS.
+	// The service only serves plain HTTP if no certificate is provided.
+	// Returns the certificate path or error if something goes wrong
+	GetHttpsCertFile() (string, error)
+
+	// GetHttpsKeyFile retrieves the path to the private key used to terminate TLS
+	// Returns the private key path or error if something goes wrong
+	GetHttpsKeyFile() (string, error)
+
+	// GetHttpsClientCAFile retrieves the path to the CA bundle used to verify the client certificates.
+	// Clients are required to present a certificate for mutual TLS if the CA bundle is provided.
+	// Returns the client CA bundle path or error if something goes wrong
+	GetHttpsClientCAFile() (string, error)
+
+	// GetCorsAllowedOrigins retrieves the origins allowed to send cross origin requests. An origin can use a wildcard
+	// for the subdomains, e.g. https://*.example.com, and * allows all the origins.
+	// Returns the allowed origins or error if something goes wrong
+	GetCorsAllowedOrigins() ([]string, error)
+
+	// GetCorsAllowedHeaders retrieves the request headers the cross origin requests are allowed to send
+	// Returns the allowed headers or error if something goes wrong
+	GetCorsAllowedHeaders() ([]string, error)
+
+	// GetCorsExposedHeaders retrieves the response headers the cross origin requests are allowed to read
+	// Returns the exposed headers or error if something goes wrong
+	GetCorsExposedHeaders() ([]string, error)
+
+	// GetCorsAllowCredentials retrieves whether the cross origin requests are allowed to send credentials such as cookies.
+	// The credentials can not be allowed together with all the origins.
+	// Returns true if the credentials are allowed or error if something goes wrong
+	GetCorsAllowCredentials() (bool, error)
+
+	// GetCorsMaxAge retrieves the time the browsers can cache the result of the preflight requests
+	// Returns the preflight max age or error if something goes wrong
+	GetCorsMaxAge() (time.Duration, error)
+
 	// GetProjectServiceAddress retrieves project service full gRPC address and returns it.
 	// The address will be used to dial the gRPC client to connect to the project service.
 	// Returns the project service address or error if something goes wrong
@@ -24,4 +66,304 @@ type ConfigurationContract interface {
 	// GetJwksURL retrieves the JWKS URL
 	// Returns the JWKS URL or error if something goes wrong
 	GetJwksURL() (string, error)
+
+	// GetJwtSubjectClaim retrieves the name of the JWT claim that contains the unique identifier of the user
+	// Returns the JWT subject claim name or error if something goes wrong
+	GetJwtSubjectClaim() (string, error)
+
+	// GetJwtEmailClaim retrieves the name of the JWT claim that contains the email address of the user
+	// Returns the JWT email claim name or error if something goes wrong
+	GetJwtEmailClaim() (string, error)
+
+	// GetJwtScopesClaim retrieves the name of the JWT claim that contains the scopes granted to the user
+	// Returns the JWT scopes claim name or error if something goes wrong
+	GetJwtScopesClaim() (string, error)
+
+	// GetGrpcMaxMessageSize retrieves the maximum size in bytes of the messages sent to and received from the backend gRPC services
+	// Returns the maximum message size or error if something goes wrong
+	GetGrpcMaxMessageSize() (int, error)
+
+	// GetGrpcKeepaliveTime retrieves the period of inactivity after which the gRPC client pings the backend services
+	// Returns the keepalive time or error if something goes wrong
+	GetGrpcKeepaliveTime() (time.Duration, error)
+
+	// GetGrpcKeepaliveTimeout retrieves the time the gRPC client waits for the keepalive ping acknowledgement
+	// before closing the connection
+	// Returns the keepalive timeout or error if something goes wrong
+	GetGrpcKeepaliveTimeout() (time.Duration, error)
+
+	// GetGrpcTLSEnabled retrieves whether the connections to the backend gRPC services are secured using TLS.
+	// The connections fall back to plain text if TLS is not enabled.
+	// Returns true if TLS is enabled or error if something goes wrong
+	GetGrpcTLSEnabled() (bool, error)
+
+	// GetGrpcCAFile retrieves the path to the CA bundle used to verify the backend gRPC services certificates.
+	// The host root CA set is used if no CA bundle is provided.
+	// Returns the CA bundle path or error if something goes wrong
+	GetGrpcCAFile() (string, error)
+
+	// GetGrpcCertFile retrieves the path to the client certificate presented to the backend gRPC services for mutual TLS
+	// Returns the client certificate path or error if something goes wrong
+	GetGrpcCertFile() (string, error)
+
+	// GetGrpcKeyFile retrieves the path to the client private key presented to the backend gRPC services for mutual TLS
+	// Returns the client private key path or error if something goes wrong
+	GetGrpcKeyFile() (string, error)
+
+	// GetGrpcServerNameOverride retrieves the server name used to verify the backend gRPC services certificates
+	// instead of the host name in the service address
+	// Returns the server name override or error if something goes wrong
+	GetGrpcServerNameOverride() (string, error)
+
+	// GetGrpcDefaultTimeout retrieves the time the backend gRPC calls are allowed to take, including the retries,
+	// if no timeout is configured for the called method
+	// Returns the default gRPC call timeout or error if something goes wrong
+	GetGrpcDefaultTimeout() (time.Duration, error)
+
+	// GetGrpcMethodTimeouts retrieves the timeouts that override the default timeout of the backend gRPC calls.
+	// The keys are the method names, e.g. ListEdgeClusterPods.
+	// Returns the gRPC method timeouts or error if something goes wrong
+	GetGrpcMethodTimeouts() (map[string]time.Duration, error)
+
+	// GetGrpcMaxRetries retrieves the maximum number of times the failed idempotent backend gRPC calls are retried
+	// Returns the maximum number of retries or error if something goes wrong
+	GetGrpcMaxRetries() (int, error)
+
+	// GetGrpcRetryBackoff retrieves the base delay before retrying a failed backend gRPC call. The delay doubles
+	// with every retry and is jittered.
+	// Returns the retry backoff or error if something goes wrong
+	GetGrpcRetryBackoff() (time.Duration, error)
+
+	// GetGrpcRetryMaxBackoff retrieves the maximum delay before retrying a failed backend gRPC call
+	// Returns the maximum retry backoff or error if something goes wrong
+	GetGrpcRetryMaxBackoff() (time.Duration, error)
+
+	// GetGrpcCircuitBreakerFailureThreshold retrieves the number of consecutive failed backend gRPC calls that opens
+	// the circuit breaker of the backend service
+	// Returns the circuit breaker failure threshold or error if something goes wrong
+	GetGrpcCircuitBreakerFailureThreshold() (int, error)
+
+	// GetGrpcCircuitBreakerOpenTimeout retrieves the time the circuit breaker stays open before letting trial calls
+	// through to the backend service
+	// Returns the circuit breaker open timeout or error if something goes wrong
+	GetGrpcCircuitBreakerOpenTimeout() (time.Duration, error)
+
+	// GetGrpcCircuitBreakerHalfOpenMaxRequests retrieves the number of trial calls let through to the backend service
+	// while the circuit breaker is half-open
+	// Returns the maximum number of half-open requests or error if something goes wrong
+	GetGrpcCircuitBreakerHalfOpenMaxRequests() (int, error)
+
+	// GetGrpcMaxConcurrentCalls retrieves the maximum number of in-flight gRPC calls to a single backend service
+	// Returns the maximum number of concurrent calls or error if something goes wrong
+	GetGrpcMaxConcurrentCalls() (int, error)
+
+	// GetRequestTimeout retrieves the time a GraphQL request is allowed to take. The deadline is passed down to
+	// all the backend gRPC calls made while resolving the request.
+	// Returns the request timeout or error if something goes wrong
+	GetRequestTimeout() (time.Duration, error)
+
+	// GetTracingOtlpEndpoint retrieves the address of the OTLP collector the spans are exported to. The spans are not
+	// exported if the endpoint is not configured.
+	// Returns the OTLP endpoint or error if something goes wrong
+	GetTracingOtlpEndpoint() (string, error)
+
+	// GetTracingOtlpInsecure retrieves whether the spans are exported to the OTLP collector without TLS
+	// Returns true if TLS is disabled or error if something goes wrong
+	GetTracingOtlpInsecure() (bool, error)
+
+	// GetTracingSampler retrieves the name of the sampler that decides which traces are recorded. Supported values
+	// are always_on, always_off, traceidratio, parentbased_always_on, parentbased_always_off and parentbased_traceidratio.
+	// Returns the sampler name or error if something goes wrong
+	GetTracingSampler() (string, error)
+
+	// GetTracingSamplerRatio retrieves the ratio of the traces recorded by the traceidratio based samplers
+	// Returns the sampler ratio or error if something goes wrong
+	GetTracingSamplerRatio() (float64, error)
+
+	// GetShutdownReadinessDelay retrieves the time the service keeps accepting new connections after the readiness
+	// check starts failing, giving the load balancers enough time to stop routing new requests to the service
+	// Returns the shutdown readiness delay or error if something goes wrong
+	GetShutdownReadinessDelay() (time.Duration, error)
+
+	// GetShutdownTimeout retrieves the maximum time the service waits for the in-flight requests to finish during shutdown
+	// Returns the shutdown timeout or error if something goes wrong
+	GetShutdownTimeout() (time.Duration, error)
+
+	// GetHealthCheckTimeout retrieves the maximum time the readiness check waits for the backend services to respond
+	// Returns the health check timeout or error if something goes wrong
+	GetHealthCheckTimeout() (time.Duration, error)
+
+	// GetHealthCheckCacheDuration retrieves the time the result of the backend services health check is cached for
+	// Returns the health check cache duration or error if something goes wrong
+	GetHealthCheckCacheDuration() (time.Duration, error)
+
+	// GetSubscriptionPollInterval retrieves the interval the backend services are polled to detect the changes
+	// delivered to the GraphQL subscriptions
+	// Returns the subscription poll interval or error if something goes wrong
+	GetSubscriptionPollInterval() (time.Duration, error)
+
+	// GetSubscriptionMaxPerConnection retrieves the maximum number of the GraphQL subscriptions each WebSocket
+	// connection is allowed to run at once
+	// Returns the maximum number of subscriptions per connection or error if something goes wrong
+	GetSubscriptionMaxPerConnection() (int, error)
+
+	// GetDataLoaderWait retrieves the time the data loader waits to collect the keys requested while resolving
+	// a GraphQL request before sending them to the backend services in a single batch
+	// Returns the data loader wait time or error if something goes wrong
+	GetDataLoaderWait() (time.Duration, error)
+
+	// GetDataLoaderMaxBatchSize retrieves the maximum number of keys the data loader sends to the backend services in a single batch
+	// Returns the data loader maximum batch size or error if something goes wrong
+	GetDataLoaderMaxBatchSize() (int, error)
+
+	// GetQueryMaxDepth retrieves the maximum nesting depth of the fields selected by a GraphQL operation
+	// Returns the maximum query depth or error if something goes wrong
+	GetQueryMaxDepth() (int, error)
+
+	// GetQueryMaxListSize retrieves the maximum value accepted for the first and last arguments of the list fields and the
+	// maximum number of identifiers accepted by the nodes field
+	// Returns the maximum list size or error if something goes wrong
+	GetQueryMaxListSize() (int, error)
+
+	// GetQueryMaxCost retrieves the maximum cost of a GraphQL operation
+	// Returns the maximum query cost or error if something goes wrong
+	GetQueryMaxCost() (int, error)
+
+	// GetQueryFieldCosts retrieves the cost of the fields that override the default field costs. The keys
+	// are in the Type.field format.
+	// Returns the field costs or error if something goes wrong
+	GetQueryFieldCosts() (map[string]int, error)
+
+	// GetQueryMaxBatchSize retrieves the maximum number of operations accepted in a single batched request
+	// Returns the maximum batch size or error if something goes wrong
+	GetQueryMaxBatchSize() (int, error)
+
+	// GetQueryBatchConcurrency retrieves the maximum number of operations of a batched request executed concurrently
+	// Returns the batch concurrency or error if something goes wrong
+	GetQueryBatchConcurrency() (int, error)
+
+	// GetQueryMaxBatchCost retrieves the maximum total cost of the operations accepted in a single batched request
+	// Returns the maximum batch cost or error if something goes wrong
+	GetQueryMaxBatchCost() (int, error)
+
+	// GetPersistedQueryCacheSize retrieves the maximum number of automatic persisted queries kept in memory
+	// Returns the persisted query cache size or error if something goes wrong
+	GetPersistedQueryCacheSize() (int, error)
+
+	// GetPersistedQueryAllowListFile retrieves the path to the file that contains the allow-listed queries. If provided,
+	// only the queries in the allow-list are executed.
+	// Returns the persisted query allow-list file path or error if something goes wrong
+	GetPersistedQueryAllowListFile() (string, error)
+
+	// GetProjectRoleBindingsFile retrieves the path to the YAML or JSON file that binds the users to their roles on the projects
+	// Returns the project role bindings file path or error if something goes wrong
+	GetProjectRoleBindingsFile() (string, error)
+
+	// GetMetricsMaxOperationNames retrieves the maximum number of distinct operation names reported in the GraphQL
+	// request metrics. The operations named after the limit is reached, as well as the rejected operations whose name is
+	// not reported yet, are reported as other.
+	// Returns the maximum number of operation names or error if something goes wrong
+	GetMetricsMaxOperationNames() (int, error)
+
+	// GetMetricsResolverFields retrieves the fields the resolver latency is reported for. The fields are in the Type.field format.
+	// Returns the fields or error if something goes wrong
+	GetMetricsResolverFields() ([]string, error)
+
+	// GetGraphQLRawIDs retrieves whether the ID fields report the raw backend identifiers instead of the global
+	// identifiers. It keeps the clients working while they migrate to the global identifiers.
+	// Returns true if the raw backend identifiers are reported or error if something goes wrong
+	GetGraphQLRawIDs() (bool, error)
+
+	// GetRateLimitQueryRate retrieves the number of queries per second each caller is allowed to send.
+	// Zero disables the queries rate limit.
+	// Returns the queries rate or error if something goes wrong
+	GetRateLimitQueryRate() (float64, error)
+
+	// GetRateLimitQueryBurst retrieves the number of queries each caller is allowed to send at once
+	// Returns the queries burst or error if something goes wrong
+	GetRateLimitQueryBurst() (int, error)
+
+	// GetRateLimitMutationRate retrieves the number of mutations per second each caller is allowed to send.
+	// Zero disables the mutations rate limit.
+	// Returns the mutations rate or error if something goes wrong
+	GetRateLimitMutationRate() (float64, error)
+
+	// GetRateLimitMutationBurst retrieves the number of mutations each caller is allowed to send at once
+	// Returns the mutations burst or error if something goes wrong
+	GetRateLimitMutationBurst() (int, error)
+
+	// GetRateLimitAddressRate retrieves the number of requests per second each client IP address is allowed to send
+	// before the requests are authenticated. Zero disables the IP address rate limit.
+	// Returns the IP address requests rate or error if something goes wrong
+	GetRateLimitAddressRate() (float64, error)
+
+	// GetRateLimitAddressBurst retrieves the number of requests each client IP address is allowed to send at once
+	// Returns the IP address requests burst or error if something goes wrong
+	GetRateLimitAddressBurst() (int, error)
+
+	// GetRateLimitTrustForwardedFor retrieves whether the client IP address is read from the X-Forwarded-For header set
+	// by the reverse proxy in front of the service instead of the address of the connection
+	// Returns true if the X-Forwarded-For header is trusted or error if something goes wrong
+	GetRateLimitTrustForwardedFor() (bool, error)
+
+	// GetResponseCacheEnabled retrieves whether the responses of the query operations are cached
+	// Returns true if the responses are cached or error if something goes wrong
+	GetResponseCacheEnabled() (bool, error)
+
+	// GetResponseCacheMaxBytes retrieves the maximum size in bytes of the cached responses kept in memory
+	// Returns the response cache size or error if something goes wrong
+	GetResponseCacheMaxBytes() (int, error)
+
+	// GetResponseCacheDefaultMaxAge retrieves the time the responses of the root fields that have no @cacheControl
+	// hint are cached for. Zero means these responses are not cached.
+	// Returns the default max-age or error if something goes wrong
+	GetResponseCacheDefaultMaxAge() (time.Duration, error)
+
+	// GetAuditSink retrieves the sink the mutation audit events are written to, either stdout, file or webhook
+	// Returns the audit sink or error if something goes wrong
+	GetAuditSink() (string, error)
+
+	// GetAuditRedactedFields retrieves the names of the mutation input fields whose values are redacted in the audit events
+	// Returns the redacted field names or error if something goes wrong
+	GetAuditRedactedFields() ([]string, error)
+
+	// GetAuditFilePath retrieves the path to the file the audit events are written to when the file sink is used
+	// Returns the audit file path or error if something goes wrong
+	GetAuditFilePath() (string, error)
+
+	// GetAuditFileMaxBytes retrieves the size in bytes the audit file is rotated at
+	// Returns the audit file maximum size or error if something goes wrong
+	GetAuditFileMaxBytes() (int, error)
+
+	// GetAuditFileMaxBackups retrieves the number of the rotated audit files kept next to the audit file
+	// Returns the number of the rotated audit files or error if something goes wrong
+	GetAuditFileMaxBackups() (int, error)
+
+	// GetAuditWebhookURL retrieves the URL the audit events are posted to when the webhook sink is used
+	// Returns the audit webhook URL or error if something goes wrong
+	GetAuditWebhookURL() (string, error)
+
+	// GetAuditWebhookTimeout retrieves the time allowed for a single post of an audit event to the webhook
+	// Returns the audit webhook timeout or error if something goes wrong
+	GetAuditWebhookTimeout() (time.Duration, error)
+
+	// GetAuditWebhookMaxRetries retrieves the number of times posting an audit event to the webhook is retried
+	// Returns the number of retries or error if something goes wrong
+	GetAuditWebhookMaxRetries() (int, error)
+
+	// GetKubernetesClientCacheSize retrieves the maximum number of the edge cluster Kubernetes clients kept in the cache
+	// Returns the Kubernetes client cache size or error if something goes wrong
+	GetKubernetesClientCacheSize() (int, error)
+
+	// GetKubernetesRequestTimeout retrieves the time allowed for a single request to the edge cluster Kubernetes API
+	// Returns the Kubernetes request timeout or error if something goes wrong
+	GetKubernetesRequestTimeout() (time.Duration, error)
+
+	// GetKubernetesListPageSize retrieves the number of the objects requested from the edge cluster Kubernetes API in a single list request
+	// Returns the Kubernetes list page size or error if something goes wrong
+	GetKubernetesListPageSize() (int, error)
+
+	// GetKubernetesListMaxItems retrieves the maximum number of the objects read from the edge cluster Kubernetes API for a single field
+	// Returns the maximum number of the listed Kubernetes objects or error if something goes wrong
+	GetKubernetesListMaxItems() (int, error)
 }
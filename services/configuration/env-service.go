@@ -2,9 +2,11 @@
 package configuration
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	commonErrors "github.com/micro-business/go-core/system/errors"
 )
@@ -40,6 +42,76 @@ func (service *envConfigurationService) GetHttpPort() (int, error) {
 	return portNumber, nil
 }
 
+// GetHttpsPort retrieves HTTPS port number
+// Returns the HTTPS port number or error if something goes wrong
+func (service *envConfigurationService) GetHttpsPort() (int, error) {
+	portNumberString := os.Getenv("HTTPS_PORT")
+	if strings.Trim(portNumberString, " ") == "" {
+		return 0, commonErrors.NewUnknownError("HTTPS_PORT is required")
+	}
+
+	portNumber, err := strconv.Atoi(portNumberString)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError("Failed to convert HTTPS_PORT to integer", err)
+	}
+
+	return portNumber, nil
+}
+
+// GetHttpsCertFile retrieves the path to the certificate used to terminate TLS.
+// The service only serves plain HTTP if no certificate is provided.
+// Returns the certificate path or error if something goes wrong
+func (service *envConfigurationService) GetHttpsCertFile() (string, error) {
+	return os.Getenv("HTTPS_CERT_FILE"), nil
+}
+
+// GetHttpsKeyFile retrieves the path to the private key used to terminate TLS
+// Returns the private key path or error if something goes wrong
+func (service *envConfigurationService) GetHttpsKeyFile() (string, error) {
+	return os.Getenv("HTTPS_KEY_FILE"), nil
+}
+
+// GetHttpsClientCAFile retrieves the path to the CA bundle used to verify the client certificates.
+// Clients are required to present a certificate for mutual TLS if the CA bundle is provided.
+// Returns the client CA bundle path or error if something goes wrong
+func (service *envConfigurationService) GetHttpsClientCAFile() (string, error) {
+	return os.Getenv("HTTPS_CLIENT_CA_FILE"), nil
+}
+
+// GetCorsAllowedOrigins retrieves the origins allowed to send cross origin requests. An origin can use a wildcard
+// for the subdomains, e.g. https://*.example.com, and * allows all the origins.
+// Returns the allowed origins or error if something goes wrong
+func (service *envConfigurationService) GetCorsAllowedOrigins() ([]string, error) {
+	return getStringListWithDefault("CORS_ALLOWED_ORIGINS", "*"), nil
+}
+
+// GetCorsAllowedHeaders retrieves the request headers the cross origin requests are allowed to send
+// Returns the allowed headers or error if something goes wrong
+func (service *envConfigurationService) GetCorsAllowedHeaders() ([]string, error) {
+	return getStringListWithDefault(
+		"CORS_ALLOWED_HEADERS",
+		"Origin,DNT,User-Agent,X-Requested-With,If-Modified-Since,Cache-Control,Content-Type,Range,Authorization,traceparent,tracestate"), nil
+}
+
+// GetCorsExposedHeaders retrieves the response headers the cross origin requests are allowed to read
+// Returns the exposed headers or error if something goes wrong
+func (service *envConfigurationService) GetCorsExposedHeaders() ([]string, error) {
+	return getStringListWithDefault("CORS_EXPOSED_HEADERS", ""), nil
+}
+
+// GetCorsAllowCredentials retrieves whether the cross origin requests are allowed to send credentials such as cookies.
+// The credentials can not be allowed together with all the origins.
+// Returns true if the credentials are allowed or error if something goes wrong
+func (service *envConfigurationService) GetCorsAllowCredentials() (bool, error) {
+	return getBoolWithDefault("CORS_ALLOW_CREDENTIALS", false)
+}
+
+// GetCorsMaxAge retrieves the time the browsers can cache the result of the preflight requests
+// Returns the preflight max age or error if something goes wrong
+func (service *envConfigurationService) GetCorsMaxAge() (time.Duration, error) {
+	return getDurationWithDefault("CORS_MAX_AGE", 10*time.Minute)
+}
+
 // GetProjectServiceAddress retrieves project service full gRPC address and returns it.
 // The address will be used to dial the gRPC client to connect to the project service.
 // Returns the project service address or error if something goes wrong
@@ -75,3 +147,565 @@ func (service *envConfigurationService) GetJwksURL() (string, error) {
 
 	return jwksURL, nil
 }
+
+// GetJwtSubjectClaim retrieves the name of the JWT claim that contains the unique identifier of the user
+// Returns the JWT subject claim name or error if something goes wrong
+func (service *envConfigurationService) GetJwtSubjectClaim() (string, error) {
+	return getStringWithDefault("JWT_SUBJECT_CLAIM", "sub"), nil
+}
+
+// GetJwtEmailClaim retrieves the name of the JWT claim that contains the email address of the user
+// Returns the JWT email claim name or error if something goes wrong
+func (service *envConfigurationService) GetJwtEmailClaim() (string, error) {
+	return getStringWithDefault("JWT_EMAIL_CLAIM", "email"), nil
+}
+
+// GetJwtScopesClaim retrieves the name of the JWT claim that contains the scopes granted to the user
+// Returns the JWT scopes claim name or error if something goes wrong
+func (service *envConfigurationService) GetJwtScopesClaim() (string, error) {
+	return getStringWithDefault("JWT_SCOPES_CLAIM", "scope"), nil
+}
+
+// GetGrpcMaxMessageSize retrieves the maximum size in bytes of the messages sent to and received from the backend gRPC services
+// Returns the maximum message size or error if something goes wrong
+func (service *envConfigurationService) GetGrpcMaxMessageSize() (int, error) {
+	return getIntWithDefault("GRPC_MAX_MESSAGE_SIZE", 4*1024*1024)
+}
+
+// GetGrpcKeepaliveTime retrieves the period of inactivity after which the gRPC client pings the backend services
+// Returns the keepalive time or error if something goes wrong
+func (service *envConfigurationService) GetGrpcKeepaliveTime() (time.Duration, error) {
+	return getDurationWithDefault("GRPC_KEEPALIVE_TIME", 5*time.Minute)
+}
+
+// GetGrpcKeepaliveTimeout retrieves the time the gRPC client waits for the keepalive ping acknowledgement
+// before closing the connection
+// Returns the keepalive timeout or error if something goes wrong
+func (service *envConfigurationService) GetGrpcKeepaliveTimeout() (time.Duration, error) {
+	return getDurationWithDefault("GRPC_KEEPALIVE_TIMEOUT", 20*time.Second)
+}
+
+// GetGrpcTLSEnabled retrieves whether the connections to the backend gRPC services are secured using TLS.
+// The connections fall back to plain text if TLS is not enabled.
+// Returns true if TLS is enabled or error if something goes wrong
+func (service *envConfigurationService) GetGrpcTLSEnabled() (bool, error) {
+	return getBoolWithDefault("GRPC_TLS_ENABLED", false)
+}
+
+// GetGrpcCAFile retrieves the path to the CA bundle used to verify the backend gRPC services certificates.
+// The host root CA set is used if no CA bundle is provided.
+// Returns the CA bundle path or error if something goes wrong
+func (service *envConfigurationService) GetGrpcCAFile() (string, error) {
+	return os.Getenv("GRPC_CA_FILE"), nil
+}
+
+// GetGrpcCertFile retrieves the path to the client certificate presented to the backend gRPC services for mutual TLS
+// Returns the client certificate path or error if something goes wrong
+func (service *envConfigurationService) GetGrpcCertFile() (string, error) {
+	return os.Getenv("GRPC_CERT_FILE"), nil
+}
+
+// GetGrpcKeyFile retrieves the path to the client private key presented to the backend gRPC services for mutual TLS
+// Returns the client private key path or error if something goes wrong
+func (service *envConfigurationService) GetGrpcKeyFile() (string, error) {
+	return os.Getenv("GRPC_KEY_FILE"), nil
+}
+
+// GetGrpcServerNameOverride retrieves the server name used to verify the backend gRPC services certificates
+// instead of the host name in the service address
+// Returns the server name override or error if something goes wrong
+func (service *envConfigurationService) GetGrpcServerNameOverride() (string, error) {
+	return os.Getenv("GRPC_SERVER_NAME_OVERRIDE"), nil
+}
+
+// GetGrpcDefaultTimeout retrieves the time the backend gRPC calls are allowed to take, including the retries,
+// if no timeout is configured for the called method
+// Returns the default gRPC call timeout or error if something goes wrong
+func (service *envConfigurationService) GetGrpcDefaultTimeout() (time.Duration, error) {
+	return getDurationWithDefault("GRPC_DEFAULT_TIMEOUT", 10*time.Second)
+}
+
+// GetGrpcMethodTimeouts retrieves the timeouts that override the default timeout of the backend gRPC calls. The value
+// is a comma separated list of method=timeout pairs, e.g. ListEdgeClusterPods=5s,ReadProject=2s
+// Returns the gRPC method timeouts or error if something goes wrong
+func (service *envConfigurationService) GetGrpcMethodTimeouts() (map[string]time.Duration, error) {
+	methodTimeouts := map[string]time.Duration{}
+
+	for _, pair := range strings.Split(os.Getenv("GRPC_METHOD_TIMEOUTS"), ",") {
+		if strings.Trim(pair, " ") == "" {
+			continue
+		}
+
+		parts := strings.Split(pair, "=")
+		if len(parts) != 2 || strings.Trim(parts[0], " ") == "" {
+			return nil, commonErrors.NewUnknownError(fmt.Sprintf("GRPC_METHOD_TIMEOUTS contains invalid entry: %s", pair))
+		}
+
+		timeout, err := time.ParseDuration(strings.Trim(parts[1], " "))
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to convert the timeout of %s to duration", parts[0]), err)
+		}
+
+		methodTimeouts[strings.Trim(parts[0], " ")] = timeout
+	}
+
+	return methodTimeouts, nil
+}
+
+// GetGrpcMaxRetries retrieves the maximum number of times the failed idempotent backend gRPC calls are retried
+// Returns the maximum number of retries or error if something goes wrong
+func (service *envConfigurationService) GetGrpcMaxRetries() (int, error) {
+	return getIntWithDefault("GRPC_MAX_RETRIES", 2)
+}
+
+// GetGrpcRetryBackoff retrieves the base delay before retrying a failed backend gRPC call. The delay doubles
+// with every retry and is jittered.
+// Returns the retry backoff or error if something goes wrong
+func (service *envConfigurationService) GetGrpcRetryBackoff() (time.Duration, error) {
+	return getDurationWithDefault("GRPC_RETRY_BACKOFF", 100*time.Millisecond)
+}
+
+// GetGrpcRetryMaxBackoff retrieves the maximum delay before retrying a failed backend gRPC call
+// Returns the maximum retry backoff or error if something goes wrong
+func (service *envConfigurationService) GetGrpcRetryMaxBackoff() (time.Duration, error) {
+	return getDurationWithDefault("GRPC_RETRY_MAX_BACKOFF", time.Second)
+}
+
+// GetGrpcCircuitBreakerFailureThreshold retrieves the number of consecutive failed backend gRPC calls that opens
+// the circuit breaker of the backend service
+// Returns the circuit breaker failure threshold or error if something goes wrong
+func (service *envConfigurationService) GetGrpcCircuitBreakerFailureThreshold() (int, error) {
+	return getIntWithDefault("GRPC_CIRCUIT_BREAKER_FAILURE_THRESHOLD", 5)
+}
+
+// GetGrpcCircuitBreakerOpenTimeout retrieves the time the circuit breaker stays open before letting trial calls
+// through to the backend service
+// Returns the circuit breaker open timeout or error if something goes wrong
+func (service *envConfigurationService) GetGrpcCircuitBreakerOpenTimeout() (time.Duration, error) {
+	return getDurationWithDefault("GRPC_CIRCUIT_BREAKER_OPEN_TIMEOUT", 30*time.Second)
+}
+
+// GetGrpcCircuitBreakerHalfOpenMaxRequests retrieves the number of trial calls let through to the backend service
+// while the circuit breaker is half-open
+// Returns the maximum number of half-open requests or error if something goes wrong
+func (service *envConfigurationService) GetGrpcCircuitBreakerHalfOpenMaxRequests() (int, error) {
+	return getIntWithDefault("GRPC_CIRCUIT_BREAKER_HALF_OPEN_MAX_REQUESTS", 1)
+}
+
+// GetGrpcMaxConcurrentCalls retrieves the maximum number of in-flight gRPC calls to a single backend service
+// Returns the maximum number of concurrent calls or error if something goes wrong
+func (service *envConfigurationService) GetGrpcMaxConcurrentCalls() (int, error) {
+	return getIntWithDefault("GRPC_MAX_CONCURRENT_CALLS", 100)
+}
+
+// GetRequestTimeout retrieves the time a GraphQL request is allowed to take. The deadline is passed down to
+// all the backend gRPC calls made while resolving the request.
+// Returns the request timeout or error if something goes wrong
+func (service *envConfigurationService) GetRequestTimeout() (time.Duration, error) {
+	return getDurationWithDefault("REQUEST_TIMEOUT", 30*time.Second)
+}
+
+// GetTracingOtlpEndpoint retrieves the address of the OTLP collector the spans are exported to. The spans are not
+// exported if the endpoint is not configured.
+// Returns the OTLP endpoint or error if something goes wrong
+func (service *envConfigurationService) GetTracingOtlpEndpoint() (string, error) {
+	return os.Getenv("TRACING_OTLP_ENDPOINT"), nil
+}
+
+// GetTracingOtlpInsecure retrieves whether the spans are exported to the OTLP collector without TLS
+// Returns true if TLS is disabled or error if something goes wrong
+func (service *envConfigurationService) GetTracingOtlpInsecure() (bool, error) {
+	return getBoolWithDefault("TRACING_OTLP_INSECURE", false)
+}
+
+// GetTracingSampler retrieves the name of the sampler that decides which traces are recorded. Supported values
+// are always_on, always_off, traceidratio, parentbased_always_on, parentbased_always_off and parentbased_traceidratio.
+// Returns the sampler name or error if something goes wrong
+func (service *envConfigurationService) GetTracingSampler() (string, error) {
+	return getStringWithDefault("TRACING_SAMPLER", "parentbased_always_on"), nil
+}
+
+// GetTracingSamplerRatio retrieves the ratio of the traces recorded by the traceidratio based samplers
+// Returns the sampler ratio or error if something goes wrong
+func (service *envConfigurationService) GetTracingSamplerRatio() (float64, error) {
+	return getFloatWithDefault("TRACING_SAMPLER_RATIO", 1)
+}
+
+// GetShutdownReadinessDelay retrieves the time the service keeps accepting new connections after the readiness
+// check starts failing, giving the load balancers enough time to stop routing new requests to the service
+// Returns the shutdown readiness delay or error if something goes wrong
+func (service *envConfigurationService) GetShutdownReadinessDelay() (time.Duration, error) {
+	return getDurationWithDefault("SHUTDOWN_READINESS_DELAY", 5*time.Second)
+}
+
+// GetShutdownTimeout retrieves the maximum time the service waits for the in-flight requests to finish during shutdown
+// Returns the shutdown timeout or error if something goes wrong
+func (service *envConfigurationService) GetShutdownTimeout() (time.Duration, error) {
+	return getDurationWithDefault("SHUTDOWN_TIMEOUT", 20*time.Second)
+}
+
+// GetHealthCheckTimeout retrieves the maximum time the readiness check waits for the backend services to respond
+// Returns the health check timeout or error if something goes wrong
+func (service *envConfigurationService) GetHealthCheckTimeout() (time.Duration, error) {
+	return getDurationWithDefault("HEALTH_CHECK_TIMEOUT", 2*time.Second)
+}
+
+// GetHealthCheckCacheDuration retrieves the time the result of the backend services health check is cached for
+// Returns the health check cache duration or error if something goes wrong
+func (service *envConfigurationService) GetHealthCheckCacheDuration() (time.Duration, error) {
+	return getDurationWithDefault("HEALTH_CHECK_CACHE_DURATION", 5*time.Second)
+}
+
+// GetSubscriptionPollInterval retrieves the interval the backend services are polled to detect the changes
+// delivered to the GraphQL subscriptions
+// Returns the subscription poll interval or error if something goes wrong
+func (service *envConfigurationService) GetSubscriptionPollInterval() (time.Duration, error) {
+	return getDurationWithDefault("SUBSCRIPTION_POLL_INTERVAL", 5*time.Second)
+}
+
+// GetSubscriptionMaxPerConnection retrieves the maximum number of the GraphQL subscriptions each WebSocket
+// connection is allowed to run at once
+// Returns the maximum number of subscriptions per connection or error if something goes wrong
+func (service *envConfigurationService) GetSubscriptionMaxPerConnection() (int, error) {
+	return getIntWithDefault("SUBSCRIPTION_MAX_PER_CONNECTION", 10)
+}
+
+// GetDataLoaderWait retrieves the time the data loader waits to collect the keys requested while resolving
+// a GraphQL request before sending them to the backend services in a single batch
+// Returns the data loader wait time or error if something goes wrong
+func (service *envConfigurationService) GetDataLoaderWait() (time.Duration, error) {
+	return getDurationWithDefault("DATALOADER_WAIT", 2*time.Millisecond)
+}
+
+// GetDataLoaderMaxBatchSize retrieves the maximum number of keys the data loader sends to the backend services in a single batch
+// Returns the data loader maximum batch size or error if something goes wrong
+func (service *envConfigurationService) GetDataLoaderMaxBatchSize() (int, error) {
+	return getIntWithDefault("DATALOADER_MAX_BATCH_SIZE", 100)
+}
+
+// GetQueryMaxDepth retrieves the maximum nesting depth of the fields selected by a GraphQL operation
+// Returns the maximum query depth or error if something goes wrong
+func (service *envConfigurationService) GetQueryMaxDepth() (int, error) {
+	return getIntWithDefault("QUERY_MAX_DEPTH", 10)
+}
+
+// GetQueryMaxListSize retrieves the maximum value accepted for the first and last arguments of the list fields and the
+// maximum number of identifiers accepted by the nodes field
+// Returns the maximum list size or error if something goes wrong
+func (service *envConfigurationService) GetQueryMaxListSize() (int, error) {
+	return getIntWithDefault("QUERY_MAX_LIST_SIZE", 100)
+}
+
+// GetQueryMaxCost retrieves the maximum cost of a GraphQL operation
+// Returns the maximum query cost or error if something goes wrong
+func (service *envConfigurationService) GetQueryMaxCost() (int, error) {
+	return getIntWithDefault("QUERY_MAX_COST", 5000)
+}
+
+// GetQueryFieldCosts retrieves the cost of the fields that override the default field costs. The value is
+// a comma separated list of Type.field=cost pairs, e.g. EdgeCluster.pods=20,EdgeCluster.name=0
+// Returns the field costs or error if something goes wrong
+func (service *envConfigurationService) GetQueryFieldCosts() (map[string]int, error) {
+	fieldCosts := map[string]int{}
+
+	for _, pair := range strings.Split(os.Getenv("QUERY_FIELD_COSTS"), ",") {
+		if strings.Trim(pair, " ") == "" {
+			continue
+		}
+
+		parts := strings.Split(pair, "=")
+		if len(parts) != 2 || !strings.Contains(parts[0], ".") {
+			return nil, commonErrors.NewUnknownError(fmt.Sprintf("QUERY_FIELD_COSTS contains invalid entry: %s", pair))
+		}
+
+		cost, err := strconv.Atoi(strings.Trim(parts[1], " "))
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to convert the cost of %s to integer", parts[0]), err)
+		}
+
+		fieldCosts[strings.Trim(parts[0], " ")] = cost
+	}
+
+	return fieldCosts, nil
+}
+
+// GetQueryMaxBatchSize retrieves the maximum number of operations accepted in a single batched request
+// Returns the maximum batch size or error if something goes wrong
+func (service *envConfigurationService) GetQueryMaxBatchSize() (int, error) {
+	return getIntWithDefault("QUERY_MAX_BATCH_SIZE", 10)
+}
+
+// GetQueryBatchConcurrency retrieves the maximum number of operations of a batched request executed concurrently
+// Returns the batch concurrency or error if something goes wrong
+func (service *envConfigurationService) GetQueryBatchConcurrency() (int, error) {
+	return getIntWithDefault("QUERY_BATCH_CONCURRENCY", 4)
+}
+
+// GetQueryMaxBatchCost retrieves the maximum total cost of the operations accepted in a single batched request
+// Returns the maximum batch cost or error if something goes wrong
+func (service *envConfigurationService) GetQueryMaxBatchCost() (int, error) {
+	return getIntWithDefault("QUERY_MAX_BATCH_COST", 10000)
+}
+
+// GetPersistedQueryCacheSize retrieves the maximum number of automatic persisted queries kept in memory
+// Returns the persisted query cache size or error if something goes wrong
+func (service *envConfigurationService) GetPersistedQueryCacheSize() (int, error) {
+	return getIntWithDefault("PERSISTED_QUERY_CACHE_SIZE", 1000)
+}
+
+// GetPersistedQueryAllowListFile retrieves the path to the file that contains the allow-listed queries. If provided,
+// only the queries in the allow-list are executed.
+// Returns the persisted query allow-list file path or error if something goes wrong
+func (service *envConfigurationService) GetPersistedQueryAllowListFile() (string, error) {
+	return os.Getenv("PERSISTED_QUERY_ALLOW_LIST_FILE"), nil
+}
+
+// GetProjectRoleBindingsFile retrieves the path to the YAML or JSON file that binds the users to their roles on the projects
+// Returns the project role bindings file path or error if something goes wrong
+func (service *envConfigurationService) GetProjectRoleBindingsFile() (string, error) {
+	return os.Getenv("PROJECT_ROLE_BINDINGS_FILE"), nil
+}
+
+// GetMetricsMaxOperationNames retrieves the maximum number of distinct operation names reported in the GraphQL
+// request metrics. The operations named after the limit is reached, as well as the rejected operations whose name is
+// not reported yet, are reported as other.
+// Returns the maximum number of operation names or error if something goes wrong
+func (service *envConfigurationService) GetMetricsMaxOperationNames() (int, error) {
+	return getIntWithDefault("METRICS_MAX_OPERATION_NAMES", 100)
+}
+
+// GetMetricsResolverFields retrieves the fields the resolver latency is reported for. The value is a comma separated
+// list of fields in the Type.field format, e.g. EdgeCluster.pods,EdgeCluster.nodes
+// Returns the fields or error if something goes wrong
+func (service *envConfigurationService) GetMetricsResolverFields() ([]string, error) {
+	fields := getStringListWithDefault("METRICS_RESOLVER_FIELDS", "EdgeCluster.nodes,EdgeCluster.pods,EdgeCluster.services")
+
+	for _, field := range fields {
+		if !strings.Contains(field, ".") {
+			return nil, commonErrors.NewUnknownError(fmt.Sprintf("METRICS_RESOLVER_FIELDS contains invalid entry: %s", field))
+		}
+	}
+
+	return fields, nil
+}
+
+// GetGraphQLRawIDs retrieves whether the ID fields report the raw backend identifiers instead of the global
+// identifiers. It keeps the clients working while they migrate to the global identifiers.
+// Returns true if the raw backend identifiers are reported or error if something goes wrong
+func (service *envConfigurationService) GetGraphQLRawIDs() (bool, error) {
+	return getBoolWithDefault("GRAPHQL_RAW_IDS", false)
+}
+
+// GetRateLimitQueryRate retrieves the number of queries per second each caller is allowed to send.
+// Zero disables the queries rate limit.
+// Returns the queries rate or error if something goes wrong
+func (service *envConfigurationService) GetRateLimitQueryRate() (float64, error) {
+	return getFloatWithDefault("RATE_LIMIT_QUERY_RATE", 10)
+}
+
+// GetRateLimitQueryBurst retrieves the number of queries each caller is allowed to send at once
+// Returns the queries burst or error if something goes wrong
+func (service *envConfigurationService) GetRateLimitQueryBurst() (int, error) {
+	return getIntWithDefault("RATE_LIMIT_QUERY_BURST", 50)
+}
+
+// GetRateLimitMutationRate retrieves the number of mutations per second each caller is allowed to send.
+// Zero disables the mutations rate limit.
+// Returns the mutations rate or error if something goes wrong
+func (service *envConfigurationService) GetRateLimitMutationRate() (float64, error) {
+	return getFloatWithDefault("RATE_LIMIT_MUTATION_RATE", 2)
+}
+
+// GetRateLimitMutationBurst retrieves the number of mutations each caller is allowed to send at once
+// Returns the mutations burst or error if something goes wrong
+func (service *envConfigurationService) GetRateLimitMutationBurst() (int, error) {
+	return getIntWithDefault("RATE_LIMIT_MUTATION_BURST", 10)
+}
+
+// GetRateLimitAddressRate retrieves the number of requests per second each client IP address is allowed to send
+// before the requests are authenticated. Zero disables the IP address rate limit.
+// Returns the IP address requests rate or error if something goes wrong
+func (service *envConfigurationService) GetRateLimitAddressRate() (float64, error) {
+	return getFloatWithDefault("RATE_LIMIT_ADDRESS_RATE", 50)
+}
+
+// GetRateLimitAddressBurst retrieves the number of requests each client IP address is allowed to send at once
+// Returns the IP address requests burst or error if something goes wrong
+func (service *envConfigurationService) GetRateLimitAddressBurst() (int, error) {
+	return getIntWithDefault("RATE_LIMIT_ADDRESS_BURST", 200)
+}
+
+// GetRateLimitTrustForwardedFor retrieves whether the client IP address is read from the X-Forwarded-For header set
+// by the reverse proxy in front of the service instead of the address of the connection
+// Returns true if the X-Forwarded-For header is trusted or error if something goes wrong
+func (service *envConfigurationService) GetRateLimitTrustForwardedFor() (bool, error) {
+	return getBoolWithDefault("RATE_LIMIT_TRUST_FORWARDED_FOR", false)
+}
+
+// GetResponseCacheEnabled retrieves whether the responses of the query operations are cached
+// Returns true if the responses are cached or error if something goes wrong
+func (service *envConfigurationService) GetResponseCacheEnabled() (bool, error) {
+	return getBoolWithDefault("RESPONSE_CACHE_ENABLED", false)
+}
+
+// GetResponseCacheMaxBytes retrieves the maximum size in bytes of the cached responses kept in memory
+// Returns the response cache size or error if something goes wrong
+func (service *envConfigurationService) GetResponseCacheMaxBytes() (int, error) {
+	return getIntWithDefault("RESPONSE_CACHE_MAX_BYTES", 64*1024*1024)
+}
+
+// GetResponseCacheDefaultMaxAge retrieves the time the responses of the root fields that have no @cacheControl
+// hint are cached for. Zero means these responses are not cached.
+// Returns the default max-age or error if something goes wrong
+func (service *envConfigurationService) GetResponseCacheDefaultMaxAge() (time.Duration, error) {
+	return getDurationWithDefault("RESPONSE_CACHE_DEFAULT_MAX_AGE", 0)
+}
+
+// GetAuditSink retrieves the sink the mutation audit events are written to, either stdout, file or webhook
+// Returns the audit sink or error if something goes wrong
+func (service *envConfigurationService) GetAuditSink() (string, error) {
+	return getStringWithDefault("AUDIT_SINK", "stdout"), nil
+}
+
+// GetAuditRedactedFields retrieves the names of the mutation input fields whose values are redacted in the audit events
+// Returns the redacted field names or error if something goes wrong
+func (service *envConfigurationService) GetAuditRedactedFields() ([]string, error) {
+	return getStringListWithDefault("AUDIT_REDACTED_FIELDS", "clusterSecret"), nil
+}
+
+// GetAuditFilePath retrieves the path to the file the audit events are written to when the file sink is used
+// Returns the audit file path or error if something goes wrong
+func (service *envConfigurationService) GetAuditFilePath() (string, error) {
+	return getStringWithDefault("AUDIT_FILE_PATH", "/var/log/api-gateway/audit.log"), nil
+}
+
+// GetAuditFileMaxBytes retrieves the size in bytes the audit file is rotated at
+// Returns the audit file maximum size or error if something goes wrong
+func (service *envConfigurationService) GetAuditFileMaxBytes() (int, error) {
+	return getIntWithDefault("AUDIT_FILE_MAX_BYTES", 100*1024*1024)
+}
+
+// GetAuditFileMaxBackups retrieves the number of the rotated audit files kept next to the audit file
+// Returns the number of the rotated audit files or error if something goes wrong
+func (service *envConfigurationService) GetAuditFileMaxBackups() (int, error) {
+	return getIntWithDefault("AUDIT_FILE_MAX_BACKUPS", 5)
+}
+
+// GetAuditWebhookURL retrieves the URL the audit events are posted to when the webhook sink is used
+// Returns the audit webhook URL or error if something goes wrong
+func (service *envConfigurationService) GetAuditWebhookURL() (string, error) {
+	return getStringWithDefault("AUDIT_WEBHOOK_URL", ""), nil
+}
+
+// GetAuditWebhookTimeout retrieves the time allowed for a single post of an audit event to the webhook
+// Returns the audit webhook timeout or error if something goes wrong
+func (service *envConfigurationService) GetAuditWebhookTimeout() (time.Duration, error) {
+	return getDurationWithDefault("AUDIT_WEBHOOK_TIMEOUT", 5*time.Second)
+}
+
+// GetAuditWebhookMaxRetries retrieves the number of times posting an audit event to the webhook is retried
+// Returns the number of retries or error if something goes wrong
+func (service *envConfigurationService) GetAuditWebhookMaxRetries() (int, error) {
+	return getIntWithDefault("AUDIT_WEBHOOK_MAX_RETRIES", 3)
+}
+
+// GetKubernetesClientCacheSize retrieves the maximum number of the edge cluster Kubernetes clients kept in the cache
+// Returns the Kubernetes client cache size or error if something goes wrong
+func (service *envConfigurationService) GetKubernetesClientCacheSize() (int, error) {
+	return getIntWithDefault("KUBERNETES_CLIENT_CACHE_SIZE", 100)
+}
+
+// GetKubernetesRequestTimeout retrieves the time allowed for a single request to the edge cluster Kubernetes API
+// Returns the Kubernetes request timeout or error if something goes wrong
+func (service *envConfigurationService) GetKubernetesRequestTimeout() (time.Duration, error) {
+	return getDurationWithDefault("KUBERNETES_REQUEST_TIMEOUT", 10*time.Second)
+}
+
+// GetKubernetesListPageSize retrieves the number of the objects requested from the edge cluster Kubernetes API in a single list request
+// Returns the Kubernetes list page size or error if something goes wrong
+func (service *envConfigurationService) GetKubernetesListPageSize() (int, error) {
+	return getIntWithDefault("KUBERNETES_LIST_PAGE_SIZE", 100)
+}
+
+// GetKubernetesListMaxItems retrieves the maximum number of the objects read from the edge cluster Kubernetes API for a single field
+// Returns the maximum number of the listed Kubernetes objects or error if something goes wrong
+func (service *envConfigurationService) GetKubernetesListMaxItems() (int, error) {
+	return getIntWithDefault("KUBERNETES_LIST_MAX_ITEMS", 1000)
+}
+
+func getStringWithDefault(name, defaultValue string) string {
+	value := os.Getenv(name)
+	if strings.Trim(value, " ") == "" {
+		return defaultValue
+	}
+
+	return value
+}
+
+func getStringListWithDefault(name, defaultValue string) []string {
+	values := []string{}
+
+	for _, value := range strings.Split(getStringWithDefault(name, defaultValue), ",") {
+		if value = strings.Trim(value, " "); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func getIntWithDefault(name string, defaultValue int) (int, error) {
+	valueString := os.Getenv(name)
+	if strings.Trim(valueString, " ") == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.Atoi(valueString)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to convert %s to integer", name), err)
+	}
+
+	return value, nil
+}
+
+func getFloatWithDefault(name string, defaultValue float64) (float64, error) {
+	valueString := os.Getenv(name)
+	if strings.Trim(valueString, " ") == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseFloat(valueString, 64)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to convert %s to float", name), err)
+	}
+
+	return value, nil
+}
+
+func getDurationWithDefault(name string, defaultValue time.Duration) (time.Duration, error) {
+	valueString := os.Getenv(name)
+	if strings.Trim(valueString, " ") == "" {
+		return defaultValue, nil
+	}
+
+	value, err := time.ParseDuration(valueString)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to convert %s to duration", name), err)
+	}
+
+	return value, nil
+}
+
+func getBoolWithDefault(name string, defaultValue bool) (bool, error) {
+	valueString := os.Getenv(name)
+	if strings.Trim(valueString, " ") == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseBool(valueString)
+	if err != nil {
+		return false, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to convert %s to boolean", name), err)
+	}
+
+	return value, nil
+}
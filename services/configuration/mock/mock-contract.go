@@ -6,6 +6,7 @@ package mock_configuration
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -33,64 +34,829 @@ func (m *MockConfigurationContract) EXPECT() *MockConfigurationContractMockRecor
 	return m.recorder
 }
 
+// GetAuditFileMaxBackups mocks base method.
+func (m *MockConfigurationContract) GetAuditFileMaxBackups() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditFileMaxBackups")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditFileMaxBackups indicates an expected call of GetAuditFileMaxBackups.
+func (mr *MockConfigurationContractMockRecorder) GetAuditFileMaxBackups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditFileMaxBackups", reflect.TypeOf((*MockConfigurationContract)(nil).GetAuditFileMaxBackups))
+}
+
+// GetAuditFileMaxBytes mocks base method.
+func (m *MockConfigurationContract) GetAuditFileMaxBytes() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditFileMaxBytes")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditFileMaxBytes indicates an expected call of GetAuditFileMaxBytes.
+func (mr *MockConfigurationContractMockRecorder) GetAuditFileMaxBytes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditFileMaxBytes", reflect.TypeOf((*MockConfigurationContract)(nil).GetAuditFileMaxBytes))
+}
+
+// GetAuditFilePath mocks base method.
+func (m *MockConfigurationContract) GetAuditFilePath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditFilePath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditFilePath indicates an expected call of GetAuditFilePath.
+func (mr *MockConfigurationContractMockRecorder) GetAuditFilePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditFilePath", reflect.TypeOf((*MockConfigurationContract)(nil).GetAuditFilePath))
+}
+
+// GetAuditRedactedFields mocks base method.
+func (m *MockConfigurationContract) GetAuditRedactedFields() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditRedactedFields")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditRedactedFields indicates an expected call of GetAuditRedactedFields.
+func (mr *MockConfigurationContractMockRecorder) GetAuditRedactedFields() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditRedactedFields", reflect.TypeOf((*MockConfigurationContract)(nil).GetAuditRedactedFields))
+}
+
+// GetAuditSink mocks base method.
+func (m *MockConfigurationContract) GetAuditSink() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditSink")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditSink indicates an expected call of GetAuditSink.
+func (mr *MockConfigurationContractMockRecorder) GetAuditSink() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditSink", reflect.TypeOf((*MockConfigurationContract)(nil).GetAuditSink))
+}
+
+// GetAuditWebhookMaxRetries mocks base method.
+func (m *MockConfigurationContract) GetAuditWebhookMaxRetries() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditWebhookMaxRetries")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditWebhookMaxRetries indicates an expected call of GetAuditWebhookMaxRetries.
+func (mr *MockConfigurationContractMockRecorder) GetAuditWebhookMaxRetries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditWebhookMaxRetries", reflect.TypeOf((*MockConfigurationContract)(nil).GetAuditWebhookMaxRetries))
+}
+
+// GetAuditWebhookTimeout mocks base method.
+func (m *MockConfigurationContract) GetAuditWebhookTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditWebhookTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditWebhookTimeout indicates an expected call of GetAuditWebhookTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetAuditWebhookTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditWebhookTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetAuditWebhookTimeout))
+}
+
+// GetAuditWebhookURL mocks base method.
+func (m *MockConfigurationContract) GetAuditWebhookURL() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditWebhookURL")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditWebhookURL indicates an expected call of GetAuditWebhookURL.
+func (mr *MockConfigurationContractMockRecorder) GetAuditWebhookURL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditWebhookURL", reflect.TypeOf((*MockConfigurationContract)(nil).GetAuditWebhookURL))
+}
+
+// GetCorsAllowCredentials mocks base method.
+func (m *MockConfigurationContract) GetCorsAllowCredentials() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCorsAllowCredentials")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCorsAllowCredentials indicates an expected call of GetCorsAllowCredentials.
+func (mr *MockConfigurationContractMockRecorder) GetCorsAllowCredentials() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCorsAllowCredentials", reflect.TypeOf((*MockConfigurationContract)(nil).GetCorsAllowCredentials))
+}
+
+// GetCorsAllowedHeaders mocks base method.
+func (m *MockConfigurationContract) GetCorsAllowedHeaders() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCorsAllowedHeaders")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCorsAllowedHeaders indicates an expected call of GetCorsAllowedHeaders.
+func (mr *MockConfigurationContractMockRecorder) GetCorsAllowedHeaders() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCorsAllowedHeaders", reflect.TypeOf((*MockConfigurationContract)(nil).GetCorsAllowedHeaders))
+}
+
+// GetCorsAllowedOrigins mocks base method.
+func (m *MockConfigurationContract) GetCorsAllowedOrigins() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCorsAllowedOrigins")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCorsAllowedOrigins indicates an expected call of GetCorsAllowedOrigins.
+func (mr *MockConfigurationContractMockRecorder) GetCorsAllowedOrigins() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCorsAllowedOrigins", reflect.TypeOf((*MockConfigurationContract)(nil).GetCorsAllowedOrigins))
+}
+
+// GetCorsExposedHeaders mocks base method.
+func (m *MockConfigurationContract) GetCorsExposedHeaders() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCorsExposedHeaders")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCorsExposedHeaders indicates an expected call of GetCorsExposedHeaders.
+func (mr *MockConfigurationContractMockRecorder) GetCorsExposedHeaders() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCorsExposedHeaders", reflect.TypeOf((*MockConfigurationContract)(nil).GetCorsExposedHeaders))
+}
+
+// GetCorsMaxAge mocks base method.
+func (m *MockConfigurationContract) GetCorsMaxAge() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCorsMaxAge")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCorsMaxAge indicates an expected call of GetCorsMaxAge.
+func (mr *MockConfigurationContractMockRecorder) GetCorsMaxAge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCorsMaxAge", reflect.TypeOf((*MockConfigurationContract)(nil).GetCorsMaxAge))
+}
+
+// GetDataLoaderMaxBatchSize mocks base method.
+func (m *MockConfigurationContract) GetDataLoaderMaxBatchSize() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataLoaderMaxBatchSize")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataLoaderMaxBatchSize indicates an expected call of GetDataLoaderMaxBatchSize.
+func (mr *MockConfigurationContractMockRecorder) GetDataLoaderMaxBatchSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataLoaderMaxBatchSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetDataLoaderMaxBatchSize))
+}
+
+// GetDataLoaderWait mocks base method.
+func (m *MockConfigurationContract) GetDataLoaderWait() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataLoaderWait")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataLoaderWait indicates an expected call of GetDataLoaderWait.
+func (mr *MockConfigurationContractMockRecorder) GetDataLoaderWait() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataLoaderWait", reflect.TypeOf((*MockConfigurationContract)(nil).GetDataLoaderWait))
+}
+
 // GetEdgeClusterServiceAddress mocks base method.
 func (m *MockConfigurationContract) GetEdgeClusterServiceAddress() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEdgeClusterServiceAddress")
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetEdgeClusterServiceAddress")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEdgeClusterServiceAddress indicates an expected call of GetEdgeClusterServiceAddress.
+func (mr *MockConfigurationContractMockRecorder) GetEdgeClusterServiceAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEdgeClusterServiceAddress", reflect.TypeOf((*MockConfigurationContract)(nil).GetEdgeClusterServiceAddress))
+}
+
+// GetGraphQLRawIDs mocks base method.
+func (m *MockConfigurationContract) GetGraphQLRawIDs() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGraphQLRawIDs")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGraphQLRawIDs indicates an expected call of GetGraphQLRawIDs.
+func (mr *MockConfigurationContractMockRecorder) GetGraphQLRawIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGraphQLRawIDs", reflect.TypeOf((*MockConfigurationContract)(nil).GetGraphQLRawIDs))
+}
+
+// GetGrpcCAFile mocks base method.
+func (m *MockConfigurationContract) GetGrpcCAFile() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcCAFile")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcCAFile indicates an expected call of GetGrpcCAFile.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcCAFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcCAFile", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcCAFile))
+}
+
+// GetGrpcCertFile mocks base method.
+func (m *MockConfigurationContract) GetGrpcCertFile() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcCertFile")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcCertFile indicates an expected call of GetGrpcCertFile.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcCertFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcCertFile", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcCertFile))
+}
+
+// GetGrpcCircuitBreakerFailureThreshold mocks base method.
+func (m *MockConfigurationContract) GetGrpcCircuitBreakerFailureThreshold() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcCircuitBreakerFailureThreshold")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcCircuitBreakerFailureThreshold indicates an expected call of GetGrpcCircuitBreakerFailureThreshold.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcCircuitBreakerFailureThreshold() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcCircuitBreakerFailureThreshold", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcCircuitBreakerFailureThreshold))
+}
+
+// GetGrpcCircuitBreakerHalfOpenMaxRequests mocks base method.
+func (m *MockConfigurationContract) GetGrpcCircuitBreakerHalfOpenMaxRequests() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcCircuitBreakerHalfOpenMaxRequests")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcCircuitBreakerHalfOpenMaxRequests indicates an expected call of GetGrpcCircuitBreakerHalfOpenMaxRequests.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcCircuitBreakerHalfOpenMaxRequests() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcCircuitBreakerHalfOpenMaxRequests", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcCircuitBreakerHalfOpenMaxRequests))
+}
+
+// GetGrpcCircuitBreakerOpenTimeout mocks base method.
+func (m *MockConfigurationContract) GetGrpcCircuitBreakerOpenTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcCircuitBreakerOpenTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcCircuitBreakerOpenTimeout indicates an expected call of GetGrpcCircuitBreakerOpenTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcCircuitBreakerOpenTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcCircuitBreakerOpenTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcCircuitBreakerOpenTimeout))
+}
+
+// GetGrpcDefaultTimeout mocks base method.
+func (m *MockConfigurationContract) GetGrpcDefaultTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcDefaultTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcDefaultTimeout indicates an expected call of GetGrpcDefaultTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcDefaultTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcDefaultTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcDefaultTimeout))
+}
+
+// GetGrpcKeepaliveTime mocks base method.
+func (m *MockConfigurationContract) GetGrpcKeepaliveTime() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcKeepaliveTime")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcKeepaliveTime indicates an expected call of GetGrpcKeepaliveTime.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcKeepaliveTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcKeepaliveTime", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcKeepaliveTime))
+}
+
+// GetGrpcKeepaliveTimeout mocks base method.
+func (m *MockConfigurationContract) GetGrpcKeepaliveTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcKeepaliveTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcKeepaliveTimeout indicates an expected call of GetGrpcKeepaliveTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcKeepaliveTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcKeepaliveTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcKeepaliveTimeout))
+}
+
+// GetGrpcKeyFile mocks base method.
+func (m *MockConfigurationContract) GetGrpcKeyFile() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcKeyFile")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcKeyFile indicates an expected call of GetGrpcKeyFile.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcKeyFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcKeyFile", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcKeyFile))
+}
+
+// GetGrpcMaxConcurrentCalls mocks base method.
+func (m *MockConfigurationContract) GetGrpcMaxConcurrentCalls() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcMaxConcurrentCalls")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcMaxConcurrentCalls indicates an expected call of GetGrpcMaxConcurrentCalls.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcMaxConcurrentCalls() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcMaxConcurrentCalls", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcMaxConcurrentCalls))
+}
+
+// GetGrpcMaxMessageSize mocks base method.
+func (m *MockConfigurationContract) GetGrpcMaxMessageSize() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcMaxMessageSize")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcMaxMessageSize indicates an expected call of GetGrpcMaxMessageSize.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcMaxMessageSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcMaxMessageSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcMaxMessageSize))
+}
+
+// GetGrpcMaxRetries mocks base method.
+func (m *MockConfigurationContract) GetGrpcMaxRetries() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcMaxRetries")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcMaxRetries indicates an expected call of GetGrpcMaxRetries.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcMaxRetries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcMaxRetries", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcMaxRetries))
+}
+
+// GetGrpcMethodTimeouts mocks base method.
+func (m *MockConfigurationContract) GetGrpcMethodTimeouts() (map[string]time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcMethodTimeouts")
+	ret0, _ := ret[0].(map[string]time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcMethodTimeouts indicates an expected call of GetGrpcMethodTimeouts.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcMethodTimeouts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcMethodTimeouts", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcMethodTimeouts))
+}
+
+// GetGrpcRetryBackoff mocks base method.
+func (m *MockConfigurationContract) GetGrpcRetryBackoff() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcRetryBackoff")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcRetryBackoff indicates an expected call of GetGrpcRetryBackoff.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcRetryBackoff() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcRetryBackoff", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcRetryBackoff))
+}
+
+// GetGrpcRetryMaxBackoff mocks base method.
+func (m *MockConfigurationContract) GetGrpcRetryMaxBackoff() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcRetryMaxBackoff")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcRetryMaxBackoff indicates an expected call of GetGrpcRetryMaxBackoff.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcRetryMaxBackoff() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcRetryMaxBackoff", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcRetryMaxBackoff))
+}
+
+// GetGrpcServerNameOverride mocks base method.
+func (m *MockConfigurationContract) GetGrpcServerNameOverride() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcServerNameOverride")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcServerNameOverride indicates an expected call of GetGrpcServerNameOverride.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcServerNameOverride() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcServerNameOverride", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcServerNameOverride))
+}
+
+// GetGrpcTLSEnabled mocks base method.
+func (m *MockConfigurationContract) GetGrpcTLSEnabled() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrpcTLSEnabled")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrpcTLSEnabled indicates an expected call of GetGrpcTLSEnabled.
+func (mr *MockConfigurationContractMockRecorder) GetGrpcTLSEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcTLSEnabled", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcTLSEnabled))
+}
+
+// GetHealthCheckCacheDuration mocks base method.
+func (m *MockConfigurationContract) GetHealthCheckCacheDuration() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealthCheckCacheDuration")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealthCheckCacheDuration indicates an expected call of GetHealthCheckCacheDuration.
+func (mr *MockConfigurationContractMockRecorder) GetHealthCheckCacheDuration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheckCacheDuration", reflect.TypeOf((*MockConfigurationContract)(nil).GetHealthCheckCacheDuration))
+}
+
+// GetHealthCheckTimeout mocks base method.
+func (m *MockConfigurationContract) GetHealthCheckTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealthCheckTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealthCheckTimeout indicates an expected call of GetHealthCheckTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetHealthCheckTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheckTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetHealthCheckTimeout))
+}
+
+// GetHttpHost mocks base method.
+func (m *MockConfigurationContract) GetHttpHost() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHttpHost")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHttpHost indicates an expected call of GetHttpHost.
+func (mr *MockConfigurationContractMockRecorder) GetHttpHost() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpHost", reflect.TypeOf((*MockConfigurationContract)(nil).GetHttpHost))
+}
+
+// GetHttpPort mocks base method.
+func (m *MockConfigurationContract) GetHttpPort() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHttpPort")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHttpPort indicates an expected call of GetHttpPort.
+func (mr *MockConfigurationContractMockRecorder) GetHttpPort() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpPort", reflect.TypeOf((*MockConfigurationContract)(nil).GetHttpPort))
+}
+
+// GetHttpsCertFile mocks base method.
+func (m *MockConfigurationContract) GetHttpsCertFile() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHttpsCertFile")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHttpsCertFile indicates an expected call of GetHttpsCertFile.
+func (mr *MockConfigurationContractMockRecorder) GetHttpsCertFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpsCertFile", reflect.TypeOf((*MockConfigurationContract)(nil).GetHttpsCertFile))
+}
+
+// GetHttpsClientCAFile mocks base method.
+func (m *MockConfigurationContract) GetHttpsClientCAFile() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHttpsClientCAFile")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHttpsClientCAFile indicates an expected call of GetHttpsClientCAFile.
+func (mr *MockConfigurationContractMockRecorder) GetHttpsClientCAFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpsClientCAFile", reflect.TypeOf((*MockConfigurationContract)(nil).GetHttpsClientCAFile))
+}
+
+// GetHttpsKeyFile mocks base method.
+func (m *MockConfigurationContract) GetHttpsKeyFile() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHttpsKeyFile")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHttpsKeyFile indicates an expected call of GetHttpsKeyFile.
+func (mr *MockConfigurationContractMockRecorder) GetHttpsKeyFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpsKeyFile", reflect.TypeOf((*MockConfigurationContract)(nil).GetHttpsKeyFile))
+}
+
+// GetHttpsPort mocks base method.
+func (m *MockConfigurationContract) GetHttpsPort() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHttpsPort")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHttpsPort indicates an expected call of GetHttpsPort.
+func (mr *MockConfigurationContractMockRecorder) GetHttpsPort() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpsPort", reflect.TypeOf((*MockConfigurationContract)(nil).GetHttpsPort))
+}
+
+// GetJwksURL mocks base method.
+func (m *MockConfigurationContract) GetJwksURL() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJwksURL")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJwksURL indicates an expected call of GetJwksURL.
+func (mr *MockConfigurationContractMockRecorder) GetJwksURL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJwksURL", reflect.TypeOf((*MockConfigurationContract)(nil).GetJwksURL))
+}
+
+// GetJwtEmailClaim mocks base method.
+func (m *MockConfigurationContract) GetJwtEmailClaim() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJwtEmailClaim")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJwtEmailClaim indicates an expected call of GetJwtEmailClaim.
+func (mr *MockConfigurationContractMockRecorder) GetJwtEmailClaim() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJwtEmailClaim", reflect.TypeOf((*MockConfigurationContract)(nil).GetJwtEmailClaim))
+}
+
+// GetJwtScopesClaim mocks base method.
+func (m *MockConfigurationContract) GetJwtScopesClaim() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJwtScopesClaim")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJwtScopesClaim indicates an expected call of GetJwtScopesClaim.
+func (mr *MockConfigurationContractMockRecorder) GetJwtScopesClaim() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJwtScopesClaim", reflect.TypeOf((*MockConfigurationContract)(nil).GetJwtScopesClaim))
+}
+
+// GetJwtSubjectClaim mocks base method.
+func (m *MockConfigurationContract) GetJwtSubjectClaim() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJwtSubjectClaim")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJwtSubjectClaim indicates an expected call of GetJwtSubjectClaim.
+func (mr *MockConfigurationContractMockRecorder) GetJwtSubjectClaim() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJwtSubjectClaim", reflect.TypeOf((*MockConfigurationContract)(nil).GetJwtSubjectClaim))
+}
+
+// GetKubernetesClientCacheSize mocks base method.
+func (m *MockConfigurationContract) GetKubernetesClientCacheSize() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubernetesClientCacheSize")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubernetesClientCacheSize indicates an expected call of GetKubernetesClientCacheSize.
+func (mr *MockConfigurationContractMockRecorder) GetKubernetesClientCacheSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesClientCacheSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetKubernetesClientCacheSize))
+}
+
+// GetKubernetesListMaxItems mocks base method.
+func (m *MockConfigurationContract) GetKubernetesListMaxItems() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubernetesListMaxItems")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubernetesListMaxItems indicates an expected call of GetKubernetesListMaxItems.
+func (mr *MockConfigurationContractMockRecorder) GetKubernetesListMaxItems() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesListMaxItems", reflect.TypeOf((*MockConfigurationContract)(nil).GetKubernetesListMaxItems))
+}
+
+// GetKubernetesListPageSize mocks base method.
+func (m *MockConfigurationContract) GetKubernetesListPageSize() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubernetesListPageSize")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubernetesListPageSize indicates an expected call of GetKubernetesListPageSize.
+func (mr *MockConfigurationContractMockRecorder) GetKubernetesListPageSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesListPageSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetKubernetesListPageSize))
+}
+
+// GetKubernetesRequestTimeout mocks base method.
+func (m *MockConfigurationContract) GetKubernetesRequestTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubernetesRequestTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubernetesRequestTimeout indicates an expected call of GetKubernetesRequestTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetKubernetesRequestTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesRequestTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetKubernetesRequestTimeout))
+}
+
+// GetMetricsMaxOperationNames mocks base method.
+func (m *MockConfigurationContract) GetMetricsMaxOperationNames() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetricsMaxOperationNames")
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEdgeClusterServiceAddress indicates an expected call of GetEdgeClusterServiceAddress.
-func (mr *MockConfigurationContractMockRecorder) GetEdgeClusterServiceAddress() *gomock.Call {
+// GetMetricsMaxOperationNames indicates an expected call of GetMetricsMaxOperationNames.
+func (mr *MockConfigurationContractMockRecorder) GetMetricsMaxOperationNames() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEdgeClusterServiceAddress", reflect.TypeOf((*MockConfigurationContract)(nil).GetEdgeClusterServiceAddress))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricsMaxOperationNames", reflect.TypeOf((*MockConfigurationContract)(nil).GetMetricsMaxOperationNames))
 }
 
-// GetHttpHost mocks base method.
-func (m *MockConfigurationContract) GetHttpHost() (string, error) {
+// GetMetricsResolverFields mocks base method.
+func (m *MockConfigurationContract) GetMetricsResolverFields() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHttpHost")
+	ret := m.ctrl.Call(m, "GetMetricsResolverFields")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetricsResolverFields indicates an expected call of GetMetricsResolverFields.
+func (mr *MockConfigurationContractMockRecorder) GetMetricsResolverFields() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricsResolverFields", reflect.TypeOf((*MockConfigurationContract)(nil).GetMetricsResolverFields))
+}
+
+// GetPersistedQueryAllowListFile mocks base method.
+func (m *MockConfigurationContract) GetPersistedQueryAllowListFile() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersistedQueryAllowListFile")
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHttpHost indicates an expected call of GetHttpHost.
-func (mr *MockConfigurationContractMockRecorder) GetHttpHost() *gomock.Call {
+// GetPersistedQueryAllowListFile indicates an expected call of GetPersistedQueryAllowListFile.
+func (mr *MockConfigurationContractMockRecorder) GetPersistedQueryAllowListFile() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpHost", reflect.TypeOf((*MockConfigurationContract)(nil).GetHttpHost))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersistedQueryAllowListFile", reflect.TypeOf((*MockConfigurationContract)(nil).GetPersistedQueryAllowListFile))
 }
 
-// GetHttpPort mocks base method.
-func (m *MockConfigurationContract) GetHttpPort() (int, error) {
+// GetPersistedQueryCacheSize mocks base method.
+func (m *MockConfigurationContract) GetPersistedQueryCacheSize() (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHttpPort")
+	ret := m.ctrl.Call(m, "GetPersistedQueryCacheSize")
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHttpPort indicates an expected call of GetHttpPort.
-func (mr *MockConfigurationContractMockRecorder) GetHttpPort() *gomock.Call {
+// GetPersistedQueryCacheSize indicates an expected call of GetPersistedQueryCacheSize.
+func (mr *MockConfigurationContractMockRecorder) GetPersistedQueryCacheSize() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpPort", reflect.TypeOf((*MockConfigurationContract)(nil).GetHttpPort))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersistedQueryCacheSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetPersistedQueryCacheSize))
 }
 
-// GetJwksURL mocks base method.
-func (m *MockConfigurationContract) GetJwksURL() (string, error) {
+// GetProjectRoleBindingsFile mocks base method.
+func (m *MockConfigurationContract) GetProjectRoleBindingsFile() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetJwksURL")
+	ret := m.ctrl.Call(m, "GetProjectRoleBindingsFile")
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetJwksURL indicates an expected call of GetJwksURL.
-func (mr *MockConfigurationContractMockRecorder) GetJwksURL() *gomock.Call {
+// GetProjectRoleBindingsFile indicates an expected call of GetProjectRoleBindingsFile.
+func (mr *MockConfigurationContractMockRecorder) GetProjectRoleBindingsFile() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJwksURL", reflect.TypeOf((*MockConfigurationContract)(nil).GetJwksURL))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectRoleBindingsFile", reflect.TypeOf((*MockConfigurationContract)(nil).GetProjectRoleBindingsFile))
 }
 
 // GetProjectServiceAddress mocks base method.
@@ -107,3 +873,393 @@ func (mr *MockConfigurationContractMockRecorder) GetProjectServiceAddress() *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectServiceAddress", reflect.TypeOf((*MockConfigurationContract)(nil).GetProjectServiceAddress))
 }
+
+// GetQueryBatchConcurrency mocks base method.
+func (m *MockConfigurationContract) GetQueryBatchConcurrency() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryBatchConcurrency")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryBatchConcurrency indicates an expected call of GetQueryBatchConcurrency.
+func (mr *MockConfigurationContractMockRecorder) GetQueryBatchConcurrency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryBatchConcurrency", reflect.TypeOf((*MockConfigurationContract)(nil).GetQueryBatchConcurrency))
+}
+
+// GetQueryFieldCosts mocks base method.
+func (m *MockConfigurationContract) GetQueryFieldCosts() (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryFieldCosts")
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryFieldCosts indicates an expected call of GetQueryFieldCosts.
+func (mr *MockConfigurationContractMockRecorder) GetQueryFieldCosts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryFieldCosts", reflect.TypeOf((*MockConfigurationContract)(nil).GetQueryFieldCosts))
+}
+
+// GetQueryMaxBatchCost mocks base method.
+func (m *MockConfigurationContract) GetQueryMaxBatchCost() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryMaxBatchCost")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryMaxBatchCost indicates an expected call of GetQueryMaxBatchCost.
+func (mr *MockConfigurationContractMockRecorder) GetQueryMaxBatchCost() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryMaxBatchCost", reflect.TypeOf((*MockConfigurationContract)(nil).GetQueryMaxBatchCost))
+}
+
+// GetQueryMaxBatchSize mocks base method.
+func (m *MockConfigurationContract) GetQueryMaxBatchSize() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryMaxBatchSize")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryMaxBatchSize indicates an expected call of GetQueryMaxBatchSize.
+func (mr *MockConfigurationContractMockRecorder) GetQueryMaxBatchSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryMaxBatchSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetQueryMaxBatchSize))
+}
+
+// GetQueryMaxCost mocks base method.
+func (m *MockConfigurationContract) GetQueryMaxCost() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryMaxCost")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryMaxCost indicates an expected call of GetQueryMaxCost.
+func (mr *MockConfigurationContractMockRecorder) GetQueryMaxCost() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryMaxCost", reflect.TypeOf((*MockConfigurationContract)(nil).GetQueryMaxCost))
+}
+
+// GetQueryMaxDepth mocks base method.
+func (m *MockConfigurationContract) GetQueryMaxDepth() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryMaxDepth")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryMaxDepth indicates an expected call of GetQueryMaxDepth.
+func (mr *MockConfigurationContractMockRecorder) GetQueryMaxDepth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryMaxDepth", reflect.TypeOf((*MockConfigurationContract)(nil).GetQueryMaxDepth))
+}
+
+// GetQueryMaxListSize mocks base method.
+func (m *MockConfigurationContract) GetQueryMaxListSize() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryMaxListSize")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryMaxListSize indicates an expected call of GetQueryMaxListSize.
+func (mr *MockConfigurationContractMockRecorder) GetQueryMaxListSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryMaxListSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetQueryMaxListSize))
+}
+
+// GetRateLimitAddressBurst mocks base method.
+func (m *MockConfigurationContract) GetRateLimitAddressBurst() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitAddressBurst")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitAddressBurst indicates an expected call of GetRateLimitAddressBurst.
+func (mr *MockConfigurationContractMockRecorder) GetRateLimitAddressBurst() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitAddressBurst", reflect.TypeOf((*MockConfigurationContract)(nil).GetRateLimitAddressBurst))
+}
+
+// GetRateLimitAddressRate mocks base method.
+func (m *MockConfigurationContract) GetRateLimitAddressRate() (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitAddressRate")
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitAddressRate indicates an expected call of GetRateLimitAddressRate.
+func (mr *MockConfigurationContractMockRecorder) GetRateLimitAddressRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitAddressRate", reflect.TypeOf((*MockConfigurationContract)(nil).GetRateLimitAddressRate))
+}
+
+// GetRateLimitMutationBurst mocks base method.
+func (m *MockConfigurationContract) GetRateLimitMutationBurst() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitMutationBurst")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitMutationBurst indicates an expected call of GetRateLimitMutationBurst.
+func (mr *MockConfigurationContractMockRecorder) GetRateLimitMutationBurst() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitMutationBurst", reflect.TypeOf((*MockConfigurationContract)(nil).GetRateLimitMutationBurst))
+}
+
+// GetRateLimitMutationRate mocks base method.
+func (m *MockConfigurationContract) GetRateLimitMutationRate() (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitMutationRate")
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitMutationRate indicates an expected call of GetRateLimitMutationRate.
+func (mr *MockConfigurationContractMockRecorder) GetRateLimitMutationRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitMutationRate", reflect.TypeOf((*MockConfigurationContract)(nil).GetRateLimitMutationRate))
+}
+
+// GetRateLimitQueryBurst mocks base method.
+func (m *MockConfigurationContract) GetRateLimitQueryBurst() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitQueryBurst")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitQueryBurst indicates an expected call of GetRateLimitQueryBurst.
+func (mr *MockConfigurationContractMockRecorder) GetRateLimitQueryBurst() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitQueryBurst", reflect.TypeOf((*MockConfigurationContract)(nil).GetRateLimitQueryBurst))
+}
+
+// GetRateLimitQueryRate mocks base method.
+func (m *MockConfigurationContract) GetRateLimitQueryRate() (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitQueryRate")
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitQueryRate indicates an expected call of GetRateLimitQueryRate.
+func (mr *MockConfigurationContractMockRecorder) GetRateLimitQueryRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitQueryRate", reflect.TypeOf((*MockConfigurationContract)(nil).GetRateLimitQueryRate))
+}
+
+// GetRateLimitTrustForwardedFor mocks base method.
+func (m *MockConfigurationContract) GetRateLimitTrustForwardedFor() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitTrustForwardedFor")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitTrustForwardedFor indicates an expected call of GetRateLimitTrustForwardedFor.
+func (mr *MockConfigurationContractMockRecorder) GetRateLimitTrustForwardedFor() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitTrustForwardedFor", reflect.TypeOf((*MockConfigurationContract)(nil).GetRateLimitTrustForwardedFor))
+}
+
+// GetRequestTimeout mocks base method.
+func (m *MockConfigurationContract) GetRequestTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestTimeout indicates an expected call of GetRequestTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetRequestTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetRequestTimeout))
+}
+
+// GetResponseCacheDefaultMaxAge mocks base method.
+func (m *MockConfigurationContract) GetResponseCacheDefaultMaxAge() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResponseCacheDefaultMaxAge")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResponseCacheDefaultMaxAge indicates an expected call of GetResponseCacheDefaultMaxAge.
+func (mr *MockConfigurationContractMockRecorder) GetResponseCacheDefaultMaxAge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResponseCacheDefaultMaxAge", reflect.TypeOf((*MockConfigurationContract)(nil).GetResponseCacheDefaultMaxAge))
+}
+
+// GetResponseCacheEnabled mocks base method.
+func (m *MockConfigurationContract) GetResponseCacheEnabled() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResponseCacheEnabled")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResponseCacheEnabled indicates an expected call of GetResponseCacheEnabled.
+func (mr *MockConfigurationContractMockRecorder) GetResponseCacheEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResponseCacheEnabled", reflect.TypeOf((*MockConfigurationContract)(nil).GetResponseCacheEnabled))
+}
+
+// GetResponseCacheMaxBytes mocks base method.
+func (m *MockConfigurationContract) GetResponseCacheMaxBytes() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResponseCacheMaxBytes")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResponseCacheMaxBytes indicates an expected call of GetResponseCacheMaxBytes.
+func (mr *MockConfigurationContractMockRecorder) GetResponseCacheMaxBytes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResponseCacheMaxBytes", reflect.TypeOf((*MockConfigurationContract)(nil).GetResponseCacheMaxBytes))
+}
+
+// GetShutdownReadinessDelay mocks base method.
+func (m *MockConfigurationContract) GetShutdownReadinessDelay() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShutdownReadinessDelay")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShutdownReadinessDelay indicates an expected call of GetShutdownReadinessDelay.
+func (mr *MockConfigurationContractMockRecorder) GetShutdownReadinessDelay() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShutdownReadinessDelay", reflect.TypeOf((*MockConfigurationContract)(nil).GetShutdownReadinessDelay))
+}
+
+// GetShutdownTimeout mocks base method.
+func (m *MockConfigurationContract) GetShutdownTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShutdownTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShutdownTimeout indicates an expected call of GetShutdownTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetShutdownTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShutdownTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetShutdownTimeout))
+}
+
+// GetSubscriptionMaxPerConnection mocks base method.
+func (m *MockConfigurationContract) GetSubscriptionMaxPerConnection() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionMaxPerConnection")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionMaxPerConnection indicates an expected call of GetSubscriptionMaxPerConnection.
+func (mr *MockConfigurationContractMockRecorder) GetSubscriptionMaxPerConnection() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionMaxPerConnection", reflect.TypeOf((*MockConfigurationContract)(nil).GetSubscriptionMaxPerConnection))
+}
+
+// GetSubscriptionPollInterval mocks base method.
+func (m *MockConfigurationContract) GetSubscriptionPollInterval() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionPollInterval")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionPollInterval indicates an expected call of GetSubscriptionPollInterval.
+func (mr *MockConfigurationContractMockRecorder) GetSubscriptionPollInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionPollInterval", reflect.TypeOf((*MockConfigurationContract)(nil).GetSubscriptionPollInterval))
+}
+
+// GetTracingOtlpEndpoint mocks base method.
+func (m *MockConfigurationContract) GetTracingOtlpEndpoint() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTracingOtlpEndpoint")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTracingOtlpEndpoint indicates an expected call of GetTracingOtlpEndpoint.
+func (mr *MockConfigurationContractMockRecorder) GetTracingOtlpEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTracingOtlpEndpoint", reflect.TypeOf((*MockConfigurationContract)(nil).GetTracingOtlpEndpoint))
+}
+
+// GetTracingOtlpInsecure mocks base method.
+func (m *MockConfigurationContract) GetTracingOtlpInsecure() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTracingOtlpInsecure")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTracingOtlpInsecure indicates an expected call of GetTracingOtlpInsecure.
+func (mr *MockConfigurationContractMockRecorder) GetTracingOtlpInsecure() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTracingOtlpInsecure", reflect.TypeOf((*MockConfigurationContract)(nil).GetTracingOtlpInsecure))
+}
+
+// GetTracingSampler mocks base method.
+func (m *MockConfigurationContract) GetTracingSampler() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTracingSampler")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTracingSampler indicates an expected call of GetTracingSampler.
+func (mr *MockConfigurationContractMockRecorder) GetTracingSampler() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTracingSampler", reflect.TypeOf((*MockConfigurationContract)(nil).GetTracingSampler))
+}
+
+// GetTracingSamplerRatio mocks base method.
+func (m *MockConfigurationContract) GetTracingSamplerRatio() (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTracingSamplerRatio")
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTracingSamplerRatio indicates an expected call of GetTracingSamplerRatio.
+func (mr *MockConfigurationContractMockRecorder) GetTracingSamplerRatio() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTracingSamplerRatio", reflect.TypeOf((*MockConfigurationContract)(nil).GetTracingSamplerRatio))
+}
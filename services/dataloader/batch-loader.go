@@ -0,0 +1,134 @@
+// Package dataloader implements the per-request loaders that batch the project and edge cluster lookups sent to the backend services
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// batchFetchFunc fetches the values of all the given keys in a single call. Keys missing from the returned map are reported as not found.
+type batchFetchFunc func(ctx context.Context, keys []string) (map[string]interface{}, error)
+
+// notFoundErrorFunc creates the error returned for a key that the backend service did not return
+type notFoundErrorFunc func(key string) error
+
+type loadResult struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+type batch struct {
+	ctx     context.Context
+	keys    []string
+	results map[string]*loadResult
+	timer   *time.Timer
+}
+
+type batchLoader struct {
+	name          string
+	wait          time.Duration
+	maxBatchSize  int
+	fetch         batchFetchFunc
+	notFoundError notFoundErrorFunc
+	lock          sync.Mutex
+	cache         map[string]*loadResult
+	pending       *batch
+}
+
+func newBatchLoader(
+	name string,
+	wait time.Duration,
+	maxBatchSize int,
+	fetch batchFetchFunc,
+	notFoundError notFoundErrorFunc) *batchLoader {
+	return &batchLoader{
+		name:          name,
+		wait:          wait,
+		maxBatchSize:  maxBatchSize,
+		fetch:         fetch,
+		notFoundError: notFoundError,
+		cache:         map[string]*loadResult{},
+	}
+}
+
+// load returns the value of the given key. The key is either served from the cache or added to the pending batch
+// that is sent to the backend service once the wait time elapses or the batch is full.
+func (loader *batchLoader) load(ctx context.Context, key string) (interface{}, error) {
+	loader.lock.Lock()
+
+	result, ok := loader.cache[key]
+	if ok {
+		loader.lock.Unlock()
+		cacheHitsCounter.WithLabelValues(loader.name).Inc()
+	} else {
+		result = &loadResult{done: make(chan struct{})}
+		loader.cache[key] = result
+		loader.enqueue(ctx, key, result)
+		loader.lock.Unlock()
+	}
+
+	select {
+	case <-result.done:
+		return result.value, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// enqueue adds the key to the pending batch. The caller must hold the lock.
+func (loader *batchLoader) enqueue(ctx context.Context, key string, result *loadResult) {
+	if loader.pending == nil {
+		pending := &batch{
+			ctx:     ctx,
+			results: map[string]*loadResult{},
+		}
+
+		pending.timer = time.AfterFunc(loader.wait, func() {
+			loader.lock.Lock()
+			if loader.pending != pending {
+				loader.lock.Unlock()
+
+				return
+			}
+
+			loader.pending = nil
+			loader.lock.Unlock()
+
+			loader.dispatch(pending)
+		})
+
+		loader.pending = pending
+	}
+
+	pending := loader.pending
+	pending.keys = append(pending.keys, key)
+	pending.results[key] = result
+
+	if len(pending.keys) >= loader.maxBatchSize {
+		pending.timer.Stop()
+		loader.pending = nil
+
+		go loader.dispatch(pending)
+	}
+}
+
+func (loader *batchLoader) dispatch(pending *batch) {
+	batchesCounter.WithLabelValues(loader.name).Inc()
+	batchSizeHistogram.WithLabelValues(loader.name).Observe(float64(len(pending.keys)))
+
+	values, err := loader.fetch(pending.ctx, pending.keys)
+
+	for key, result := range pending.results {
+		if err != nil {
+			result.err = err
+		} else if value, ok := values[key]; ok {
+			result.value = value
+		} else {
+			result.err = loader.notFoundError(key)
+		}
+
+		close(result.done)
+	}
+}
@@ -0,0 +1,23 @@
+// Package dataloader implements the per-request loaders that batch the project and edge cluster lookups sent to the backend services
+package dataloader
+
+import "context"
+
+type dataLoaderContextKey struct{}
+
+// NewContext returns a copy of the parent context that carries the given data loader
+// ctx: Mandatory. Reference to the parent context
+// dataLoader: Mandatory. The data loader created for the current request
+// Returns the new context
+func NewContext(ctx context.Context, dataLoader DataLoaderContract) context.Context {
+	return context.WithValue(ctx, dataLoaderContextKey{}, dataLoader)
+}
+
+// FromContext retrieves the data loader stored in the context
+// ctx: Mandatory. Reference to the context
+// Returns the data loader and true if the data loader exists in the context, otherwise returns nil and false
+func FromContext(ctx context.Context) (DataLoaderContract, bool) {
+	dataLoader, ok := ctx.Value(dataLoaderContextKey{}).(DataLoaderContract)
+
+	return dataLoader, ok && dataLoader != nil
+}
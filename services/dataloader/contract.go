@@ -0,0 +1,33 @@
+// Package dataloader implements the per-request loaders that batch the project and edge cluster lookups sent to the backend services
+package dataloader
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+)
+
+// DataLoaderContract declares the loader that collects the project and edge cluster lookups made while resolving
+// a single GraphQL request and sends them to the backend services in batches. Loaded values are cached for the
+// lifetime of the loader.
+type DataLoaderContract interface {
+	// LoadProject loads the project with the given unique identifier
+	// ctx: Mandatory. Reference to the context
+	// projectID: Mandatory. The project unique identifier
+	// Returns the project details or error if something goes wrong
+	LoadProject(ctx context.Context, projectID string) (*project.ProjectDetail, error)
+
+	// LoadEdgeCluster loads the edge cluster with the given unique identifier
+	// ctx: Mandatory. Reference to the context
+	// edgeClusterID: Mandatory. The edge cluster unique identifier
+	// Returns the edge cluster details or error if something goes wrong
+	LoadEdgeCluster(ctx context.Context, edgeClusterID string) (*edgecluster.EdgeClusterDetail, error)
+}
+
+// DataLoaderFactoryContract declares the service that creates new data loaders
+type DataLoaderFactoryContract interface {
+	// NewDataLoader creates new data loader with an empty cache. A new data loader should be created for every request.
+	// Returns the new data loader
+	NewDataLoader() DataLoaderContract
+}
@@ -0,0 +1,188 @@
+// Package dataloader implements the per-request loaders that batch the project and edge cluster lookups sent to the backend services
+package dataloader
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	edgeClusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
+	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+const (
+	projectLoaderName     = "project"
+	edgeClusterLoaderName = "edgeCluster"
+)
+
+type grpcDataLoaderFactory struct {
+	projectClientService     project.ProjectClientContract
+	edgeClusterClientService edgecluster.EdgeClusterClientContract
+	wait                     time.Duration
+	maxBatchSize             int
+}
+
+type grpcDataLoader struct {
+	projectLoader     *batchLoader
+	edgeClusterLoader *batchLoader
+}
+
+// NewGrpcDataLoaderFactory creates new instance of the grpcDataLoaderFactory, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// projectClientService: Mandatory. Reference to the project client service
+// edgeClusterClientService: Mandatory. Reference to the edge cluster client service
+// Returns the new service or error if something goes wrong
+func NewGrpcDataLoaderFactory(
+	configurationService configuration.ConfigurationContract,
+	projectClientService project.ProjectClientContract,
+	edgeClusterClientService edgecluster.EdgeClusterClientContract) (DataLoaderFactoryContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if projectClientService == nil {
+		return nil, commonErrors.NewArgumentNilError("projectClientService", "projectClientService is required")
+	}
+
+	if edgeClusterClientService == nil {
+		return nil, commonErrors.NewArgumentNilError("edgeClusterClientService", "edgeClusterClientService is required")
+	}
+
+	wait, err := configurationService.GetDataLoaderWait()
+	if err != nil {
+		return nil, err
+	}
+
+	maxBatchSize, err := configurationService.GetDataLoaderMaxBatchSize()
+	if err != nil {
+		return nil, err
+	}
+
+	if maxBatchSize <= 0 {
+		return nil, commonErrors.NewUnknownError("DATALOADER_MAX_BATCH_SIZE must be greater than zero")
+	}
+
+	return &grpcDataLoaderFactory{
+		projectClientService:     projectClientService,
+		edgeClusterClientService: edgeClusterClientService,
+		wait:                     wait,
+		maxBatchSize:             maxBatchSize,
+	}, nil
+}
+
+// NewDataLoader creates new data loader with an empty cache. A new data loader should be created for every request.
+// Returns the new data loader
+func (factory *grpcDataLoaderFactory) NewDataLoader() DataLoaderContract {
+	return &grpcDataLoader{
+		projectLoader: newBatchLoader(
+			projectLoaderName,
+			factory.wait,
+			factory.maxBatchSize,
+			factory.fetchProjects,
+			func(projectID string) error {
+				return commonErrors.NewNotFoundErrorWithError(fmt.Errorf("project not found, projectID: %s", projectID))
+			}),
+		edgeClusterLoader: newBatchLoader(
+			edgeClusterLoaderName,
+			factory.wait,
+			factory.maxBatchSize,
+			factory.fetchEdgeClusters,
+			func(edgeClusterID string) error {
+				return commonErrors.NewNotFoundErrorWithError(fmt.Errorf("edge cluster not found, edgeClusterID: %s", edgeClusterID))
+			}),
+	}
+}
+
+// LoadProject loads the project with the given unique identifier
+// ctx: Mandatory. Reference to the context
+// projectID: Mandatory. The project unique identifier
+// Returns the project details or error if something goes wrong
+func (loader *grpcDataLoader) LoadProject(ctx context.Context, projectID string) (*project.ProjectDetail, error) {
+	value, err := loader.projectLoader.load(ctx, projectID)
+	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	return value.(*project.ProjectDetail), nil
+}
+
+// LoadEdgeCluster loads the edge cluster with the given unique identifier
+// ctx: Mandatory. Reference to the context
+// edgeClusterID: Mandatory. The edge cluster unique identifier
+// Returns the edge cluster details or error if something goes wrong
+func (loader *grpcDataLoader) LoadEdgeCluster(ctx context.Context, edgeClusterID string) (*edgecluster.EdgeClusterDetail, error) {
+	value, err := loader.edgeClusterLoader.load(ctx, edgeClusterID)
+	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	return value.(*edgecluster.EdgeClusterDetail), nil
+}
+
+func (factory *grpcDataLoaderFactory) fetchProjects(ctx context.Context, projectIDs []string) (map[string]interface{}, error) {
+	projectServiceClient := factory.projectClientService.GetClient()
+
+	response, err := projectServiceClient.ListProjects(
+		ctx,
+		&projectGrpcContract.ListProjectsRequest{
+			Pagination: &projectGrpcContract.Pagination{
+				HasFirst: true,
+				First:    int32(len(projectIDs)),
+			},
+			SortingOptions: []*projectGrpcContract.SortingOptionPair{},
+			ProjectIDs:     projectIDs,
+		})
+	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	if err := errortranslation.FromProjectError(response.Error, response.ErrorMessage); err != nil {
+		return nil, err
+	}
+
+	projects := make(map[string]interface{}, len(response.Projects))
+	for _, item := range response.Projects {
+		projects[item.ProjectID] = &project.ProjectDetail{
+			Project: item.Project,
+		}
+	}
+
+	return projects, nil
+}
+
+func (factory *grpcDataLoaderFactory) fetchEdgeClusters(ctx context.Context, edgeClusterIDs []string) (map[string]interface{}, error) {
+	edgeClusterServiceClient := factory.edgeClusterClientService.GetClient()
+
+	response, err := edgeClusterServiceClient.ListEdgeClusters(
+		ctx,
+		&edgeClusterGrpcContract.ListEdgeClustersRequest{
+			Pagination: &edgeClusterGrpcContract.Pagination{
+				HasFirst: true,
+				First:    int32(len(edgeClusterIDs)),
+			},
+			SortingOptions: []*edgeClusterGrpcContract.SortingOptionPair{},
+			EdgeClusterIDs: edgeClusterIDs,
+		})
+	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	if err := errortranslation.FromEdgeClusterError(response.Error, response.ErrorMessage); err != nil {
+		return nil, err
+	}
+
+	edgeClusters := make(map[string]interface{}, len(response.EdgeClusters))
+	for _, item := range response.EdgeClusters {
+		edgeClusters[item.EdgeClusterID] = &edgecluster.EdgeClusterDetail{
+			EdgeCluster:      item.EdgeCluster,
+			ProvisionDetails: item.ProvisionDetail,
+		}
+	}
+
+	return edgeClusters, nil
+}
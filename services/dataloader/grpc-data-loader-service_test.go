@@ -0,0 +1,198 @@
+package dataloader_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/dataloader"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeProjectServiceClient returns a project for every requested identifier except the missing one, and records the
+// identifiers requested in every call
+type fakeProjectServiceClient struct {
+	projectGrpcContract.ServiceClient
+	mutex   sync.Mutex
+	calls   [][]string
+	failure error
+}
+
+func (client *fakeProjectServiceClient) ListProjects(
+	ctx context.Context,
+	in *projectGrpcContract.ListProjectsRequest,
+	opts ...grpc.CallOption) (*projectGrpcContract.ListProjectsResponse, error) {
+	client.mutex.Lock()
+	projectIDs := append([]string{}, in.ProjectIDs...)
+	sort.Strings(projectIDs)
+	client.calls = append(client.calls, projectIDs)
+	client.mutex.Unlock()
+
+	if client.failure != nil {
+		return nil, client.failure
+	}
+
+	response := &projectGrpcContract.ListProjectsResponse{}
+	for _, projectID := range in.ProjectIDs {
+		if projectID == "missing" {
+			continue
+		}
+
+		response.Projects = append(response.Projects, &projectGrpcContract.ProjectWithCursor{
+			ProjectID: projectID,
+			Project:   &projectGrpcContract.Project{Name: "name of " + projectID},
+		})
+	}
+
+	return response, nil
+}
+
+func (client *fakeProjectServiceClient) getCalls() [][]string {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	return client.calls
+}
+
+type fakeProjectClientService struct {
+	project.ProjectClientContract
+	client *fakeProjectServiceClient
+}
+
+func (service *fakeProjectClientService) GetClient() projectGrpcContract.ServiceClient {
+	return service.client
+}
+
+type fakeEdgeClusterClientService struct {
+	edgecluster.EdgeClusterClientContract
+}
+
+func TestGrpcDataLoader_BatchesAndCachesTheProjectLookups(t *testing.T) {
+	client := &fakeProjectServiceClient{}
+	dataLoader := newDataLoader(t, client, 10)
+
+	results := loadProjects(dataLoader, "p1", "p2", "p1", "missing")
+
+	for _, projectID := range []string{"p1", "p2"} {
+		if results[projectID].err != nil || results[projectID].project.Project.Name != "name of "+projectID {
+			t.Fatalf("expected the %s project to be loaded, got %v", projectID, results[projectID])
+		}
+	}
+
+	if !errortranslation.IsNotFound(results["missing"].err) {
+		t.Fatalf("expected the missing project to be reported as not found, got %v", results["missing"].err)
+	}
+
+	if calls := client.getCalls(); fmt.Sprint(calls) != "[[missing p1 p2]]" {
+		t.Fatalf("expected the distinct identifiers to be requested in a single call, got %v", calls)
+	}
+
+	if _, err := dataLoader.LoadProject(context.Background(), "p2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls := client.getCalls(); len(calls) != 1 {
+		t.Fatalf("expected the loaded project to be served from the cache, got %v", calls)
+	}
+}
+
+func TestGrpcDataLoader_SplitsTheBatchesAtTheMaxBatchSize(t *testing.T) {
+	client := &fakeProjectServiceClient{}
+	dataLoader := newDataLoader(t, client, 2)
+
+	results := loadProjects(dataLoader, "p1", "p2", "p3")
+	for projectID, result := range results {
+		if result.err != nil {
+			t.Fatalf("expected the %s project to be loaded, got %v", projectID, result.err)
+		}
+	}
+
+	calls := client.getCalls()
+	if len(calls) != 2 || len(calls[0])+len(calls[1]) != 3 {
+		t.Fatalf("expected the identifiers to be requested in two calls, got %v", calls)
+	}
+
+	for _, call := range calls {
+		if len(call) > 2 {
+			t.Fatalf("expected no call to exceed the maximum batch size, got %v", calls)
+		}
+	}
+}
+
+func TestGrpcDataLoader_ReportsTheBackendFailureToEveryLookup(t *testing.T) {
+	client := &fakeProjectServiceClient{failure: status.Error(codes.Unavailable, "project service is not available")}
+	dataLoader := newDataLoader(t, client, 10)
+
+	for projectID, result := range loadProjects(dataLoader, "p1", "p2") {
+		var extendedErr interface{ Extensions() map[string]interface{} }
+		if !errors.As(result.err, &extendedErr) || extendedErr.Extensions()["code"] != errortranslation.CodeUnavailable {
+			t.Fatalf("expected the %s project lookup to fail with the %s error code, got %v", projectID, errortranslation.CodeUnavailable, result.err)
+		}
+	}
+
+	if calls := client.getCalls(); len(calls) != 1 {
+		t.Fatalf("expected a single call, got %v", calls)
+	}
+}
+
+type loadProjectResult struct {
+	project *project.ProjectDetail
+	err     error
+}
+
+// loadProjects loads the projects concurrently and returns the result of every lookup by the project identifier
+func loadProjects(dataLoader dataloader.DataLoaderContract, projectIDs ...string) map[string]loadProjectResult {
+	var mutex sync.Mutex
+	var waitGroup sync.WaitGroup
+
+	results := map[string]loadProjectResult{}
+
+	for _, projectID := range projectIDs {
+		waitGroup.Add(1)
+
+		go func(projectID string) {
+			defer waitGroup.Done()
+
+			projectDetail, err := dataLoader.LoadProject(context.Background(), projectID)
+
+			mutex.Lock()
+			results[projectID] = loadProjectResult{project: projectDetail, err: err}
+			mutex.Unlock()
+		}(projectID)
+	}
+
+	waitGroup.Wait()
+
+	return results
+}
+
+func newDataLoader(t *testing.T, client *fakeProjectServiceClient, maxBatchSize int) dataloader.DataLoaderContract {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetDataLoaderWait().Return(20*time.Millisecond, nil)
+	configurationService.EXPECT().GetDataLoaderMaxBatchSize().Return(maxBatchSize, nil)
+
+	factory, err := dataloader.NewGrpcDataLoaderFactory(
+		configurationService,
+		&fakeProjectClientService{client: client},
+		&fakeEdgeClusterClientService{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return factory.NewDataLoader()
+}
@@ -0,0 +1,31 @@
+// Package dataloader implements the per-request loaders that batch the project and edge cluster lookups sent to the backend services
+package dataloader
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	cacheHitsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "api_gateway_dataloader_cache_hits_total",
+			Help: "The number of keys served from the data loader cache without contacting the backend service",
+		},
+		[]string{"loader"})
+
+	batchesCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "api_gateway_dataloader_batches_total",
+			Help: "The number of batched requests sent to the backend service",
+		},
+		[]string{"loader"})
+
+	batchSizeHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "api_gateway_dataloader_batch_size",
+			Help:    "The number of keys sent to the backend service in a single batch",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500},
+		},
+		[]string{"loader"})
+)
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/dataloader/contract.go
+
+// Package mock_dataloader is a generated GoMock package.
+package mock_dataloader
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dataloader "github.com/decentralized-cloud/api-gateway/services/dataloader"
+	edgecluster "github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	project "github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataLoaderContract is a mock of DataLoaderContract interface.
+type MockDataLoaderContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataLoaderContractMockRecorder
+}
+
+// MockDataLoaderContractMockRecorder is the mock recorder for MockDataLoaderContract.
+type MockDataLoaderContractMockRecorder struct {
+	mock *MockDataLoaderContract
+}
+
+// NewMockDataLoaderContract creates a new mock instance.
+func NewMockDataLoaderContract(ctrl *gomock.Controller) *MockDataLoaderContract {
+	mock := &MockDataLoaderContract{ctrl: ctrl}
+	mock.recorder = &MockDataLoaderContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataLoaderContract) EXPECT() *MockDataLoaderContractMockRecorder {
+	return m.recorder
+}
+
+// LoadEdgeCluster mocks base method.
+func (m *MockDataLoaderContract) LoadEdgeCluster(ctx context.Context, edgeClusterID string) (*edgecluster.EdgeClusterDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadEdgeCluster", ctx, edgeClusterID)
+	ret0, _ := ret[0].(*edgecluster.EdgeClusterDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadEdgeCluster indicates an expected call of LoadEdgeCluster.
+func (mr *MockDataLoaderContractMockRecorder) LoadEdgeCluster(ctx, edgeClusterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadEdgeCluster", reflect.TypeOf((*MockDataLoaderContract)(nil).LoadEdgeCluster), ctx, edgeClusterID)
+}
+
+// LoadProject mocks base method.
+func (m *MockDataLoaderContract) LoadProject(ctx context.Context, projectID string) (*project.ProjectDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadProject", ctx, projectID)
+	ret0, _ := ret[0].(*project.ProjectDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadProject indicates an expected call of LoadProject.
+func (mr *MockDataLoaderContractMockRecorder) LoadProject(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadProject", reflect.TypeOf((*MockDataLoaderContract)(nil).LoadProject), ctx, projectID)
+}
+
+// MockDataLoaderFactoryContract is a mock of DataLoaderFactoryContract interface.
+type MockDataLoaderFactoryContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataLoaderFactoryContractMockRecorder
+}
+
+// MockDataLoaderFactoryContractMockRecorder is the mock recorder for MockDataLoaderFactoryContract.
+type MockDataLoaderFactoryContractMockRecorder struct {
+	mock *MockDataLoaderFactoryContract
+}
+
+// NewMockDataLoaderFactoryContract creates a new mock instance.
+func NewMockDataLoaderFactoryContract(ctrl *gomock.Controller) *MockDataLoaderFactoryContract {
+	mock := &MockDataLoaderFactoryContract{ctrl: ctrl}
+	mock.recorder = &MockDataLoaderFactoryContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataLoaderFactoryContract) EXPECT() *MockDataLoaderFactoryContractMockRecorder {
+	return m.recorder
+}
+
+// NewDataLoader mocks base method.
+func (m *MockDataLoaderFactoryContract) NewDataLoader() dataloader.DataLoaderContract {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDataLoader")
+	ret0, _ := ret[0].(dataloader.DataLoaderContract)
+	return ret0
+}
+
+// NewDataLoader indicates an expected call of NewDataLoader.
+func (mr *MockDataLoaderFactoryContractMockRecorder) NewDataLoader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDataLoader", reflect.TypeOf((*MockDataLoaderFactoryContract)(nil).NewDataLoader))
+}
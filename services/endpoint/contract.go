@@ -3,10 +3,29 @@ package endpoint
 
 import "github.com/go-kit/kit/endpoint"
 
+const (
+	// ErrorCodeOperationNotAllowed is reported when a mutation or a subscription is requested in a request that only
+	// allows queries
+	ErrorCodeOperationNotAllowed = "OPERATION_NOT_ALLOWED"
+
+	// ErrorCodeMaxBatchSizeExceeded is reported when a batched request contains more operations than the configured maximum batch size
+	ErrorCodeMaxBatchSizeExceeded = "MAX_BATCH_SIZE_EXCEEDED"
+
+	// ErrorCodeMaxBatchCostExceeded is reported when the total cost of the operations of a batched request exceeds the configured
+	// maximum batch cost
+	ErrorCodeMaxBatchCostExceeded = "MAX_BATCH_COST_EXCEEDED"
+)
+
 // EndpointCreatorContract declares the contract that creates endpoints to create new edgeCluster,
 // read, update and delete existing edgeClusters.
 type EndpointCreatorContract interface {
-	// GraphQLEndpoint creates GraphQL endpoint
+	// GraphQLEndpoint creates GraphQL endpoint. The endpoint executes either a single GraphQL request or a batch of
+	// GraphQL requests, in which case the response is the list of the GraphQL responses in the order of the requests.
 	// Returns the GraphQL endpoint
 	GraphQLEndpoint() endpoint.Endpoint
+
+	// GraphQLSubscriptionEndpoint creates GraphQL subscription endpoint. The endpoint response is the channel
+	// that receives the GraphQL responses until the subscription completes or the context is done.
+	// Returns the GraphQL subscription endpoint
+	GraphQLSubscriptionEndpoint() endpoint.Endpoint
 }
@@ -1 +0,0 @@
-package endpoint_test
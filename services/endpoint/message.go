@@ -2,14 +2,28 @@
 package endpoint
 
 import (
+	"github.com/decentralized-cloud/api-gateway/services/persistedquery"
 	"github.com/graph-gophers/graphql-go"
 )
 
-// GraphQLRequest contains the request to process the GraphQL request
+// GraphQLRequest contains the request to process the GraphQL request. QueriesOnly is set by the transport when the
+// request is received in a way that must not change any data, such as a HTTP GET request.
 type GraphQLRequest struct {
-	Query         string                 `json:"query"`
-	OperationName string                 `json:"operationName"`
-	Variables     map[string]interface{} `json:"variables"`
+	Query         string                    `json:"query"`
+	OperationName string                    `json:"operationName"`
+	Variables     map[string]interface{}    `json:"variables"`
+	Extensions    *GraphQLRequestExtensions `json:"extensions,omitempty"`
+	QueriesOnly   bool                      `json:"-"`
+}
+
+// GraphQLBatchRequest contains the GraphQL requests sent together in a single batched request
+type GraphQLBatchRequest struct {
+	Requests []*GraphQLRequest
+}
+
+// GraphQLRequestExtensions contains the extensions the client can send along with the GraphQL request
+type GraphQLRequestExtensions struct {
+	PersistedQuery *persistedquery.PersistedQuery `json:"persistedQuery,omitempty"`
 }
 
 // GraphQLResponse contains the result of processing the GraphQL request
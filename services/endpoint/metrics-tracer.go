@@ -0,0 +1,69 @@
+// Package endpoint implements different endpoint services required by the api-gateway service
+package endpoint
+
+import (
+	"context"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/introspection"
+	gqltrace "github.com/graph-gophers/graphql-go/trace"
+)
+
+// metricsTracer reports the time taken to resolve the monitored fields and passes all the tracing calls to the wrapped tracer
+type metricsTracer struct {
+	tracer          gqltrace.Tracer
+	monitoredFields map[string]struct{}
+}
+
+func newMetricsTracer(tracer gqltrace.Tracer, monitoredFields []string) *metricsTracer {
+	fields := map[string]struct{}{}
+	for _, field := range monitoredFields {
+		fields[field] = struct{}{}
+	}
+
+	return &metricsTracer{
+		tracer:          tracer,
+		monitoredFields: fields,
+	}
+}
+
+// TraceQuery passes the call to the wrapped tracer
+func (tracer *metricsTracer) TraceQuery(
+	ctx context.Context,
+	queryString string,
+	operationName string,
+	variables map[string]interface{},
+	varTypes map[string]*introspection.Type) (context.Context, gqltrace.TraceQueryFinishFunc) {
+	return tracer.tracer.TraceQuery(ctx, queryString, operationName, variables, varTypes)
+}
+
+// TraceField passes the call to the wrapped tracer and reports the time taken to resolve the field if it is monitored
+func (tracer *metricsTracer) TraceField(
+	ctx context.Context,
+	label string,
+	typeName string,
+	fieldName string,
+	trivial bool,
+	args map[string]interface{}) (context.Context, gqltrace.TraceFieldFinishFunc) {
+	ctx, finish := tracer.tracer.TraceField(ctx, label, typeName, fieldName, trivial, args)
+	if _, ok := tracer.monitoredFields[typeName+"."+fieldName]; !ok {
+		return ctx, finish
+	}
+
+	start := time.Now()
+
+	return ctx, func(queryError *gqlerrors.QueryError) {
+		resolverDurationHistogram.WithLabelValues(typeName, fieldName).Observe(time.Since(start).Seconds())
+		finish(queryError)
+	}
+}
+
+// TraceValidation passes the call to the wrapped tracer if it traces the validation
+func (tracer *metricsTracer) TraceValidation(ctx context.Context) gqltrace.TraceValidationFinishFunc {
+	if validationTracer, ok := tracer.tracer.(gqltrace.ValidationTracerContext); ok {
+		return validationTracer.TraceValidation(ctx)
+	}
+
+	return func([]*gqlerrors.QueryError) {}
+}
@@ -0,0 +1,101 @@
+// Package endpoint implements different endpoint services required by the api-gateway service
+package endpoint
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// anonymousOperationName is reported for the operations that are not named
+	anonymousOperationName = "anonymous"
+
+	// otherOperationName is reported for the operations named after the maximum number of operation names is reached
+	otherOperationName = "other"
+
+	// unknownOperationName is reported for the requests rejected before the operation is analysed
+	unknownOperationName = "unknown"
+
+	// unknownOperationType is reported for the requests rejected before the operation type is known
+	unknownOperationType = "unknown"
+
+	// unknownErrorCode is reported for the errors that do not carry an error code
+	unknownErrorCode = "UNKNOWN"
+)
+
+var (
+	requestDurationHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "api_gateway_graphql_request_duration_seconds",
+			Help:    "The time taken to execute the GraphQL requests",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"operation_name", "operation_type"})
+
+	errorsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "api_gateway_graphql_errors_total",
+			Help: "The number of errors reported in the GraphQL responses",
+		},
+		[]string{"code"})
+
+	resolverDurationHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "api_gateway_graphql_resolver_duration_seconds",
+			Help:    "The time taken to resolve the monitored GraphQL fields",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"type", "field"})
+)
+
+// operationNameLabels bounds the number of distinct operation names reported in the metrics. The operation names are
+// chosen by the clients, so only the first names registered are reported as is and the rest are reported as other.
+type operationNameLabels struct {
+	lock     sync.RWMutex
+	maxNames int
+	names    map[string]struct{}
+}
+
+func newOperationNameLabels(maxNames int) *operationNameLabels {
+	return &operationNameLabels{
+		maxNames: maxNames,
+		names:    map[string]struct{}{},
+	}
+}
+
+// label returns the label value the operation name is reported with. The operation name is registered if it is not
+// registered yet, the register flag is set and the maximum number of operation names is not reached.
+func (labels *operationNameLabels) label(operationName string, register bool) string {
+	if operationName == "" {
+		return anonymousOperationName
+	}
+
+	labels.lock.RLock()
+	_, ok := labels.names[operationName]
+	labels.lock.RUnlock()
+
+	if ok {
+		return operationName
+	}
+
+	if !register {
+		return otherOperationName
+	}
+
+	labels.lock.Lock()
+	defer labels.lock.Unlock()
+
+	if _, ok := labels.names[operationName]; ok {
+		return operationName
+	}
+
+	if len(labels.names) >= labels.maxNames {
+		return otherOperationName
+	}
+
+	labels.names[operationName] = struct{}{}
+
+	return operationName
+}
@@ -47,3 +47,17 @@ func (mr *MockEndpointCreatorContractMockRecorder) GraphQLEndpoint() *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GraphQLEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).GraphQLEndpoint))
 }
+
+// GraphQLSubscriptionEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) GraphQLSubscriptionEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GraphQLSubscriptionEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// GraphQLSubscriptionEndpoint indicates an expected call of GraphQLSubscriptionEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) GraphQLSubscriptionEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GraphQLSubscriptionEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).GraphQLSubscriptionEndpoint))
+}
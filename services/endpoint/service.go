@@ -3,27 +3,83 @@ package endpoint
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"github.com/decentralized-cloud/api-gateway/services/dataloader"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/persistedquery"
+	"github.com/decentralized-cloud/api-gateway/services/querylimit"
+	"github.com/decentralized-cloud/api-gateway/services/responsecache"
+	"github.com/decentralized-cloud/api-gateway/services/tracing"
+	"github.com/decentralized-cloud/api-gateway/services/warning"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/gobuffalo/packr"
 	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.uber.org/zap"
 )
 
+// subscribeResolverTimeout is the maximum time allowed to resolve the fields of a single subscription event
+const subscribeResolverTimeout = 10 * time.Second
+
 type endpointCreatorService struct {
-	schema *graphql.Schema
+	logger                *zap.Logger
+	schema                *graphql.Schema
+	dataLoaderFactory     dataloader.DataLoaderFactoryContract
+	queryLimitService     querylimit.QueryLimitContract
+	persistedQueryService persistedquery.PersistedQueryContract
+	responseCacheService  responsecache.ResponseCacheContract
+	operationNameLabels   *operationNameLabels
+	maxBatchSize          int
+	maxBatchCost          int
+	batchConcurrency      int
 }
 
 // NewEndpointCreatorService creates new instance of the EndpointCreatorService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
+// dataLoaderFactory: Mandatory. Reference to the factory that creates the per-request data loaders
+// persistedQueryService: Mandatory. Reference to the service that resolves the persisted queries
+// responseCacheService: Mandatory. Reference to the service that caches the responses of the query operations
 // Returns the new service or error if something goes wrong
 func NewEndpointCreatorService(
-	resolverCreator types.ResolverCreatorContract) (EndpointCreatorContract, error) {
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	resolverCreator types.ResolverCreatorContract,
+	dataLoaderFactory dataloader.DataLoaderFactoryContract,
+	persistedQueryService persistedquery.PersistedQueryContract,
+	responseCacheService responsecache.ResponseCacheContract) (EndpointCreatorContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
 
 	if resolverCreator == nil {
 		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
 	}
 
+	if dataLoaderFactory == nil {
+		return nil, commonErrors.NewArgumentNilError("dataLoaderFactory", "dataLoaderFactory is required")
+	}
+
+	if persistedQueryService == nil {
+		return nil, commonErrors.NewArgumentNilError("persistedQueryService", "persistedQueryService is required")
+	}
+
+	if responseCacheService == nil {
+		return nil, commonErrors.NewArgumentNilError("responseCacheService", "responseCacheService is required")
+	}
+
 	box := packr.NewBox("../../contract/graphql/schema")
 	graphqlSchema, err := box.FindString("schema.graphql")
 	if err != nil {
@@ -35,22 +91,75 @@ func NewEndpointCreatorService(
 		schema {
 		  query: Query
 		  mutation: Mutation
+		  subscription: Subscription
 		}
 	` + "\n" + graphqlSchema
 
+	maxBatchSize, err := configurationService.GetQueryMaxBatchSize()
+	if err != nil {
+		return nil, err
+	}
+
+	maxBatchCost, err := configurationService.GetQueryMaxBatchCost()
+	if err != nil {
+		return nil, err
+	}
+
+	if maxBatchCost < 1 {
+		return nil, commonErrors.NewArgumentError("maxBatchCost", "maximum batch cost must be at least 1")
+	}
+
+	batchConcurrency, err := configurationService.GetQueryBatchConcurrency()
+	if err != nil {
+		return nil, err
+	}
+
+	if batchConcurrency < 1 {
+		return nil, commonErrors.NewArgumentError("batchConcurrency", "batch concurrency must be at least 1")
+	}
+
+	maxOperationNames, err := configurationService.GetMetricsMaxOperationNames()
+	if err != nil {
+		return nil, err
+	}
+
+	resolverFields, err := configurationService.GetMetricsResolverFields()
+	if err != nil {
+		return nil, err
+	}
+
 	rootResolver, err := resolverCreator.NewRootResolver(context.Background())
 	if err != nil {
 		return nil, commonErrors.NewUnknownErrorWithError("Failed to create the root resolver", err)
 	}
 
-	schema := graphql.MustParseSchema(graphqlSchema, rootResolver)
+	schema := graphql.MustParseSchema(
+		graphqlSchema,
+		rootResolver,
+		graphql.SubscribeResolverTimeout(subscribeResolverTimeout),
+		graphql.Tracer(newMetricsTracer(tracing.NewGraphQLTracer(), resolverFields)))
+
+	queryLimitService, err := querylimit.NewGqlparserQueryLimitService(configurationService, graphqlSchema)
+	if err != nil {
+		return nil, err
+	}
 
 	return &endpointCreatorService{
-		schema: schema,
+		logger:                logger,
+		schema:                schema,
+		dataLoaderFactory:     dataLoaderFactory,
+		queryLimitService:     queryLimitService,
+		persistedQueryService: persistedQueryService,
+		responseCacheService:  responseCacheService,
+		operationNameLabels:   newOperationNameLabels(maxOperationNames),
+		maxBatchSize:          maxBatchSize,
+		maxBatchCost:          maxBatchCost,
+		batchConcurrency:      batchConcurrency,
 	}, nil
 }
 
-// GraphQLEndpoint creates GraphQL endpoint
+// GraphQLEndpoint creates GraphQL endpoint. The endpoint executes either a single GraphQL request or a batch of
+// GraphQL requests, in which case the response is the list of the GraphQL responses in the order of the requests.
 // Returns the GraphQL endpoint
 func (service *endpointCreatorService) GraphQLEndpoint() endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
@@ -66,8 +175,281 @@ func (service *endpointCreatorService) GraphQLEndpoint() endpoint.Endpoint {
 			}, nil
 		}
 
+		// Every request gets its own data loader so the cached values are never shared between requests. The operations
+		// of a batched request share the data loader so the values they all need are loaded once, until a mutation runs.
+		ctx = dataloader.NewContext(ctx, service.dataLoaderFactory.NewDataLoader())
+
+		if batchRequest, ok := request.(*GraphQLBatchRequest); ok {
+			return service.executeBatch(ctx, batchRequest), nil
+		}
+
+		return service.execute(ctx, request.(*GraphQLRequest)), nil
+	}
+}
+
+// GraphQLSubscriptionEndpoint creates GraphQL subscription endpoint. The endpoint response is the channel
+// that receives the GraphQL responses until the subscription completes or the context is done.
+// Returns the GraphQL subscription endpoint
+func (service *endpointCreatorService) GraphQLSubscriptionEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
+		}
+
+		if request == nil {
+			return nil, commonErrors.NewArgumentNilError("request", "request is required")
+		}
+
 		castedRequest := request.(*GraphQLRequest)
 
-		return service.schema.Exec(ctx, castedRequest.Query, castedRequest.OperationName, castedRequest.Variables), nil
+		if _, queryErrors := service.prepareRequest(ctx, castedRequest); len(queryErrors) > 0 {
+			responses := make(chan interface{}, 1)
+			responses <- &graphql.Response{Errors: queryErrors}
+			close(responses)
+
+			return (<-chan interface{})(responses), nil
+		}
+
+		return service.schema.Subscribe(ctx, castedRequest.Query, castedRequest.OperationName, castedRequest.Variables)
+	}
+}
+
+// execute executes the GraphQL request. The responses of the query operations are served from the response cache
+// when possible, and the mutations invalidate the cached responses they affect.
+// ctx: Mandatory. Reference to the context
+// request: Mandatory. The GraphQL request
+// Returns the GraphQL response
+func (service *endpointCreatorService) execute(ctx context.Context, request *GraphQLRequest) *graphql.Response {
+	start := time.Now()
+	analysis, queryErrors := service.prepareRequest(ctx, request)
+
+	return service.executePrepared(ctx, request, analysis, queryErrors, start)
+}
+
+// executePrepared executes the GraphQL request that is already prepared, or reports the errors the request is rejected with
+// ctx: Mandatory. Reference to the context
+// request: Mandatory. The GraphQL request
+// analysis: Optional. The analysis of the operation, nil if the request was rejected before it could be analysed
+// queryErrors: Optional. The errors explaining why the operation must be rejected
+// start: Mandatory. The time the request execution started
+// Returns the GraphQL response
+func (service *endpointCreatorService) executePrepared(
+	ctx context.Context,
+	request *GraphQLRequest,
+	analysis *querylimit.QueryAnalysis,
+	queryErrors []*gqlerrors.QueryError,
+	start time.Time) *graphql.Response {
+	if len(queryErrors) > 0 {
+		response := &graphql.Response{Errors: queryErrors}
+		service.recordMetrics(analysis, false, response, start)
+
+		return response
+	}
+
+	cacheOperation := &responsecache.Operation{
+		Document:   analysis.Document,
+		Definition: analysis.Operation,
+		Variables:  request.Variables,
+	}
+
+	if analysis.OperationType == string(ast.Query) {
+		if response, ok := service.responseCacheService.Get(ctx, cacheOperation); ok {
+			service.recordMetrics(analysis, true, response, start)
+
+			return response
+		}
+	}
+
+	collector := warning.NewWarningCollector()
+	response := service.schema.Exec(warning.NewContext(ctx, collector), request.Query, request.OperationName, request.Variables)
+	translateResolverErrors(ctx, response)
+
+	if warnings := collector.Warnings(); len(warnings) > 0 {
+		response.Extensions = map[string]interface{}{warning.ExtensionKey: warnings}
+	}
+
+	switch analysis.OperationType {
+	case string(ast.Query):
+		service.responseCacheService.Put(ctx, cacheOperation, response)
+	case string(ast.Mutation):
+		service.responseCacheService.Invalidate(ctx, cacheOperation, response)
+	}
+
+	service.recordMetrics(analysis, true, response, start)
+
+	return response
+}
+
+// executeBatch executes the GraphQL requests of the batch. The whole batch is rejected if it contains more requests than
+// the configured maximum batch size, or if the total cost of its operations exceeds the configured maximum batch cost, so
+// batching can not be used to get around the limits applied to the single operations. The queries run concurrently, up to
+// the configured batch concurrency, while every mutation waits for the earlier operations to complete and runs on its own,
+// in the order of the requests. The operations that follow a mutation get a new data loader so they never read the values
+// cached before the mutation.
+// ctx: Mandatory. Reference to the context
+// batchRequest: Mandatory. The batch of GraphQL requests
+// Returns the GraphQL responses in the order of the requests, or the response that explains why the batch is rejected
+func (service *endpointCreatorService) executeBatch(ctx context.Context, batchRequest *GraphQLBatchRequest) interface{} {
+	if len(batchRequest.Requests) > service.maxBatchSize {
+		return &graphql.Response{Errors: []*gqlerrors.QueryError{{
+			Message: fmt.Sprintf("The batch contains %d operations which exceeds the maximum batch size %d", len(batchRequest.Requests), service.maxBatchSize),
+			Extensions: map[string]interface{}{
+				"code":         ErrorCodeMaxBatchSizeExceeded,
+				"batchSize":    len(batchRequest.Requests),
+				"maxBatchSize": service.maxBatchSize,
+			},
+		}}}
+	}
+
+	start := time.Now()
+	analyses := make([]*querylimit.QueryAnalysis, len(batchRequest.Requests))
+	queryErrors := make([][]*gqlerrors.QueryError, len(batchRequest.Requests))
+	batchCost := 0
+
+	for index, request := range batchRequest.Requests {
+		analyses[index], queryErrors[index] = service.prepareRequest(ctx, request)
+		if analyses[index] != nil && len(queryErrors[index]) == 0 {
+			batchCost += analyses[index].Cost
+		}
+	}
+
+	if batchCost > service.maxBatchCost {
+		return &graphql.Response{Errors: []*gqlerrors.QueryError{{
+			Message: fmt.Sprintf("The total cost of the batch operations is %d which exceeds the maximum batch cost %d", batchCost, service.maxBatchCost),
+			Extensions: map[string]interface{}{
+				"code":         ErrorCodeMaxBatchCostExceeded,
+				"batchCost":    batchCost,
+				"maxBatchCost": service.maxBatchCost,
+			},
+		}}}
+	}
+
+	responses := make([]*graphql.Response, len(batchRequest.Requests))
+	slots := make(chan struct{}, service.batchConcurrency)
+
+	var waitGroup sync.WaitGroup
+
+	for index, request := range batchRequest.Requests {
+		if len(queryErrors[index]) == 0 && analyses[index].OperationType == string(ast.Mutation) {
+			waitGroup.Wait()
+
+			responses[index] = service.executePrepared(ctx, request, analyses[index], nil, start)
+			ctx = dataloader.NewContext(ctx, service.dataLoaderFactory.NewDataLoader())
+
+			continue
+		}
+
+		slots <- struct{}{}
+		waitGroup.Add(1)
+
+		go func(ctx context.Context, index int, request *GraphQLRequest) {
+			defer func() {
+				<-slots
+				waitGroup.Done()
+			}()
+
+			responses[index] = service.executePrepared(ctx, request, analyses[index], queryErrors[index], start)
+		}(ctx, index, request)
+	}
+
+	waitGroup.Wait()
+
+	return responses
+}
+
+// prepareRequest resolves the query text of persisted queries, then analyses the requested operation and logs its computed depth and cost
+// ctx: Mandatory. Reference to the context
+// request: Mandatory. The GraphQL request
+// Returns the analysis of the operation if it could be analysed and the errors explaining why the operation must be rejected, if any
+func (service *endpointCreatorService) prepareRequest(
+	ctx context.Context,
+	request *GraphQLRequest) (*querylimit.QueryAnalysis, []*gqlerrors.QueryError) {
+	var persistedQuery *persistedquery.PersistedQuery
+	if request.Extensions != nil {
+		persistedQuery = request.Extensions.PersistedQuery
+	}
+
+	query, queryError := service.persistedQueryService.ResolveQuery(ctx, request.Query, persistedQuery)
+	if queryError != nil {
+		return nil, []*gqlerrors.QueryError{queryError}
+	}
+
+	request.Query = query
+
+	analysis, queryErrors := service.queryLimitService.Analyze(request.Query, request.OperationName, request.Variables)
+	if analysis != nil {
+		service.logger.Info(
+			"GraphQL operation analysed",
+			zap.String("operationName", request.OperationName),
+			zap.Int("depth", analysis.Depth),
+			zap.Int("cost", analysis.Cost),
+			zap.Bool("rejected", len(queryErrors) > 0))
+	}
+
+	if len(queryErrors) == 0 && request.QueriesOnly && analysis.OperationType != string(ast.Query) {
+		queryErrors = []*gqlerrors.QueryError{{
+			Message:    fmt.Sprintf("Only queries are allowed in this request, the requested operation is a %s", analysis.OperationType),
+			Extensions: map[string]interface{}{"code": ErrorCodeOperationNotAllowed},
+		}}
+	}
+
+	return analysis, queryErrors
+}
+
+// recordMetrics reports the time taken to execute the GraphQL request and the errors reported in the response. The operation
+// names are chosen by the clients, so a new operation name is only reported once an operation with that name is executed.
+// The operations that could not be analysed are reported with the unknown operation name.
+// analysis: Optional. The analysis of the operation, nil if the request was rejected before it could be analysed
+// executed: Mandatory. True if the operation passed the analysis and was executed, otherwise false
+// response: Mandatory. The GraphQL response
+// start: Mandatory. The time the request execution started
+func (service *endpointCreatorService) recordMetrics(
+	analysis *querylimit.QueryAnalysis,
+	executed bool,
+	response *graphql.Response,
+	start time.Time) {
+	operationName := unknownOperationName
+	operationType := unknownOperationType
+
+	if analysis != nil {
+		operationName = service.operationNameLabels.label(analysis.OperationName, executed)
+		operationType = analysis.OperationType
+	}
+
+	requestDurationHistogram.
+		WithLabelValues(operationName, operationType).
+		Observe(time.Since(start).Seconds())
+
+	for _, queryError := range response.Errors {
+		code, ok := queryError.Extensions["code"].(string)
+		if !ok {
+			code = unknownErrorCode
+		}
+
+		errorsCounter.WithLabelValues(code).Inc()
+	}
+}
+
+// translateResolverErrors adds the error code to the errors returned from the resolvers that are not translated by the
+// resolvers themselves, and to the error reported when the request context is done before the execution completes
+func translateResolverErrors(ctx context.Context, response *graphql.Response) {
+	for _, queryError := range response.Errors {
+		if queryError.Extensions != nil {
+			continue
+		}
+
+		err := queryError.ResolverError
+		if err == nil {
+			if err = ctx.Err(); err == nil {
+				continue
+			}
+		}
+
+		translatedErr := errortranslation.FromError(err)
+		queryError.Message = translatedErr.Error()
+
+		if extendedErr, ok := translatedErr.(interface{ Extensions() map[string]interface{} }); ok {
+			queryError.Extensions = extendedErr.Extensions()
+		}
 	}
 }
@@ -0,0 +1,408 @@
+package endpoint_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	mock_audit "github.com/decentralized-cloud/api-gateway/services/audit/mock"
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/dataloader"
+	mock_dataloader "github.com/decentralized-cloud/api-gateway/services/dataloader/mock"
+	"github.com/decentralized-cloud/api-gateway/services/endpoint"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/root"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	"github.com/decentralized-cloud/api-gateway/services/persistedquery"
+	mock_persistedquery "github.com/decentralized-cloud/api-gateway/services/persistedquery/mock"
+	mock_responsecache "github.com/decentralized-cloud/api-gateway/services/responsecache/mock"
+	"github.com/decentralized-cloud/api-gateway/services/warning"
+	"github.com/golang/mock/gomock"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// endpointTestConfiguration contains the configurations that differ between the tests
+type endpointTestConfiguration struct {
+	maxDepth          int
+	maxOperationNames int
+	maxBatchCost      int
+}
+
+// eventRecorder records the order the resolvers are called in and the data loaders they are called with
+type eventRecorder struct {
+	mutex       sync.Mutex
+	events      []string
+	dataLoaders map[string]dataloader.DataLoaderContract
+}
+
+func (recorder *eventRecorder) record(ctx context.Context, event string) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	dataLoader, _ := dataloader.FromContext(ctx)
+	recorder.events = append(recorder.events, event)
+	recorder.dataLoaders[event] = dataLoader
+}
+
+// fakeResolverCreator creates the real root resolver, the other resolvers are created by the tests that need them
+type fakeResolverCreator struct {
+	types.ResolverCreatorContract
+	mockCtrl *gomock.Controller
+	recorder *eventRecorder
+}
+
+func (creator *fakeResolverCreator) NewRootResolver(ctx context.Context) (types.RootResolverContract, error) {
+	auditService := mock_audit.NewMockAuditContract(creator.mockCtrl)
+	auditService.EXPECT().RecordMutation(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return root.NewRootResolver(ctx, creator, zap.NewNop(), auditService)
+}
+
+func (creator *fakeResolverCreator) NewNodeResolver(ctx context.Context, id graphql.ID) (types.NodeResolverContract, error) {
+	creator.recorder.record(ctx, fmt.Sprintf("node %s started", id))
+
+	switch id {
+	case "missing":
+		return nil, nil
+	case "broken":
+		return nil, status.Error(codes.Unavailable, "backend service is not available")
+	case "truncated":
+		warning.Report(ctx, warning.Warning{Message: "The node was truncated", Extensions: map[string]interface{}{"code": warning.CodeListTruncated}})
+
+		return &fakeNodeResolver{id: id}, nil
+	case "slow":
+		time.Sleep(50 * time.Millisecond)
+		creator.recorder.record(ctx, fmt.Sprintf("node %s completed", id))
+
+		return &fakeNodeResolver{id: id}, nil
+	default:
+		return &fakeNodeResolver{id: id}, nil
+	}
+}
+
+func (creator *fakeResolverCreator) NewDeleteProject(ctx context.Context) (project.DeleteProjectContract, error) {
+	return &fakeDeleteProject{recorder: creator.recorder}, nil
+}
+
+// fakeDeleteProject records the mutation and reports the project got deleted
+type fakeDeleteProject struct {
+	recorder *eventRecorder
+}
+
+func (mutation *fakeDeleteProject) MutateAndGetPayload(
+	ctx context.Context,
+	args project.DeleteProjectInputArgument) (project.DeleteProjectPayloadResolverContract, error) {
+	mutation.recorder.record(ctx, "deleteProject")
+
+	return &fakeDeleteProjectPayload{projectID: args.Input.ProjectID}, nil
+}
+
+// fakeDeleteProjectPayload reports the identifier of the deleted project
+type fakeDeleteProjectPayload struct {
+	project.DeleteProjectPayloadResolverContract
+	projectID graphql.ID
+}
+
+func (r *fakeDeleteProjectPayload) DeletedProjectID(ctx context.Context) graphql.ID {
+	return r.projectID
+}
+
+// fakeNodeResolver resolves the identifier of the node, the type of the node is not known
+type fakeNodeResolver struct {
+	types.NodeResolverContract
+	id graphql.ID
+}
+
+func (r *fakeNodeResolver) ID(ctx context.Context) (graphql.ID, error) {
+	return r.id, nil
+}
+
+func TestGraphQLEndpoint_NodesReportsTheFailedEntries(t *testing.T) {
+	graphQLEndpoint := newGraphQLEndpoint(t, endpointTestConfiguration{maxDepth: 10, maxOperationNames: 10, maxBatchCost: 100}, newEventRecorder())
+
+	response := execute(t, graphQLEndpoint, &endpoint.GraphQLRequest{Query: `{ nodes(ids: ["n1", "broken", "missing", "n2"]) { id } }`})
+
+	if string(response.Data) != `{"nodes":[{"id":"n1"},null,null,{"id":"n2"}]}` {
+		t.Fatalf("expected only the failed and missing entries to be null, got %s", response.Data)
+	}
+
+	if len(response.Errors) != 1 {
+		t.Fatalf("expected a single error, got %v", response.Errors)
+	}
+
+	if path := fmt.Sprint(response.Errors[0].Path); path != "[nodes 1 id]" {
+		t.Fatalf("expected the error to be reported at the failed entry, got %s", path)
+	}
+
+	if code := response.Errors[0].Extensions["code"]; code != "UNAVAILABLE" {
+		t.Fatalf("expected the UNAVAILABLE error code, got %v", code)
+	}
+}
+
+func TestGraphQLEndpoint_ReportsTheWarningsInTheResponseExtensions(t *testing.T) {
+	graphQLEndpoint := newGraphQLEndpoint(t, endpointTestConfiguration{maxDepth: 10, maxOperationNames: 10, maxBatchCost: 100}, newEventRecorder())
+
+	response := execute(t, graphQLEndpoint, &endpoint.GraphQLRequest{Query: `{ nodes(ids: ["n1", "truncated"]) { id } }`})
+
+	if string(response.Data) != `{"nodes":[{"id":"n1"},{"id":"truncated"}]}` || len(response.Errors) > 0 {
+		t.Fatalf("expected the warning not to fail the request, got %s (%v)", response.Data, response.Errors)
+	}
+
+	warnings, ok := response.Extensions[warning.ExtensionKey].([]warning.Warning)
+	if !ok || len(warnings) != 1 || warnings[0].Extensions["code"] != warning.CodeListTruncated {
+		t.Fatalf("expected the warning to be reported in the response extensions, got %v", response.Extensions)
+	}
+
+	if response := execute(t, graphQLEndpoint, &endpoint.GraphQLRequest{Query: `{ nodes(ids: ["n1"]) { id } }`}); response.Extensions != nil {
+		t.Fatalf("expected no extensions without warnings, got %v", response.Extensions)
+	}
+}
+
+func TestGraphQLEndpoint_BatchCostIsBoundedByTheMaxBatchCost(t *testing.T) {
+	recorder := newEventRecorder()
+	graphQLEndpoint := newGraphQLEndpoint(t, endpointTestConfiguration{maxDepth: 10, maxOperationNames: 10, maxBatchCost: 3}, recorder)
+
+	// Every operation costs 2, so the batch is rejected although every operation is within the limits on its own
+	response := executeBatch(
+		t,
+		graphQLEndpoint,
+		&endpoint.GraphQLRequest{Query: `{ nodes(ids: ["n1"]) { id } user { id } }`},
+		&endpoint.GraphQLRequest{Query: `{ nodes(ids: ["n2"]) { id } user { id } }`})
+
+	rejectedResponse, ok := response.(*graphql.Response)
+	if !ok {
+		t.Fatalf("expected the batch to be rejected, got %v", response)
+	}
+
+	if len(rejectedResponse.Errors) != 1 || rejectedResponse.Errors[0].Extensions["code"] != endpoint.ErrorCodeMaxBatchCostExceeded {
+		t.Fatalf("expected the batch to be rejected with the %s error code, got %v", endpoint.ErrorCodeMaxBatchCostExceeded, rejectedResponse.Errors)
+	}
+
+	if rejectedResponse.Errors[0].Extensions["batchCost"] != 4 {
+		t.Fatalf("expected the batch cost to be reported, got %v", rejectedResponse.Errors[0].Extensions)
+	}
+
+	if len(recorder.events) > 0 {
+		t.Fatalf("expected none of the batch operations to be executed, got %v", recorder.events)
+	}
+
+	response = executeBatch(
+		t,
+		graphQLEndpoint,
+		&endpoint.GraphQLRequest{Query: `{ nodes(ids: ["n1"]) { id } }`},
+		&endpoint.GraphQLRequest{Query: `{ nodes(ids: ["n2"]) { id } }`})
+
+	responses, ok := response.([]*graphql.Response)
+	if !ok || len(responses) != 2 {
+		t.Fatalf("expected the batch within the maximum batch cost to be executed, got %v", response)
+	}
+
+	for _, response := range responses {
+		if len(response.Errors) > 0 {
+			t.Fatalf("expected the batch operations to be executed, got %v", response.Errors)
+		}
+	}
+}
+
+func TestGraphQLEndpoint_BatchMutationsRunInOrderWithANewDataLoader(t *testing.T) {
+	recorder := newEventRecorder()
+	graphQLEndpoint := newGraphQLEndpoint(t, endpointTestConfiguration{maxDepth: 10, maxOperationNames: 10, maxBatchCost: 100}, recorder)
+
+	response := executeBatch(
+		t,
+		graphQLEndpoint,
+		&endpoint.GraphQLRequest{Query: `{ nodes(ids: ["slow"]) { id } }`},
+		&endpoint.GraphQLRequest{Query: `mutation { deleteProject(input: { projectID: "p1" }) { deletedProjectID } }`},
+		&endpoint.GraphQLRequest{Query: `{ nodes(ids: ["after"]) { id } }`})
+
+	responses, ok := response.([]*graphql.Response)
+	if !ok || len(responses) != 3 {
+		t.Fatalf("expected the responses of all the batch operations, got %v", response)
+	}
+
+	for index, expectedData := range []string{
+		`{"nodes":[{"id":"slow"}]}`,
+		`{"deleteProject":{"deletedProjectID":"p1"}}`,
+		`{"nodes":[{"id":"after"}]}`,
+	} {
+		if string(responses[index].Data) != expectedData {
+			t.Fatalf("expected %s, got %s %v", expectedData, responses[index].Data, responses[index].Errors)
+		}
+	}
+
+	expectedEvents := []string{"node slow started", "node slow completed", "deleteProject", "node after started"}
+	if fmt.Sprint(recorder.events) != fmt.Sprint(expectedEvents) {
+		t.Fatalf("expected the operations to run in the order of the requests %v, got %v", expectedEvents, recorder.events)
+	}
+
+	if recorder.dataLoaders["node slow started"] != recorder.dataLoaders["deleteProject"] {
+		t.Fatal("expected the mutation to share the data loader of the earlier operations")
+	}
+
+	if recorder.dataLoaders["node after started"] == recorder.dataLoaders["deleteProject"] {
+		t.Fatal("expected the operations that follow the mutation to get a new data loader")
+	}
+}
+
+func TestGraphQLEndpoint_OnlyExecutedOperationsRegisterOperationNames(t *testing.T) {
+	graphQLEndpoint := newGraphQLEndpoint(t, endpointTestConfiguration{maxDepth: 1, maxOperationNames: 1, maxBatchCost: 100}, newEventRecorder())
+
+	// Neither the operation that fails the validation nor the operation rejected by the query limits takes the only slot
+	for _, request := range []*endpoint.GraphQLRequest{
+		{Query: "query InvalidOperation { doesNotExist }", OperationName: "InvalidOperation"},
+		{Query: "query TooDeepOperation { user { id } }", OperationName: "TooDeepOperation"},
+	} {
+		if response := execute(t, graphQLEndpoint, request); len(response.Errors) == 0 {
+			t.Fatalf("expected the %s operation to be rejected", request.OperationName)
+		}
+	}
+
+	for _, request := range []*endpoint.GraphQLRequest{
+		{Query: "query ExecutedOperation { __typename }", OperationName: "ExecutedOperation"},
+		{Query: "query LateOperation { __typename }", OperationName: "LateOperation"},
+	} {
+		if response := execute(t, graphQLEndpoint, request); len(response.Errors) > 0 {
+			t.Fatalf("expected the %s operation to be executed, got %v", request.OperationName, response.Errors)
+		}
+	}
+
+	operationNames := getReportedOperationNames(t)
+
+	for _, operationName := range []string{"InvalidOperation", "TooDeepOperation", "LateOperation"} {
+		if _, ok := operationNames[operationName]; ok {
+			t.Fatalf("expected the %s operation name not to be reported, got %v", operationName, operationNames)
+		}
+	}
+
+	if operationNames["ExecutedOperation"] != "query" {
+		t.Fatalf("expected the executed operation name to be reported, got %v", operationNames)
+	}
+
+	if operationNames["unknown"] != "unknown" {
+		t.Fatalf("expected the operation that could not be analysed to be reported as unknown, got %v", operationNames)
+	}
+}
+
+func newGraphQLEndpoint(
+	t *testing.T,
+	config endpointTestConfiguration,
+	recorder *eventRecorder) func(ctx context.Context, request interface{}) (interface{}, error) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetQueryMaxBatchSize().Return(10, nil).AnyTimes()
+	configurationService.EXPECT().GetQueryBatchConcurrency().Return(4, nil).AnyTimes()
+	configurationService.EXPECT().GetQueryMaxBatchCost().Return(config.maxBatchCost, nil).AnyTimes()
+	configurationService.EXPECT().GetMetricsMaxOperationNames().Return(config.maxOperationNames, nil).AnyTimes()
+	configurationService.EXPECT().GetMetricsResolverFields().Return([]string{}, nil).AnyTimes()
+	configurationService.EXPECT().GetQueryMaxDepth().Return(config.maxDepth, nil).AnyTimes()
+	configurationService.EXPECT().GetQueryMaxListSize().Return(100, nil).AnyTimes()
+	configurationService.EXPECT().GetQueryMaxCost().Return(10000, nil).AnyTimes()
+	configurationService.EXPECT().GetQueryFieldCosts().Return(map[string]int{}, nil).AnyTimes()
+
+	dataLoaderFactory := mock_dataloader.NewMockDataLoaderFactoryContract(mockCtrl)
+	dataLoaderFactory.
+		EXPECT().
+		NewDataLoader().
+		DoAndReturn(func() dataloader.DataLoaderContract {
+			return mock_dataloader.NewMockDataLoaderContract(mockCtrl)
+		}).
+		AnyTimes()
+
+	persistedQueryService := mock_persistedquery.NewMockPersistedQueryContract(mockCtrl)
+	persistedQueryService.
+		EXPECT().
+		ResolveQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query string, persistedQuery *persistedquery.PersistedQuery) (string, *gqlerrors.QueryError) {
+			return query, nil
+		}).
+		AnyTimes()
+
+	responseCacheService := mock_responsecache.NewMockResponseCacheContract(mockCtrl)
+	responseCacheService.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+	responseCacheService.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	responseCacheService.EXPECT().Invalidate(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	endpointCreatorService, err := endpoint.NewEndpointCreatorService(
+		zap.NewNop(),
+		configurationService,
+		&fakeResolverCreator{mockCtrl: mockCtrl, recorder: recorder},
+		dataLoaderFactory,
+		persistedQueryService,
+		responseCacheService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return endpointCreatorService.GraphQLEndpoint()
+}
+
+func newEventRecorder() *eventRecorder {
+	return &eventRecorder{dataLoaders: map[string]dataloader.DataLoaderContract{}}
+}
+
+func execute(
+	t *testing.T,
+	graphQLEndpoint func(ctx context.Context, request interface{}) (interface{}, error),
+	request *endpoint.GraphQLRequest) *graphql.Response {
+	t.Helper()
+
+	response, err := graphQLEndpoint(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response.(*graphql.Response)
+}
+
+func executeBatch(
+	t *testing.T,
+	graphQLEndpoint func(ctx context.Context, request interface{}) (interface{}, error),
+	requests ...*endpoint.GraphQLRequest) interface{} {
+	t.Helper()
+
+	response, err := graphQLEndpoint(context.Background(), &endpoint.GraphQLBatchRequest{Requests: requests})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response
+}
+
+// getReportedOperationNames returns the operation names reported in the request duration metric mapped to their operation type
+func getReportedOperationNames(t *testing.T) map[string]string {
+	t.Helper()
+
+	metricFamilies, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operationNames := map[string]string{}
+
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != "api_gateway_graphql_request_duration_seconds" {
+			continue
+		}
+
+		for _, metric := range metricFamily.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			operationNames[labels["operation_name"]] = labels["operation_type"]
+		}
+	}
+
+	return operationNames
+}
@@ -0,0 +1,231 @@
+// Package errortranslation translates the errors reported by the backend services to GraphQL errors with a machine readable code
+package errortranslation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	edgeClusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
+	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kubernetesErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// CodeNotFound is reported when the requested resource does not exist
+	CodeNotFound = "NOT_FOUND"
+
+	// CodeAlreadyExists is reported when the resource to create already exists
+	CodeAlreadyExists = "ALREADY_EXISTS"
+
+	// CodeInvalidArgument is reported when the request contains invalid arguments
+	CodeInvalidArgument = "INVALID_ARGUMENT"
+
+	// CodeUnauthenticated is reported when the backend service does not accept the caller credentials
+	CodeUnauthenticated = "UNAUTHENTICATED"
+
+	// CodeForbidden is reported when the caller is not allowed to perform the request
+	CodeForbidden = "FORBIDDEN"
+
+	// CodeUnavailable is reported when the backend service can not be reached or does not respond in time
+	CodeUnavailable = "UNAVAILABLE"
+
+	// CodeInternal is reported for all other failures
+	CodeInternal = "INTERNAL_SERVER_ERROR"
+)
+
+// resolverError is the error returned from the resolvers. The GraphQL library reports the extensions along with the error message.
+type resolverError struct {
+	code    string
+	message string
+	err     error
+}
+
+// Error returns the error message
+func (e *resolverError) Error() string {
+	return e.message
+}
+
+// Unwrap returns the translated error
+func (e *resolverError) Unwrap() error {
+	return e.err
+}
+
+// Extensions returns the extensions reported along with the GraphQL error
+func (e *resolverError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+// FromError translates the error returned from a backend call or a resolver to a GraphQL error. gRPC status errors are
+// translated using their status code. Errors that already carry GraphQL extensions are returned unchanged.
+// err: Optional. The error to translate
+// Returns the translated error or nil if err is nil
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var extendedErr interface{ Extensions() map[string]interface{} }
+	if errors.As(err, &extendedErr) {
+		return err
+	}
+
+	if grpcStatus, ok := status.FromError(err); ok {
+		return &resolverError{
+			code:    fromGrpcCode(grpcStatus.Code()),
+			message: grpcStatus.Message(),
+			err:     err,
+		}
+	}
+
+	code := CodeInternal
+
+	switch {
+	case commonErrors.IsNotFoundError(err):
+		code = CodeNotFound
+	case commonErrors.IsAlreadyExistsError(err):
+		code = CodeAlreadyExists
+	case commonErrors.IsArgumentError(err):
+		code = CodeInvalidArgument
+	case errors.Is(err, context.DeadlineExceeded):
+		code = CodeUnavailable
+	}
+
+	return &resolverError{
+		code:    code,
+		message: err.Error(),
+		err:     err,
+	}
+}
+
+// FromKubernetesError translates the error returned from an edge cluster Kubernetes API call to a GraphQL error
+// err: Optional. The error to translate
+// Returns the translated error or nil if err is nil
+func FromKubernetesError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	code := CodeInternal
+
+	var netErr net.Error
+
+	switch {
+	case kubernetesErrors.IsNotFound(err):
+		code = CodeNotFound
+	case kubernetesErrors.IsBadRequest(err), kubernetesErrors.IsInvalid(err):
+		code = CodeInvalidArgument
+	case kubernetesErrors.IsUnauthorized(err):
+		code = CodeUnauthenticated
+	case kubernetesErrors.IsForbidden(err):
+		code = CodeForbidden
+	case kubernetesErrors.IsTimeout(err),
+		kubernetesErrors.IsServerTimeout(err),
+		kubernetesErrors.IsServiceUnavailable(err),
+		kubernetesErrors.IsTooManyRequests(err),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.As(err, &netErr):
+		code = CodeUnavailable
+	}
+
+	return &resolverError{
+		code:    code,
+		message: err.Error(),
+		err:     err,
+	}
+}
+
+// FromProjectError translates the error reported in the project service response to a GraphQL error
+// projectError: Mandatory. The error reported by the project service
+// message: Optional. The error message reported by the project service
+// Returns the translated error or nil if the project service did not report an error
+func FromProjectError(projectError projectGrpcContract.Error, message string) error {
+	if projectError == projectGrpcContract.Error_NO_ERROR {
+		return nil
+	}
+
+	code := CodeInternal
+
+	switch projectError {
+	case projectGrpcContract.Error_PROJECT_NOT_FOUND:
+		code = CodeNotFound
+	case projectGrpcContract.Error_PROJECT_ALREADY_EXISTS:
+		code = CodeAlreadyExists
+	case projectGrpcContract.Error_BAD_REQUEST:
+		code = CodeInvalidArgument
+	}
+
+	return &resolverError{
+		code:    code,
+		message: messageOrDefault(message, projectError.String()),
+	}
+}
+
+// FromEdgeClusterError translates the error reported in the edge cluster service response to a GraphQL error
+// edgeClusterError: Mandatory. The error reported by the edge cluster service
+// message: Optional. The error message reported by the edge cluster service
+// Returns the translated error or nil if the edge cluster service did not report an error
+func FromEdgeClusterError(edgeClusterError edgeClusterGrpcContract.Error, message string) error {
+	if edgeClusterError == edgeClusterGrpcContract.Error_NO_ERROR {
+		return nil
+	}
+
+	code := CodeInternal
+
+	switch edgeClusterError {
+	case edgeClusterGrpcContract.Error_EDGE_CLUSTER_NOT_FOUND:
+		code = CodeNotFound
+	case edgeClusterGrpcContract.Error_EDGE_CLUSTER_ALREADY_EXISTS:
+		code = CodeAlreadyExists
+	case edgeClusterGrpcContract.Error_BAD_REQUEST:
+		code = CodeInvalidArgument
+	}
+
+	return &resolverError{
+		code:    code,
+		message: messageOrDefault(message, edgeClusterError.String()),
+	}
+}
+
+// IsNotFound reports whether the error is reported because the requested resource does not exist
+// err: Optional. The error to check
+// Returns true if the error translates to the not found error code
+func IsNotFound(err error) bool {
+	var extendedErr interface{ Extensions() map[string]interface{} }
+	if !errors.As(FromError(err), &extendedErr) {
+		return false
+	}
+
+	return extendedErr.Extensions()["code"] == CodeNotFound
+}
+
+func fromGrpcCode(grpcCode codes.Code) string {
+	switch grpcCode {
+	case codes.NotFound:
+		return CodeNotFound
+	case codes.AlreadyExists:
+		return CodeAlreadyExists
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return CodeInvalidArgument
+	case codes.Unauthenticated:
+		return CodeUnauthenticated
+	case codes.PermissionDenied:
+		return CodeForbidden
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return CodeUnavailable
+	default:
+		return CodeInternal
+	}
+}
+
+func messageOrDefault(message string, defaultMessage string) string {
+	if message == "" {
+		return fmt.Sprintf("Backend service reported %s", defaultMessage)
+	}
+
+	return message
+}
@@ -0,0 +1,176 @@
+package errortranslation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	edgeClusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
+	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kubernetesErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// extendedError is an error that already carries GraphQL extensions
+type extendedError struct{}
+
+func (e *extendedError) Error() string {
+	return "already translated"
+}
+
+func (e *extendedError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": "CUSTOM"}
+}
+
+func TestFromError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{name: "gRPC not found", err: status.Error(codes.NotFound, "not found"), code: errortranslation.CodeNotFound},
+		{name: "gRPC already exists", err: status.Error(codes.AlreadyExists, "exists"), code: errortranslation.CodeAlreadyExists},
+		{name: "gRPC invalid argument", err: status.Error(codes.InvalidArgument, "invalid"), code: errortranslation.CodeInvalidArgument},
+		{name: "gRPC failed precondition", err: status.Error(codes.FailedPrecondition, "precondition"), code: errortranslation.CodeInvalidArgument},
+		{name: "gRPC unauthenticated", err: status.Error(codes.Unauthenticated, "unauthenticated"), code: errortranslation.CodeUnauthenticated},
+		{name: "gRPC permission denied", err: status.Error(codes.PermissionDenied, "denied"), code: errortranslation.CodeForbidden},
+		{name: "gRPC unavailable", err: status.Error(codes.Unavailable, "unavailable"), code: errortranslation.CodeUnavailable},
+		{name: "gRPC deadline exceeded", err: status.Error(codes.DeadlineExceeded, "deadline"), code: errortranslation.CodeUnavailable},
+		{name: "gRPC resource exhausted", err: status.Error(codes.ResourceExhausted, "exhausted"), code: errortranslation.CodeUnavailable},
+		{name: "gRPC internal", err: status.Error(codes.Internal, "internal"), code: errortranslation.CodeInternal},
+		{name: "not found error", err: commonErrors.NewNotFoundError(), code: errortranslation.CodeNotFound},
+		{name: "already exists error", err: commonErrors.NewAlreadyExistsError(), code: errortranslation.CodeAlreadyExists},
+		{name: "argument error", err: commonErrors.NewArgumentError("id", "id is invalid"), code: errortranslation.CodeInvalidArgument},
+		{name: "context deadline exceeded", err: fmt.Errorf("call failed: %w", context.DeadlineExceeded), code: errortranslation.CodeUnavailable},
+		{name: "other error", err: errors.New("failure"), code: errortranslation.CodeInternal},
+		{name: "translated error", err: &extendedError{}, code: "CUSTOM"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			translatedErr := errortranslation.FromError(test.err)
+			assertErrorCode(t, translatedErr, test.code)
+
+			if !errors.Is(translatedErr, test.err) {
+				t.Fatalf("expected the translated error to wrap the original error, got %v", translatedErr)
+			}
+		})
+	}
+
+	if errortranslation.FromError(nil) != nil {
+		t.Fatal("expected no error to be translated to nil")
+	}
+
+	if err := errortranslation.FromError(status.Error(codes.NotFound, "project not found")); err.Error() != "project not found" {
+		t.Fatalf("expected the gRPC status message to be reported, got %s", err.Error())
+	}
+}
+
+func TestFromKubernetesError(t *testing.T) {
+	resource := schema.GroupResource{Resource: "pods"}
+
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{name: "not found", err: kubernetesErrors.NewNotFound(resource, "pod"), code: errortranslation.CodeNotFound},
+		{name: "bad request", err: kubernetesErrors.NewBadRequest("bad request"), code: errortranslation.CodeInvalidArgument},
+		{name: "unauthorized", err: kubernetesErrors.NewUnauthorized("unauthorized"), code: errortranslation.CodeUnauthenticated},
+		{name: "forbidden", err: kubernetesErrors.NewForbidden(resource, "pod", errors.New("forbidden")), code: errortranslation.CodeForbidden},
+		{name: "too many requests", err: kubernetesErrors.NewTooManyRequests("too many requests", 1), code: errortranslation.CodeUnavailable},
+		{name: "service unavailable", err: kubernetesErrors.NewServiceUnavailable("unavailable"), code: errortranslation.CodeUnavailable},
+		{name: "context deadline exceeded", err: context.DeadlineExceeded, code: errortranslation.CodeUnavailable},
+		{name: "other error", err: errors.New("failure"), code: errortranslation.CodeInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assertErrorCode(t, errortranslation.FromKubernetesError(test.err), test.code)
+		})
+	}
+
+	if errortranslation.FromKubernetesError(nil) != nil {
+		t.Fatal("expected no error to be translated to nil")
+	}
+}
+
+func TestFromProjectError(t *testing.T) {
+	tests := []struct {
+		projectError projectGrpcContract.Error
+		code         string
+	}{
+		{projectError: projectGrpcContract.Error_PROJECT_NOT_FOUND, code: errortranslation.CodeNotFound},
+		{projectError: projectGrpcContract.Error_PROJECT_ALREADY_EXISTS, code: errortranslation.CodeAlreadyExists},
+		{projectError: projectGrpcContract.Error_BAD_REQUEST, code: errortranslation.CodeInvalidArgument},
+		{projectError: projectGrpcContract.Error_UNKNOWN, code: errortranslation.CodeInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.projectError.String(), func(t *testing.T) {
+			assertErrorCode(t, errortranslation.FromProjectError(test.projectError, "project service failure"), test.code)
+		})
+	}
+
+	if errortranslation.FromProjectError(projectGrpcContract.Error_NO_ERROR, "") != nil {
+		t.Fatal("expected no error to be translated to nil")
+	}
+
+	if err := errortranslation.FromProjectError(projectGrpcContract.Error_PROJECT_NOT_FOUND, ""); err.Error() != "Backend service reported PROJECT_NOT_FOUND" {
+		t.Fatalf("expected the default message, got %s", err.Error())
+	}
+}
+
+func TestFromEdgeClusterError(t *testing.T) {
+	tests := []struct {
+		edgeClusterError edgeClusterGrpcContract.Error
+		code             string
+	}{
+		{edgeClusterError: edgeClusterGrpcContract.Error_EDGE_CLUSTER_NOT_FOUND, code: errortranslation.CodeNotFound},
+		{edgeClusterError: edgeClusterGrpcContract.Error_EDGE_CLUSTER_ALREADY_EXISTS, code: errortranslation.CodeAlreadyExists},
+		{edgeClusterError: edgeClusterGrpcContract.Error_BAD_REQUEST, code: errortranslation.CodeInvalidArgument},
+		{edgeClusterError: edgeClusterGrpcContract.Error_UNKNOWN, code: errortranslation.CodeInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.edgeClusterError.String(), func(t *testing.T) {
+			assertErrorCode(t, errortranslation.FromEdgeClusterError(test.edgeClusterError, "edge cluster service failure"), test.code)
+		})
+	}
+
+	if errortranslation.FromEdgeClusterError(edgeClusterGrpcContract.Error_NO_ERROR, "") != nil {
+		t.Fatal("expected no error to be translated to nil")
+	}
+
+	if err := errortranslation.FromEdgeClusterError(edgeClusterGrpcContract.Error_BAD_REQUEST, "name is required"); err.Error() != "name is required" {
+		t.Fatalf("expected the reported message, got %s", err.Error())
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	if !errortranslation.IsNotFound(status.Error(codes.NotFound, "not found")) {
+		t.Fatal("expected the gRPC not found error to be reported as not found")
+	}
+
+	if !errortranslation.IsNotFound(errortranslation.FromProjectError(projectGrpcContract.Error_PROJECT_NOT_FOUND, "")) {
+		t.Fatal("expected the translated not found error to be reported as not found")
+	}
+
+	if errortranslation.IsNotFound(status.Error(codes.Unavailable, "unavailable")) || errortranslation.IsNotFound(nil) {
+		t.Fatal("expected the other errors not to be reported as not found")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+
+	var extendedErr interface{ Extensions() map[string]interface{} }
+	if !errors.As(err, &extendedErr) || extendedErr.Extensions()["code"] != code {
+		t.Fatalf("expected the %s error code, got %v", code, err)
+	}
+}
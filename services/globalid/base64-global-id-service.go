@@ -0,0 +1,120 @@
+// Package globalid implements the global object identifiers reported in the GraphQL ID fields and accepted by the node query
+package globalid
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/graph-gophers/graphql-go"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// knownTypeNames contains the names of the GraphQL types the global identifiers are issued for
+var knownTypeNames = map[string]bool{
+	TypeUser:               true,
+	TypeProject:            true,
+	TypeEdgeCluster:        true,
+	TypeEdgeClusterProject: true,
+}
+
+type base64GlobalIDService struct {
+	rawIDs bool
+}
+
+// NewBase64GlobalIDService creates new instance of the base64GlobalIDService, setting up all dependencies and returns the instance.
+// The global identifiers are the base64 encoded type name and backend identifier separated by a colon.
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new service or error if something goes wrong
+func NewBase64GlobalIDService(configurationService configuration.ConfigurationContract) (GlobalIDContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	rawIDs, err := configurationService.GetGraphQLRawIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	return &base64GlobalIDService{
+		rawIDs: rawIDs,
+	}, nil
+}
+
+// ToGlobalID returns the identifier reported to the clients for the backend object
+// typeName: Mandatory. The name of the GraphQL type of the object
+// id: Mandatory. The backend object unique identifier
+// Returns the global identifier, or the backend identifier if the raw backend identifiers are reported
+func (service *base64GlobalIDService) ToGlobalID(typeName string, id string) graphql.ID {
+	if service.rawIDs {
+		return graphql.ID(id)
+	}
+
+	return graphql.ID(base64.StdEncoding.EncodeToString([]byte(typeName + ":" + id)))
+}
+
+// FromGlobalID returns the backend identifier of the identifier sent by the client. While the raw backend identifiers
+// are reported, the identifiers that are not global identifiers are accepted as the backend identifiers.
+// id: Mandatory. The identifier sent by the client
+// typeNames: Mandatory. The names of the GraphQL types the identifier is expected to refer to
+// Returns the backend object unique identifier or error if the identifier is not valid or refers to another type
+func (service *base64GlobalIDService) FromGlobalID(id graphql.ID, typeNames ...string) (string, error) {
+	typeName, backendID, err := service.ParseGlobalID(id)
+	if err != nil {
+		if service.rawIDs && strings.Trim(string(id), " ") != "" {
+			return string(id), nil
+		}
+
+		return "", err
+	}
+
+	for _, expectedTypeName := range typeNames {
+		if typeName == expectedTypeName {
+			return backendID, nil
+		}
+	}
+
+	return "", newInvalidIDError(fmt.Sprintf("ID %s refers to %s, expected %s", id, typeName, strings.Join(typeNames, " or ")))
+}
+
+// FromGlobalIDs returns the backend identifiers of the identifiers sent by the client
+// ids: Mandatory. The identifiers sent by the client
+// typeNames: Mandatory. The names of the GraphQL types the identifiers are expected to refer to
+// Returns the backend object unique identifiers in the same order or error if any of the identifiers is not valid or refers to another type
+func (service *base64GlobalIDService) FromGlobalIDs(ids []graphql.ID, typeNames ...string) ([]string, error) {
+	backendIDs := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		backendID, err := service.FromGlobalID(id, typeNames...)
+		if err != nil {
+			return nil, err
+		}
+
+		backendIDs = append(backendIDs, backendID)
+	}
+
+	return backendIDs, nil
+}
+
+// ParseGlobalID decodes the global identifier sent by the client
+// id: Mandatory. The global identifier
+// Returns the name of the GraphQL type and the backend object unique identifier or error if the identifier is not a valid global identifier
+func (service *base64GlobalIDService) ParseGlobalID(id graphql.ID) (string, string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(string(id))
+	if err != nil {
+		return "", "", newInvalidIDError(fmt.Sprintf("%s is not a valid ID", id))
+	}
+
+	parts := strings.SplitN(string(decoded), ":", 2)
+	if len(parts) != 2 || !knownTypeNames[parts[0]] || strings.Trim(parts[1], " ") == "" {
+		return "", "", newInvalidIDError(fmt.Sprintf("%s is not a valid ID", id))
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func newInvalidIDError(message string) error {
+	return errortranslation.FromError(commonErrors.NewArgumentError("id", message))
+}
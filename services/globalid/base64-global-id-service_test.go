@@ -0,0 +1,122 @@
+package globalid_test
+
+import (
+	"errors"
+	"testing"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
+	"github.com/golang/mock/gomock"
+	"github.com/graph-gophers/graphql-go"
+)
+
+func newGlobalIDService(t *testing.T, rawIDs bool) globalid.GlobalIDContract {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetGraphQLRawIDs().Return(rawIDs, nil)
+
+	service, err := globalid.NewBase64GlobalIDService(configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return service
+}
+
+func assertInvalidID(t *testing.T, err error) {
+	t.Helper()
+
+	var extendedErr interface{ Extensions() map[string]interface{} }
+	if !errors.As(err, &extendedErr) || extendedErr.Extensions()["code"] != "INVALID_ARGUMENT" {
+		t.Fatalf("expected the identifier to be rejected as an invalid argument, got %v", err)
+	}
+}
+
+func TestBase64GlobalIDService_RoundTrip(t *testing.T) {
+	service := newGlobalIDService(t, false)
+
+	for _, typeName := range []string{
+		globalid.TypeUser,
+		globalid.TypeProject,
+		globalid.TypeEdgeCluster,
+		globalid.TypeEdgeClusterProject,
+	} {
+		id := service.ToGlobalID(typeName, "backend:id-1")
+		if id == "backend:id-1" {
+			t.Fatalf("expected the %s backend identifier to be encoded", typeName)
+		}
+
+		parsedTypeName, backendID, err := service.ParseGlobalID(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if parsedTypeName != typeName || backendID != "backend:id-1" {
+			t.Fatalf("expected %s backend:id-1, got %s %s", typeName, parsedTypeName, backendID)
+		}
+
+		backendID, err = service.FromGlobalID(id, typeName)
+		if err != nil || backendID != "backend:id-1" {
+			t.Fatalf("expected backend:id-1, got %s %v", backendID, err)
+		}
+	}
+}
+
+func TestBase64GlobalIDService_RejectsInvalidIdentifiers(t *testing.T) {
+	service := newGlobalIDService(t, false)
+
+	projectID := service.ToGlobalID(globalid.TypeProject, "p1")
+
+	_, err := service.FromGlobalID(projectID, globalid.TypeEdgeCluster)
+	assertInvalidID(t, err)
+
+	for _, id := range []graphql.ID{
+		"p1",
+		"not base64!",
+		graphql.ID("VW5rbm93bjpwMQ=="), // Unknown:p1
+		graphql.ID("UHJvamVjdDo="),     // Project:
+		"",
+	} {
+		_, err := service.FromGlobalID(id, globalid.TypeProject)
+		assertInvalidID(t, err)
+	}
+
+	backendIDs, err := service.FromGlobalIDs(
+		[]graphql.ID{projectID, service.ToGlobalID(globalid.TypeEdgeClusterProject, "p2")},
+		globalid.TypeProject,
+		globalid.TypeEdgeClusterProject)
+	if err != nil || len(backendIDs) != 2 || backendIDs[0] != "p1" || backendIDs[1] != "p2" {
+		t.Fatalf("expected p1 and p2, got %v %v", backendIDs, err)
+	}
+
+	_, err = service.FromGlobalIDs([]graphql.ID{projectID, "p2"}, globalid.TypeProject)
+	assertInvalidID(t, err)
+}
+
+func TestBase64GlobalIDService_RawIDs(t *testing.T) {
+	service := newGlobalIDService(t, true)
+
+	if id := service.ToGlobalID(globalid.TypeProject, "p1"); id != "p1" {
+		t.Fatalf("expected the raw backend identifier to be reported, got %s", id)
+	}
+
+	backendID, err := service.FromGlobalID("p1", globalid.TypeProject)
+	if err != nil || backendID != "p1" {
+		t.Fatalf("expected the raw backend identifier to be accepted, got %s %v", backendID, err)
+	}
+
+	globalService := newGlobalIDService(t, false)
+
+	backendID, err = service.FromGlobalID(globalService.ToGlobalID(globalid.TypeProject, "p1"), globalid.TypeProject)
+	if err != nil || backendID != "p1" {
+		t.Fatalf("expected the global identifier to still be accepted, got %s %v", backendID, err)
+	}
+
+	_, err = service.FromGlobalID(globalService.ToGlobalID(globalid.TypeEdgeCluster, "e1"), globalid.TypeProject)
+	assertInvalidID(t, err)
+
+	_, err = service.FromGlobalID(" ", globalid.TypeProject)
+	assertInvalidID(t, err)
+}
@@ -0,0 +1,46 @@
+// Package globalid implements the global object identifiers reported in the GraphQL ID fields and accepted by the node query
+package globalid
+
+import "github.com/graph-gophers/graphql-go"
+
+const (
+	// TypeUser is the type name of the user global identifiers
+	TypeUser = "User"
+
+	// TypeProject is the type name of the project global identifiers
+	TypeProject = "Project"
+
+	// TypeEdgeCluster is the type name of the edge cluster global identifiers
+	TypeEdgeCluster = "EdgeCluster"
+
+	// TypeEdgeClusterProject is the type name of the global identifiers of the project an edge cluster belongs to
+	TypeEdgeClusterProject = "EdgeClusterProject"
+)
+
+// GlobalIDContract declares the service that converts the backend identifiers to the global identifiers reported to
+// the clients and back. The global identifier carries the GraphQL type name so the object can be fetched by the
+// identifier only.
+type GlobalIDContract interface {
+	// ToGlobalID returns the identifier reported to the clients for the backend object
+	// typeName: Mandatory. The name of the GraphQL type of the object
+	// id: Mandatory. The backend object unique identifier
+	// Returns the global identifier, or the backend identifier if the raw backend identifiers are reported
+	ToGlobalID(typeName string, id string) graphql.ID
+
+	// FromGlobalID returns the backend identifier of the identifier sent by the client
+	// id: Mandatory. The identifier sent by the client
+	// typeNames: Mandatory. The names of the GraphQL types the identifier is expected to refer to
+	// Returns the backend object unique identifier or error if the identifier is not valid or refers to another type
+	FromGlobalID(id graphql.ID, typeNames ...string) (string, error)
+
+	// FromGlobalIDs returns the backend identifiers of the identifiers sent by the client
+	// ids: Mandatory. The identifiers sent by the client
+	// typeNames: Mandatory. The names of the GraphQL types the identifiers are expected to refer to
+	// Returns the backend object unique identifiers in the same order or error if any of the identifiers is not valid or refers to another type
+	FromGlobalIDs(ids []graphql.ID, typeNames ...string) ([]string, error)
+
+	// ParseGlobalID decodes the global identifier sent by the client
+	// id: Mandatory. The global identifier
+	// Returns the name of the GraphQL type and the backend object unique identifier or error if the identifier is not a valid global identifier
+	ParseGlobalID(id graphql.ID) (string, string, error)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/globalid/contract.go
+
+// Package mock_globalid is a generated GoMock package.
+package mock_globalid
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+// MockGlobalIDContract is a mock of GlobalIDContract interface.
+type MockGlobalIDContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockGlobalIDContractMockRecorder
+}
+
+// MockGlobalIDContractMockRecorder is the mock recorder for MockGlobalIDContract.
+type MockGlobalIDContractMockRecorder struct {
+	mock *MockGlobalIDContract
+}
+
+// NewMockGlobalIDContract creates a new mock instance.
+func NewMockGlobalIDContract(ctrl *gomock.Controller) *MockGlobalIDContract {
+	mock := &MockGlobalIDContract{ctrl: ctrl}
+	mock.recorder = &MockGlobalIDContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGlobalIDContract) EXPECT() *MockGlobalIDContractMockRecorder {
+	return m.recorder
+}
+
+// FromGlobalID mocks base method.
+func (m *MockGlobalIDContract) FromGlobalID(id graphql.ID, typeNames ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range typeNames {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FromGlobalID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FromGlobalID indicates an expected call of FromGlobalID.
+func (mr *MockGlobalIDContractMockRecorder) FromGlobalID(id interface{}, typeNames ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, typeNames...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromGlobalID", reflect.TypeOf((*MockGlobalIDContract)(nil).FromGlobalID), varargs...)
+}
+
+// FromGlobalIDs mocks base method.
+func (m *MockGlobalIDContract) FromGlobalIDs(ids []graphql.ID, typeNames ...string) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ids}
+	for _, a := range typeNames {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FromGlobalIDs", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FromGlobalIDs indicates an expected call of FromGlobalIDs.
+func (mr *MockGlobalIDContractMockRecorder) FromGlobalIDs(ids interface{}, typeNames ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ids}, typeNames...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromGlobalIDs", reflect.TypeOf((*MockGlobalIDContract)(nil).FromGlobalIDs), varargs...)
+}
+
+// ParseGlobalID mocks base method.
+func (m *MockGlobalIDContract) ParseGlobalID(id graphql.ID) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseGlobalID", id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ParseGlobalID indicates an expected call of ParseGlobalID.
+func (mr *MockGlobalIDContractMockRecorder) ParseGlobalID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseGlobalID", reflect.TypeOf((*MockGlobalIDContract)(nil).ParseGlobalID), id)
+}
+
+// ToGlobalID mocks base method.
+func (m *MockGlobalIDContract) ToGlobalID(typeName, id string) graphql.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToGlobalID", typeName, id)
+	ret0, _ := ret[0].(graphql.ID)
+	return ret0
+}
+
+// ToGlobalID indicates an expected call of ToGlobalID.
+func (mr *MockGlobalIDContractMockRecorder) ToGlobalID(typeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToGlobalID", reflect.TypeOf((*MockGlobalIDContract)(nil).ToGlobalID), typeName, id)
+}
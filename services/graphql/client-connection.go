@@ -0,0 +1,151 @@
+// Package graphql implements functions to expose api-gateway service endpoint using GraphQL protocol.
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decentralized-cloud/api-gateway/services/certificate"
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	_ "google.golang.org/grpc/health" // Registers the client side health checking used by the service config below
+	healthGrpcContract "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+// clientServiceConfig enables the client side health checking so sub-connections reported as not serving by
+// the backend service are taken out of rotation until they recover. Client side health checking is only
+// supported by the round_robin load balancing policy.
+const clientServiceConfig = `{
+	"loadBalancingPolicy": "round_robin",
+	"healthCheckConfig": {
+		"serviceName": ""
+	}
+}`
+
+// newClientConnection creates the long-lived gRPC connection to the given backend service address.
+// The connection is established in background and is re-established automatically if it breaks. The calls made over
+// the connection are bounded by the configured timeouts, the failed idempotent calls are retried and the backend service
+// is protected by a circuit breaker and a bulkhead.
+// configurationService: Mandatory. Reference to the configuration service
+// serviceName: Mandatory. The name of the backend service
+// serviceAddress: Mandatory. The backend service full gRPC address
+// Returns the new connection or error if something goes wrong
+func newClientConnection(
+	configurationService configuration.ConfigurationContract,
+	serviceName string,
+	serviceAddress string) (*grpc.ClientConn, error) {
+	maxMessageSize, err := configurationService.GetGrpcMaxMessageSize()
+	if err != nil {
+		return nil, err
+	}
+
+	keepaliveTime, err := configurationService.GetGrpcKeepaliveTime()
+	if err != nil {
+		return nil, err
+	}
+
+	keepaliveTimeout, err := configurationService.GetGrpcKeepaliveTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	transportCredentialsOption, err := newTransportCredentialsOption(configurationService)
+	if err != nil {
+		return nil, err
+	}
+
+	clientInterceptorsOption, err := newClientInterceptorsOption(configurationService, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.Dial(
+		serviceAddress,
+		transportCredentialsOption,
+		clientInterceptorsOption,
+		grpc.WithDefaultServiceConfig(clientServiceConfig),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMessageSize),
+			grpc.MaxCallSendMsgSize(maxMessageSize)),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    keepaliveTime,
+			Timeout: keepaliveTimeout,
+		}),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.DefaultConfig,
+		}))
+}
+
+// newTransportCredentialsOption creates the dial option that secures the connection to the backend service using TLS,
+// or the plain text dial option if TLS is not enabled
+// configurationService: Mandatory. Reference to the configuration service
+// Returns the dial option or error if something goes wrong
+func newTransportCredentialsOption(configurationService configuration.ConfigurationContract) (grpc.DialOption, error) {
+	tlsEnabled, err := configurationService.GetGrpcTLSEnabled()
+	if err != nil {
+		return nil, err
+	}
+
+	if !tlsEnabled {
+		return grpc.WithInsecure(), nil
+	}
+
+	caFile, err := configurationService.GetGrpcCAFile()
+	if err != nil {
+		return nil, err
+	}
+
+	certFile, err := configurationService.GetGrpcCertFile()
+	if err != nil {
+		return nil, err
+	}
+
+	keyFile, err := configurationService.GetGrpcKeyFile()
+	if err != nil {
+		return nil, err
+	}
+
+	serverNameOverride, err := configurationService.GetGrpcServerNameOverride()
+	if err != nil {
+		return nil, err
+	}
+
+	reloader, err := certificate.NewFileCertificateReloader(certFile, keyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	transportCredentials, err := certificate.NewClientTransportCredentials(reloader, serverNameOverride)
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.WithTransportCredentials(transportCredentials), nil
+}
+
+// checkConnectionHealth queries the standard gRPC health service over the given connection. Backend services that
+// do not implement the health service are considered healthy as long as the connection is ready.
+// ctx: Mandatory. Reference to the context
+// connection: Mandatory. The connection to the backend service
+// Returns error if the backend service is not serving or something goes wrong
+func checkConnectionHealth(ctx context.Context, connection *grpc.ClientConn) error {
+	response, err := healthGrpcContract.NewHealthClient(connection).Check(ctx, &healthGrpcContract.HealthCheckRequest{})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented && connection.GetState() == connectivity.Ready {
+			return nil
+		}
+
+		return err
+	}
+
+	if response.Status != healthGrpcContract.HealthCheckResponse_SERVING {
+		return fmt.Errorf("service is not serving. Status: %v", response.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package graphql_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// certificateAuthority issues the certificates used by the backend service and the gateway in the tests
+type certificateAuthority struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certPEM     []byte
+}
+
+// fakeTLSProjectServer records the common name of the client certificate presented by every ListProjects call
+type fakeTLSProjectServer struct {
+	projectGrpcContract.UnimplementedServiceServer
+	lock        sync.Mutex
+	clientNames []string
+}
+
+func (server *fakeTLSProjectServer) ListProjects(
+	ctx context.Context,
+	request *projectGrpcContract.ListProjectsRequest) (*projectGrpcContract.ListProjectsResponse, error) {
+	peerInfo, _ := peer.FromContext(ctx)
+	tlsInfo := peerInfo.AuthInfo.(credentials.TLSInfo)
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	server.clientNames = append(server.clientNames, tlsInfo.State.PeerCertificates[0].Subject.CommonName)
+
+	return &projectGrpcContract.ListProjectsResponse{}, nil
+}
+
+func TestProjectClientService_MutualTLSPicksUpTheRotatedCertificates(t *testing.T) {
+	directory := t.TempDir()
+	caFile := filepath.Join(directory, "ca.pem")
+	certFile := filepath.Join(directory, "client.pem")
+	keyFile := filepath.Join(directory, "client-key.pem")
+
+	firstAuthority := newCertificateAuthority(t, "first-ca")
+	writeFile(t, caFile, firstAuthority.certPEM)
+	writeKeyPair(t, firstAuthority, "gateway-1", certFile, keyFile)
+
+	server := &fakeTLSProjectServer{}
+	serviceAddress, firstGrpcServer := startProjectServer(
+		t,
+		"127.0.0.1:0",
+		server,
+		newServerCredentialsOption(t, firstAuthority))
+
+	client := newProjectClient(t, grpcTestConfiguration{
+		serviceAddress:     serviceAddress,
+		maxConcurrentCalls: 10,
+		tlsEnabled:         true,
+		caFile:             caFile,
+		certFile:           certFile,
+		keyFile:            keyFile,
+		serverNameOverride: "project-service",
+	})
+
+	listProjects(t, client)
+
+	// Rotate both the CA bundle and the client key pair. The restarted backend service only trusts the new CA and
+	// presents a certificate issued by it, so the new connection only succeeds if the rotated files are reloaded.
+	secondAuthority := newCertificateAuthority(t, "second-ca")
+	writeFile(t, caFile, secondAuthority.certPEM)
+	writeKeyPair(t, secondAuthority, "gateway-2", certFile, keyFile)
+
+	firstGrpcServer.Stop()
+	startProjectServer(t, serviceAddress, server, newServerCredentialsOption(t, secondAuthority))
+
+	listProjects(t, client)
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	if len(server.clientNames) != 2 || server.clientNames[0] != "gateway-1" || server.clientNames[1] != "gateway-2" {
+		t.Fatalf("expected the rotated client certificate to be presented, got %v", server.clientNames)
+	}
+}
+
+func TestProjectClientService_RejectsTheUntrustedBackendService(t *testing.T) {
+	directory := t.TempDir()
+	caFile := filepath.Join(directory, "ca.pem")
+	certFile := filepath.Join(directory, "client.pem")
+	keyFile := filepath.Join(directory, "client-key.pem")
+
+	trustedAuthority := newCertificateAuthority(t, "trusted-ca")
+	writeFile(t, caFile, trustedAuthority.certPEM)
+	writeKeyPair(t, trustedAuthority, "gateway", certFile, keyFile)
+
+	serviceAddress, _ := startProjectServer(
+		t,
+		"127.0.0.1:0",
+		&fakeTLSProjectServer{},
+		newServerCredentialsOption(t, newCertificateAuthority(t, "untrusted-ca")))
+
+	client := newProjectClient(t, grpcTestConfiguration{
+		serviceAddress:     serviceAddress,
+		maxConcurrentCalls: 10,
+		tlsEnabled:         true,
+		caFile:             caFile,
+		certFile:           certFile,
+		keyFile:            keyFile,
+		serverNameOverride: "project-service",
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if _, err := client.ListProjects(ctx, &projectGrpcContract.ListProjectsRequest{}); err == nil {
+		t.Fatal("expected the backend service certificate issued by an untrusted CA to be rejected")
+	}
+}
+
+// listProjects lists the projects, retrying until the connection to the backend service is re-established after the
+// backend service restarts
+func listProjects(t *testing.T, client projectGrpcContract.ServiceClient) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for {
+		_, err := client.ListProjects(ctx, &projectGrpcContract.ListProjectsRequest{}, grpc.WaitForReady(true))
+		if err == nil {
+			return
+		}
+
+		if ctx.Err() != nil {
+			t.Fatalf("failed to list the projects over TLS: %v", err)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// newServerCredentialsOption creates the backend service credentials that present a certificate issued by the CA and
+// require the client certificates issued by the same CA
+func newServerCredentialsOption(t *testing.T, authority *certificateAuthority) grpc.ServerOption {
+	t.Helper()
+
+	certPEM, keyPEM := authority.issue(t, "project-service", x509.ExtKeyUsageServerAuth)
+
+	certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(authority.certificate)
+
+	return grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}))
+}
+
+func newCertificateAuthority(t *testing.T, commonName string) *certificateAuthority {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &certificateAuthority{
+		certificate: certificate,
+		key:         key,
+		certPEM:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue creates a new key pair whose certificate is issued by the CA for the given common name
+// Returns the PEM encoded certificate and private key
+func (authority *certificateAuthority) issue(t *testing.T, commonName string, extKeyUsage x509.ExtKeyUsage) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{extKeyUsage},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, authority.certificate, &key.PublicKey, authority.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeKeyPair(t *testing.T, authority *certificateAuthority, commonName string, certFile string, keyFile string) {
+	t.Helper()
+
+	certPEM, keyPEM := authority.issue(t, commonName, x509.ExtKeyUsageClientAuth)
+	writeFile(t, certFile, certPEM)
+	writeFile(t, keyFile, keyPEM)
+}
+
+// writeFile writes the file and moves its modification time forward, so the change is detected even on file systems
+// with a coarse modification time resolution
+func writeFile(t *testing.T, path string, content []byte) {
+	t.Helper()
+
+	modTime := time.Now()
+	if fileInfo, err := os.Stat(path); err == nil {
+		modTime = fileInfo.ModTime().Add(time.Second)
+	}
+
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("failed to change the modification time of %s: %v", path, err)
+	}
+}
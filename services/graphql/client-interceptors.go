@@ -0,0 +1,287 @@
+// Package graphql implements functions to expose api-gateway service endpoint using GraphQL protocol.
+package graphql
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"github.com/go-kit/kit/circuitbreaker"
+	grpcPrometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/sony/gobreaker"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// idempotentMethodPrefixes contains the prefixes of the backend methods that only read data and are safe to retry
+var idempotentMethodPrefixes = []string{"List", "Read"}
+
+// retryableCodes contains the status codes of the failures that are expected to be transient
+var retryableCodes = map[codes.Code]bool{
+	codes.Unavailable:       true,
+	codes.ResourceExhausted: true,
+	codes.Aborted:           true,
+	codes.DeadlineExceeded:  true,
+}
+
+// circuitBreakerFailureCodes contains the status codes of the failures that indicate the backend service is unhealthy.
+// The other failures, such as the validation errors, are caused by the request and do not count against the circuit breaker.
+var circuitBreakerFailureCodes = map[codes.Code]bool{
+	codes.Unavailable:      true,
+	codes.DeadlineExceeded: true,
+	codes.Internal:         true,
+	codes.Unknown:          true,
+}
+
+// newClientInterceptorsOption creates the dial option that applies the configured timeouts to all the backend gRPC calls,
+// retries the failed idempotent calls, protects the backend service with a circuit breaker and a bulkhead, and traces and measures the calls
+// configurationService: Mandatory. Reference to the configuration service
+// serviceName: Mandatory. The name of the backend service used to label the circuit breaker and the bulkhead metrics
+// Returns the dial option or error if something goes wrong
+func newClientInterceptorsOption(
+	configurationService configuration.ConfigurationContract,
+	serviceName string) (grpc.DialOption, error) {
+	defaultTimeout, err := configurationService.GetGrpcDefaultTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	methodTimeouts, err := configurationService.GetGrpcMethodTimeouts()
+	if err != nil {
+		return nil, err
+	}
+
+	maxRetries, err := configurationService.GetGrpcMaxRetries()
+	if err != nil {
+		return nil, err
+	}
+
+	retryBackoff, err := configurationService.GetGrpcRetryBackoff()
+	if err != nil {
+		return nil, err
+	}
+
+	retryMaxBackoff, err := configurationService.GetGrpcRetryMaxBackoff()
+	if err != nil {
+		return nil, err
+	}
+
+	failureThreshold, err := configurationService.GetGrpcCircuitBreakerFailureThreshold()
+	if err != nil {
+		return nil, err
+	}
+
+	openTimeout, err := configurationService.GetGrpcCircuitBreakerOpenTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	halfOpenMaxRequests, err := configurationService.GetGrpcCircuitBreakerHalfOpenMaxRequests()
+	if err != nil {
+		return nil, err
+	}
+
+	maxConcurrentCalls, err := configurationService.GetGrpcMaxConcurrentCalls()
+	if err != nil {
+		return nil, err
+	}
+
+	if maxConcurrentCalls <= 0 {
+		return nil, commonErrors.NewUnknownError("GRPC_MAX_CONCURRENT_CALLS must be greater than zero")
+	}
+
+	// The timeout interceptor is the outer one so the timeout bounds the call including all of its retries. The bulkhead
+	// holds a slot for the whole call, so the calls it rejects are neither retried nor counted against the circuit breaker.
+	// The circuit breaker sees the outcome of the call after the retries. Every attempt sent to the backend service gets
+	// its own client span carrying the trace context and is reported in the metrics.
+	return grpc.WithChainUnaryInterceptor(
+		newTimeoutInterceptor(defaultTimeout, methodTimeouts),
+		newBulkheadInterceptor(serviceName, maxConcurrentCalls),
+		newCircuitBreakerInterceptor(serviceName, failureThreshold, openTimeout, halfOpenMaxRequests),
+		newRetryInterceptor(maxRetries, retryBackoff, retryMaxBackoff),
+		otelgrpc.UnaryClientInterceptor(),
+		grpcPrometheus.UnaryClientInterceptor), nil
+}
+
+// newTimeoutInterceptor creates the interceptor that sets the deadline of the backend gRPC calls. The deadline of the
+// incoming request is kept if it expires earlier.
+func newTimeoutInterceptor(defaultTimeout time.Duration, methodTimeouts map[string]time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		request interface{},
+		reply interface{},
+		connection *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+		timeout, ok := methodTimeouts[methodName(method)]
+		if !ok {
+			timeout = defaultTimeout
+		}
+
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, request, reply, connection, opts...)
+	}
+}
+
+// newRetryInterceptor creates the interceptor that retries the idempotent backend gRPC calls failed with a transient
+// error. The delay between the attempts grows exponentially and is fully jittered so the retries of the concurrent
+// requests are spread over time.
+func newRetryInterceptor(maxRetries int, backoff time.Duration, maxBackoff time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		request interface{},
+		reply interface{},
+		connection *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, request, reply, connection, opts...)
+		if !isIdempotentMethod(method) {
+			return err
+		}
+
+		for attempt := 0; attempt < maxRetries && err != nil && retryableCodes[status.Code(err)]; attempt++ {
+			// The call failed because the deadline of the whole call expired, retrying would fail the same way
+			if ctx.Err() != nil {
+				return err
+			}
+
+			timer := time.NewTimer(jitteredBackoff(attempt, backoff, maxBackoff))
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return err
+			case <-timer.C:
+			}
+
+			err = invoker(ctx, method, request, reply, connection, opts...)
+		}
+
+		return err
+	}
+}
+
+// newCircuitBreakerInterceptor creates the interceptor that stops calling the backend service once the number of
+// consecutive failures reaches the threshold. While the circuit breaker is open the calls fail fast with the Unavailable
+// status code, and once the open timeout elapses a limited number of trial calls decide whether to close it again.
+func newCircuitBreakerInterceptor(
+	serviceName string,
+	failureThreshold int,
+	openTimeout time.Duration,
+	halfOpenMaxRequests int) grpc.UnaryClientInterceptor {
+	circuitBreakerStateGauge.WithLabelValues(serviceName).Set(float64(gobreaker.StateClosed))
+
+	breaker := circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:        serviceName,
+		MaxRequests: uint32(halfOpenMaxRequests),
+		Timeout:     openTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= uint32(failureThreshold)
+		},
+		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
+			circuitBreakerStateGauge.WithLabelValues(name).Set(float64(to))
+		},
+		IsSuccessful: func(err error) bool {
+			return err == nil || !circuitBreakerFailureCodes[status.Code(err)]
+		},
+	}))
+
+	return func(
+		ctx context.Context,
+		method string,
+		request interface{},
+		reply interface{},
+		connection *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+		_, err := breaker(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return nil, invoker(ctx, method, request, reply, connection, opts...)
+		})(ctx, nil)
+
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			return status.Errorf(codes.Unavailable, "The %s service circuit breaker is open", serviceName)
+		}
+
+		return err
+	}
+}
+
+// newBulkheadInterceptor creates the interceptor that limits the number of in-flight calls to the backend service so a
+// slow backend service can not exhaust the resources shared with the other backend services. The calls exceeding the
+// limit are rejected immediately with the ResourceExhausted status code. The interceptor must be chained outside the
+// retry interceptor, otherwise its own rejections would be retried.
+func newBulkheadInterceptor(serviceName string, maxConcurrentCalls int) grpc.UnaryClientInterceptor {
+	inFlightGauge := bulkheadInFlightGauge.WithLabelValues(serviceName)
+	rejectedCounter := bulkheadRejectedCounter.WithLabelValues(serviceName)
+	slots := make(chan struct{}, maxConcurrentCalls)
+
+	return func(
+		ctx context.Context,
+		method string,
+		request interface{},
+		reply interface{},
+		connection *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+		select {
+		case slots <- struct{}{}:
+		default:
+			rejectedCounter.Inc()
+
+			return status.Errorf(codes.ResourceExhausted, "Too many concurrent calls to the %s service", serviceName)
+		}
+
+		inFlightGauge.Inc()
+
+		defer func() {
+			inFlightGauge.Dec()
+			<-slots
+		}()
+
+		return invoker(ctx, method, request, reply, connection, opts...)
+	}
+}
+
+func jitteredBackoff(attempt int, backoff time.Duration, maxBackoff time.Duration) time.Duration {
+	delay := backoff << uint(attempt)
+	if delay <= 0 || delay > maxBackoff {
+		delay = maxBackoff
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+func isIdempotentMethod(method string) bool {
+	name := methodName(method)
+
+	for _, prefix := range idempotentMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// methodName returns the method name from the full method in the /package.Service/Method format
+func methodName(method string) string {
+	return method[strings.LastIndex(method, "/")+1:]
+}
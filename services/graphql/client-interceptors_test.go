@@ -0,0 +1,244 @@
+package graphql_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/graphql"
+	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcTestConfiguration contains the gRPC client configurations that differ between the tests
+type grpcTestConfiguration struct {
+	serviceAddress     string
+	maxRetries         int
+	maxConcurrentCalls int
+	tlsEnabled         bool
+	caFile             string
+	certFile           string
+	keyFile            string
+	serverNameOverride string
+}
+
+// fakeProjectServer blocks the ListProjects calls until they are released and fails the ReadProject calls as
+// an overloaded backend service does
+type fakeProjectServer struct {
+	projectGrpcContract.UnimplementedServiceServer
+	lock             sync.Mutex
+	listProjectCalls int
+	readProjectCalls int
+	listStarted      chan struct{}
+	release          chan struct{}
+}
+
+func (server *fakeProjectServer) ListProjects(
+	ctx context.Context,
+	request *projectGrpcContract.ListProjectsRequest) (*projectGrpcContract.ListProjectsResponse, error) {
+	server.lock.Lock()
+	server.listProjectCalls++
+	server.lock.Unlock()
+
+	server.listStarted <- struct{}{}
+	<-server.release
+
+	return &projectGrpcContract.ListProjectsResponse{}, nil
+}
+
+func (server *fakeProjectServer) ReadProject(
+	ctx context.Context,
+	request *projectGrpcContract.ReadProjectRequest) (*projectGrpcContract.ReadProjectResponse, error) {
+	server.lock.Lock()
+	server.readProjectCalls++
+	server.lock.Unlock()
+
+	return nil, status.Error(codes.ResourceExhausted, "backend service is overloaded")
+}
+
+func TestProjectClientService_BulkheadRejectionsAreNotRetried(t *testing.T) {
+	server := &fakeProjectServer{
+		listStarted: make(chan struct{}, 10),
+		release:     make(chan struct{}),
+	}
+
+	serviceAddress, _ := startProjectServer(t, "127.0.0.1:0", server)
+	client := newProjectClient(t, grpcTestConfiguration{
+		serviceAddress:     serviceAddress,
+		maxRetries:         3,
+		maxConcurrentCalls: 1,
+	})
+
+	rejectedBefore := getBulkheadRejectedCount(t, "project")
+
+	firstCallResult := make(chan error, 1)
+	go func() {
+		_, err := client.ListProjects(context.Background(), &projectGrpcContract.ListProjectsRequest{})
+		firstCallResult <- err
+	}()
+
+	<-server.listStarted
+
+	_, err := client.ListProjects(context.Background(), &projectGrpcContract.ListProjectsRequest{})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the call exceeding the limit to be rejected, got %v", err)
+	}
+
+	if rejected := getBulkheadRejectedCount(t, "project") - rejectedBefore; rejected != 1 {
+		t.Fatalf("expected the rejected call not to be retried, got %v rejections", rejected)
+	}
+
+	close(server.release)
+
+	if err := <-firstCallResult; err != nil {
+		t.Fatalf("expected the call holding the slot to succeed, got %v", err)
+	}
+
+	if _, err := client.ListProjects(context.Background(), &projectGrpcContract.ListProjectsRequest{}); err != nil {
+		t.Fatalf("expected the slot to be released, got %v", err)
+	}
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	if server.listProjectCalls != 2 {
+		t.Fatalf("expected 2 calls to reach the backend service, got %d", server.listProjectCalls)
+	}
+}
+
+func TestProjectClientService_RetriesTheBackendServiceResourceExhaustedFailures(t *testing.T) {
+	server := &fakeProjectServer{}
+
+	serviceAddress, _ := startProjectServer(t, "127.0.0.1:0", server)
+	client := newProjectClient(t, grpcTestConfiguration{
+		serviceAddress:     serviceAddress,
+		maxRetries:         2,
+		maxConcurrentCalls: 1,
+	})
+
+	_, err := client.ReadProject(context.Background(), &projectGrpcContract.ReadProjectRequest{ProjectID: "p1"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the backend service failure, got %v", err)
+	}
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	if server.readProjectCalls != 3 {
+		t.Fatalf("expected the call to be retried twice, got %d calls", server.readProjectCalls)
+	}
+}
+
+func TestProjectClientService_RejectsNonPositiveMaxConcurrentCalls(t *testing.T) {
+	for _, maxConcurrentCalls := range []int{0, -1} {
+		configurationService := newGrpcConfigurationService(t, grpcTestConfiguration{
+			serviceAddress:     "127.0.0.1:0",
+			maxConcurrentCalls: maxConcurrentCalls,
+		})
+
+		if _, err := graphql.NewProjectClientService(configurationService); err == nil {
+			t.Fatalf("expected GRPC_MAX_CONCURRENT_CALLS=%d to be rejected", maxConcurrentCalls)
+		}
+	}
+}
+
+// startProjectServer serves the project service on the given address until the test ends
+// Returns the address the project service is served on and the gRPC server
+func startProjectServer(
+	t *testing.T,
+	address string,
+	server projectGrpcContract.ServiceServer,
+	opts ...grpc.ServerOption) (string, *grpc.Server) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	projectGrpcContract.RegisterServiceServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	return listener.Addr().String(), grpcServer
+}
+
+func newProjectClient(t *testing.T, config grpcTestConfiguration) projectGrpcContract.ServiceClient {
+	t.Helper()
+
+	projectClientService, err := graphql.NewProjectClientService(newGrpcConfigurationService(t, config))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = projectClientService.Close()
+	})
+
+	return projectClientService.GetClient()
+}
+
+func newGrpcConfigurationService(t *testing.T, config grpcTestConfiguration) *mock_configuration.MockConfigurationContract {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetProjectServiceAddress().Return(config.serviceAddress, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcMaxMessageSize().Return(4*1024*1024, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcKeepaliveTime().Return(time.Minute, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcKeepaliveTimeout().Return(20*time.Second, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcTLSEnabled().Return(config.tlsEnabled, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcCAFile().Return(config.caFile, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcCertFile().Return(config.certFile, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcKeyFile().Return(config.keyFile, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcServerNameOverride().Return(config.serverNameOverride, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcDefaultTimeout().Return(5*time.Second, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcMethodTimeouts().Return(map[string]time.Duration{}, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcMaxRetries().Return(config.maxRetries, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcRetryBackoff().Return(time.Millisecond, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcRetryMaxBackoff().Return(10*time.Millisecond, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcCircuitBreakerFailureThreshold().Return(100, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcCircuitBreakerOpenTimeout().Return(time.Minute, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcCircuitBreakerHalfOpenMaxRequests().Return(1, nil).AnyTimes()
+	configurationService.EXPECT().GetGrpcMaxConcurrentCalls().Return(config.maxConcurrentCalls, nil).AnyTimes()
+
+	return configurationService
+}
+
+// getBulkheadRejectedCount returns the number of calls to the backend service the bulkhead rejected so far
+func getBulkheadRejectedCount(t *testing.T, serviceName string) float64 {
+	t.Helper()
+
+	metricFamilies, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != "api_gateway_bulkhead_rejected_total" {
+			continue
+		}
+
+		for _, metric := range metricFamily.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "service" && label.GetValue() == serviceName {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+
+	return 0
+}
@@ -1 +0,0 @@
-package graphql_test
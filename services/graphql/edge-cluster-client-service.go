@@ -2,6 +2,8 @@
 package graphql
 
 import (
+	"context"
+
 	"github.com/decentralized-cloud/api-gateway/services/configuration"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
 	edgeClusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
@@ -10,7 +12,8 @@ import (
 )
 
 type edgeClusterClientService struct {
-	serviceAddress string
+	connection *grpc.ClientConn
+	client     edgeClusterGrpcContract.ServiceClient
 }
 
 // NewEdgeClusterClientService creates new instance of the edgeClusterClientService, setting up all dependencies and returns the instance
@@ -27,19 +30,33 @@ func NewEdgeClusterClientService(
 		return nil, err
 	}
 
+	connection, err := newClientConnection(configurationService, "edge-cluster", serviceAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	return &edgeClusterClientService{
-		serviceAddress: serviceAddress,
+		connection: connection,
+		client:     edgeClusterGrpcContract.NewServiceClient(connection),
 	}, nil
 }
 
-// CreateClient creats a new edge cluster gRPC client and returns the connection
-// and the client to the caller.
-// Returns connection and the edge cluster gRPC client or error if something goes wrong.
-func (service *edgeClusterClientService) CreateClient() (*grpc.ClientConn, edgeClusterGrpcContract.ServiceClient, error) {
-	connection, err := grpc.Dial(service.serviceAddress, grpc.WithInsecure())
-	if err != nil {
-		return nil, nil, err
-	}
+// GetClient returns the edge cluster gRPC client that uses the long-lived connection shared
+// between all callers.
+// Returns the edge cluster gRPC client.
+func (service *edgeClusterClientService) GetClient() edgeClusterGrpcContract.ServiceClient {
+	return service.client
+}
+
+// CheckHealth queries the standard gRPC health service of the edge cluster service.
+// ctx: Mandatory. Reference to the context
+// Returns error if the edge cluster service is not serving or something goes wrong.
+func (service *edgeClusterClientService) CheckHealth(ctx context.Context) error {
+	return checkConnectionHealth(ctx, service.connection)
+}
 
-	return connection, edgeClusterGrpcContract.NewServiceClient(connection), nil
+// Close closes the shared connection to the edge cluster service.
+// Returns error if something goes wrong.
+func (service *edgeClusterClientService) Close() error {
+	return service.connection.Close()
 }
@@ -0,0 +1,37 @@
+// Package graphql implements functions to expose api-gateway service endpoint using GraphQL protocol.
+package graphql
+
+import (
+	grpcPrometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	circuitBreakerStateGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "api_gateway_circuit_breaker_state",
+			Help: "The state of the circuit breaker of the backend service, 0 for closed, 1 for half-open and 2 for open",
+		},
+		[]string{"service"})
+
+	bulkheadInFlightGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "api_gateway_bulkhead_in_flight",
+			Help: "The number of in-flight gRPC calls to the backend service",
+		},
+		[]string{"service"})
+
+	bulkheadRejectedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "api_gateway_bulkhead_rejected_total",
+			Help: "The number of gRPC calls rejected because the maximum number of concurrent calls to the backend service was reached",
+		},
+		[]string{"service"})
+)
+
+// init turns on the latency histogram of the backend gRPC calls. The calls are reported per backend service and method
+// so the number of label values is bounded by the backend service contracts.
+func init() {
+	grpcPrometheus.EnableClientHandlingTimeHistogram()
+}
@@ -3,21 +3,26 @@ package edgecluster
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/policy"
 	edgeclusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
 )
 
 type createEdgeCluster struct {
-	logger                   *zap.Logger
-	resolverCreator          types.ResolverCreatorContract
-	edgeClusterClientService edgecluster.EdgeClusterClientContract
+	logger                      *zap.Logger
+	globalIDService             globalid.GlobalIDContract
+	resolverCreator             types.ResolverCreatorContract
+	edgeClusterClientService    edgecluster.EdgeClusterClientContract
+	projectAuthorizationService authorization.ProjectAuthorizationContract
 }
 
 type createEdgeClusterPayloadResolver struct {
@@ -32,12 +37,17 @@ type createEdgeClusterPayloadResolver struct {
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
 // logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
+// edgeClusterClientService: Mandatory. the edge cluster client service that creates gRPC connection and client to the edge cluster
+// projectAuthorizationService: Mandatory. the service that authorizes the action on the target project
 // Returns the new instance or error if something goes wrong
 func NewCreateEdgeCluster(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
 	logger *zap.Logger,
-	edgeClusterClientService edgecluster.EdgeClusterClientContract) (edgecluster.CreateEdgeClusterContract, error) {
+	globalIDService globalid.GlobalIDContract,
+	edgeClusterClientService edgecluster.EdgeClusterClientContract,
+	projectAuthorizationService authorization.ProjectAuthorizationContract) (edgecluster.CreateEdgeClusterContract, error) {
 	if ctx == nil {
 		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
 	}
@@ -50,14 +60,24 @@ func NewCreateEdgeCluster(
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
 	if edgeClusterClientService == nil {
 		return nil, commonErrors.NewArgumentNilError("edgeClusterClientService", "edgeClusterClientService is required")
 	}
 
+	if projectAuthorizationService == nil {
+		return nil, commonErrors.NewArgumentNilError("projectAuthorizationService", "projectAuthorizationService is required")
+	}
+
 	return &createEdgeCluster{
-		logger:                   logger,
-		resolverCreator:          resolverCreator,
-		edgeClusterClientService: edgeClusterClientService,
+		logger:                      logger,
+		globalIDService:             globalIDService,
+		resolverCreator:             resolverCreator,
+		edgeClusterClientService:    edgeClusterClientService,
+		projectAuthorizationService: projectAuthorizationService,
 	}, nil
 }
 
@@ -112,14 +132,16 @@ func NewCreateEdgeClusterPayloadResolver(
 func (m *createEdgeCluster) MutateAndGetPayload(
 	ctx context.Context,
 	args edgecluster.CreateEdgeClusterInputArgument) (edgecluster.CreateEdgeClusterPayloadResolverContract, error) {
-	connection, edgeClusterServiceClient, err := m.edgeClusterClientService.CreateClient()
+	projectID, err := m.globalIDService.FromGlobalID(args.Input.ProjectID, globalid.TypeProject, globalid.TypeEdgeClusterProject)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		_ = connection.Close()
-	}()
+	if err := m.projectAuthorizationService.AuthorizeProjectAction(ctx, projectID, policy.ActionCreateEdgeCluster); err != nil {
+		return nil, err
+	}
+
+	edgeClusterServiceClient := m.edgeClusterClientService.GetClient()
 
 	var clusterType edgeclusterGrpcContract.ClusterType
 
@@ -133,17 +155,17 @@ func (m *createEdgeCluster) MutateAndGetPayload(
 		ctx,
 		&edgeclusterGrpcContract.CreateEdgeClusterRequest{
 			EdgeCluster: &edgeclusterGrpcContract.EdgeCluster{
-				ProjectID:     string(args.Input.ProjectID),
+				ProjectID:     projectID,
 				Name:          args.Input.Name,
 				ClusterSecret: args.Input.ClusterSecret,
 				ClusterType:   clusterType,
 			}})
 	if err != nil {
-		return nil, err
+		return nil, errortranslation.FromError(err)
 	}
 
-	if response.Error != edgeclusterGrpcContract.Error_NO_ERROR {
-		return nil, errors.New(response.ErrorMessage)
+	if err := errortranslation.FromEdgeClusterError(response.Error, response.ErrorMessage); err != nil {
+		return nil, err
 	}
 
 	return m.resolverCreator.NewCreateEdgeClusterPayloadResolver(
@@ -3,10 +3,14 @@ package edgecluster
 
 import (
 	"context"
-	"errors"
 
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	"github.com/decentralized-cloud/api-gateway/services/dataloader"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/policy"
 	edgeclusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
 	"github.com/graph-gophers/graphql-go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
@@ -14,13 +18,17 @@ import (
 )
 
 type deleteEdgeCluster struct {
-	logger                   *zap.Logger
-	resolverCreator          types.ResolverCreatorContract
-	edgeClusterClientService edgecluster.EdgeClusterClientContract
+	logger                      *zap.Logger
+	globalIDService             globalid.GlobalIDContract
+	resolverCreator             types.ResolverCreatorContract
+	edgeClusterClientService    edgecluster.EdgeClusterClientContract
+	dataLoader                  dataloader.DataLoaderContract
+	projectAuthorizationService authorization.ProjectAuthorizationContract
 }
 
 type deleteEdgeClusterPayloadResolver struct {
 	resolverCreator  types.ResolverCreatorContract
+	globalIDService  globalid.GlobalIDContract
 	edgeClusterID    string
 	clientMutationId *string
 }
@@ -28,13 +36,21 @@ type deleteEdgeClusterPayloadResolver struct {
 // NewDeleteEdgeCluster deletes new instance of the deleteEdgeCluster, setting up all dependencies and returns the instance
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can delete new instances of resolvers
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
 // logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
+// edgeClusterClientService: Mandatory. the edge cluster client service that creates gRPC connection and client to the edge cluster
+// dataLoader: Mandatory. the data loader that loads the edge cluster to find the project it belongs to
+// projectAuthorizationService: Mandatory. the service that authorizes the action on the target project
 // Returns the new instance or error if something goes wrong
 func NewDeleteEdgeCluster(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
 	logger *zap.Logger,
-	edgeClusterClientService edgecluster.EdgeClusterClientContract) (edgecluster.DeleteEdgeClusterContract, error) {
+	globalIDService globalid.GlobalIDContract,
+	edgeClusterClientService edgecluster.EdgeClusterClientContract,
+	dataLoader dataloader.DataLoaderContract,
+	projectAuthorizationService authorization.ProjectAuthorizationContract) (edgecluster.DeleteEdgeClusterContract, error) {
 
 	if ctx == nil {
 		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
@@ -48,14 +64,29 @@ func NewDeleteEdgeCluster(
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
 	if edgeClusterClientService == nil {
 		return nil, commonErrors.NewArgumentNilError("edgeClusterClientService", "edgeClusterClientService is required")
 	}
 
+	if dataLoader == nil {
+		return nil, commonErrors.NewArgumentNilError("dataLoader", "dataLoader is required")
+	}
+
+	if projectAuthorizationService == nil {
+		return nil, commonErrors.NewArgumentNilError("projectAuthorizationService", "projectAuthorizationService is required")
+	}
+
 	return &deleteEdgeCluster{
-		logger:                   logger,
-		resolverCreator:          resolverCreator,
-		edgeClusterClientService: edgeClusterClientService,
+		logger:                      logger,
+		globalIDService:             globalIDService,
+		resolverCreator:             resolverCreator,
+		edgeClusterClientService:    edgeClusterClientService,
+		dataLoader:                  dataLoader,
+		projectAuthorizationService: projectAuthorizationService,
 	}, nil
 }
 
@@ -65,6 +96,7 @@ func NewDeleteEdgeCluster(
 func NewDeleteEdgeClusterPayloadResolver(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
+	globalIDService globalid.GlobalIDContract,
 	edgeClusterID string,
 	clientMutationId *string) (edgecluster.DeleteEdgeClusterPayloadResolverContract, error) {
 	if ctx == nil {
@@ -75,8 +107,13 @@ func NewDeleteEdgeClusterPayloadResolver(
 		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
 	}
 
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
 	return &deleteEdgeClusterPayloadResolver{
 		resolverCreator:  resolverCreator,
+		globalIDService:  globalIDService,
 		edgeClusterID:    edgeClusterID,
 		clientMutationId: clientMutationId,
 	}, nil
@@ -89,15 +126,21 @@ func NewDeleteEdgeClusterPayloadResolver(
 func (m *deleteEdgeCluster) MutateAndGetPayload(
 	ctx context.Context,
 	args edgecluster.DeleteEdgeClusterInputArgument) (edgecluster.DeleteEdgeClusterPayloadResolverContract, error) {
-	edgeClusterID := string(args.Input.EdgeClusterID)
-	connection, edgeClusterServiceClient, err := m.edgeClusterClientService.CreateClient()
+	edgeClusterID, err := m.globalIDService.FromGlobalID(args.Input.EdgeClusterID, globalid.TypeEdgeCluster)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		_ = connection.Close()
-	}()
+	edgeClusterDetail, err := m.dataLoader.LoadEdgeCluster(ctx, edgeClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.projectAuthorizationService.AuthorizeProjectAction(ctx, edgeClusterDetail.EdgeCluster.ProjectID, policy.ActionDeleteEdgeCluster); err != nil {
+		return nil, err
+	}
+
+	edgeClusterServiceClient := m.edgeClusterClientService.GetClient()
 
 	response, err := edgeClusterServiceClient.DeleteEdgeCluster(
 		ctx,
@@ -105,11 +148,11 @@ func (m *deleteEdgeCluster) MutateAndGetPayload(
 			EdgeClusterID: edgeClusterID,
 		})
 	if err != nil {
-		return nil, err
+		return nil, errortranslation.FromError(err)
 	}
 
-	if response.Error != edgeclusterGrpcContract.Error_NO_ERROR {
-		return nil, errors.New(response.ErrorMessage)
+	if err := errortranslation.FromEdgeClusterError(response.Error, response.ErrorMessage); err != nil {
+		return nil, err
 	}
 
 	return m.resolverCreator.NewDeleteEdgeClusterPayloadResolver(
@@ -123,7 +166,7 @@ func (m *deleteEdgeCluster) MutateAndGetPayload(
 // ctx: Mandatory. Reference to the context
 // Returns the unique identifier of the the edge cluster that got deleted
 func (r *deleteEdgeClusterPayloadResolver) DeletedEdgeClusterID(ctx context.Context) graphql.ID {
-	return graphql.ID(r.edgeClusterID)
+	return r.globalIDService.ToGlobalID(globalid.TypeEdgeCluster, r.edgeClusterID)
 }
 
 // ClientMutationId returns the client mutation ID that was provided as part of the mutation request
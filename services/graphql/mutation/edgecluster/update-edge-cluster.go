@@ -3,21 +3,28 @@ package edgecluster
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	"github.com/decentralized-cloud/api-gateway/services/dataloader"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/policy"
 	edgeclusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
 )
 
 type updateEdgeCluster struct {
-	logger                   *zap.Logger
-	resolverCreator          types.ResolverCreatorContract
-	edgeClusterClientService edgecluster.EdgeClusterClientContract
+	logger                      *zap.Logger
+	globalIDService             globalid.GlobalIDContract
+	resolverCreator             types.ResolverCreatorContract
+	edgeClusterClientService    edgecluster.EdgeClusterClientContract
+	dataLoader                  dataloader.DataLoaderContract
+	projectAuthorizationService authorization.ProjectAuthorizationContract
 }
 
 type updateEdgeClusterPayloadResolver struct {
@@ -32,12 +39,19 @@ type updateEdgeClusterPayloadResolver struct {
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can update new instances of resolvers
 // logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
+// edgeClusterClientService: Mandatory. the edge cluster client service that creates gRPC connection and client to the edge cluster
+// dataLoader: Mandatory. the data loader that loads the edge cluster to find the project it belongs to
+// projectAuthorizationService: Mandatory. the service that authorizes the action on the target project
 // Returns the new instance or error if something goes wrong
 func NewUpdateEdgeCluster(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
 	logger *zap.Logger,
-	edgeClusterClientService edgecluster.EdgeClusterClientContract) (edgecluster.UpdateEdgeClusterContract, error) {
+	globalIDService globalid.GlobalIDContract,
+	edgeClusterClientService edgecluster.EdgeClusterClientContract,
+	dataLoader dataloader.DataLoaderContract,
+	projectAuthorizationService authorization.ProjectAuthorizationContract) (edgecluster.UpdateEdgeClusterContract, error) {
 	if ctx == nil {
 		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
 	}
@@ -50,14 +64,29 @@ func NewUpdateEdgeCluster(
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
 	if edgeClusterClientService == nil {
 		return nil, commonErrors.NewArgumentNilError("edgeClusterClientService", "edgeClusterClientService is required")
 	}
 
+	if dataLoader == nil {
+		return nil, commonErrors.NewArgumentNilError("dataLoader", "dataLoader is required")
+	}
+
+	if projectAuthorizationService == nil {
+		return nil, commonErrors.NewArgumentNilError("projectAuthorizationService", "projectAuthorizationService is required")
+	}
+
 	return &updateEdgeCluster{
-		logger:                   logger,
-		resolverCreator:          resolverCreator,
-		edgeClusterClientService: edgeClusterClientService,
+		logger:                      logger,
+		globalIDService:             globalIDService,
+		resolverCreator:             resolverCreator,
+		edgeClusterClientService:    edgeClusterClientService,
+		dataLoader:                  dataLoader,
+		projectAuthorizationService: projectAuthorizationService,
 	}, nil
 }
 
@@ -111,15 +140,33 @@ func NewUpdateEdgeClusterPayloadResolver(
 func (m *updateEdgeCluster) MutateAndGetPayload(
 	ctx context.Context,
 	args edgecluster.UpdateEdgeClusterInputArgument) (edgecluster.UpdateEdgeClusterPayloadResolverContract, error) {
-	edgeClusterID := string(args.Input.EdgeClusterID)
-	connection, edgeClusterServiceClient, err := m.edgeClusterClientService.CreateClient()
+	edgeClusterID, err := m.globalIDService.FromGlobalID(args.Input.EdgeClusterID, globalid.TypeEdgeCluster)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		_ = connection.Close()
-	}()
+	projectID, err := m.globalIDService.FromGlobalID(args.Input.ProjectID, globalid.TypeProject, globalid.TypeEdgeClusterProject)
+	if err != nil {
+		return nil, err
+	}
+
+	edgeClusterDetail, err := m.dataLoader.LoadEdgeCluster(ctx, edgeClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.projectAuthorizationService.AuthorizeProjectAction(ctx, edgeClusterDetail.EdgeCluster.ProjectID, policy.ActionUpdateEdgeCluster); err != nil {
+		return nil, err
+	}
+
+	// Moving the edge cluster to another project requires the same rights on the destination project
+	if projectID != edgeClusterDetail.EdgeCluster.ProjectID {
+		if err := m.projectAuthorizationService.AuthorizeProjectAction(ctx, projectID, policy.ActionUpdateEdgeCluster); err != nil {
+			return nil, err
+		}
+	}
+
+	edgeClusterServiceClient := m.edgeClusterClientService.GetClient()
 
 	var clusterType edgeclusterGrpcContract.ClusterType
 
@@ -134,17 +181,17 @@ func (m *updateEdgeCluster) MutateAndGetPayload(
 		&edgeclusterGrpcContract.UpdateEdgeClusterRequest{
 			EdgeClusterID: edgeClusterID,
 			EdgeCluster: &edgeclusterGrpcContract.EdgeCluster{
-				ProjectID:     string(args.Input.ProjectID),
+				ProjectID:     projectID,
 				Name:          args.Input.Name,
 				ClusterSecret: args.Input.ClusterSecret,
 				ClusterType:   clusterType,
 			}})
 	if err != nil {
-		return nil, err
+		return nil, errortranslation.FromError(err)
 	}
 
-	if response.Error != edgeclusterGrpcContract.Error_NO_ERROR {
-		return nil, errors.New(response.ErrorMessage)
+	if err := errortranslation.FromEdgeClusterError(response.Error, response.ErrorMessage); err != nil {
+		return nil, err
 	}
 
 	return m.resolverCreator.NewUpdateEdgeClusterPayloadResolver(
@@ -3,20 +3,23 @@ package project
 
 import (
 	"context"
-	"errors"
 	"strings"
 
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	"github.com/decentralized-cloud/api-gateway/services/policy"
 	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
 )
 
 type createProject struct {
-	logger               *zap.Logger
-	resolverCreator      types.ResolverCreatorContract
-	projectClientService project.ProjectClientContract
+	logger                      *zap.Logger
+	resolverCreator             types.ResolverCreatorContract
+	projectClientService        project.ProjectClientContract
+	projectAuthorizationService authorization.ProjectAuthorizationContract
 }
 
 type createProjectPayloadResolver struct {
@@ -32,12 +35,14 @@ type createProjectPayloadResolver struct {
 // resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
 // logger: Mandatory. Reference to the logger service
 // projectClientService: Mandatory. the project client service that creates gRPC connection and client to the project
+// projectAuthorizationService: Mandatory. the service that authorizes the action on the target project
 // Returns the new instance or error if something goes wrong
 func NewCreateProject(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
 	logger *zap.Logger,
-	projectClientService project.ProjectClientContract) (project.CreateProjectContract, error) {
+	projectClientService project.ProjectClientContract,
+	projectAuthorizationService authorization.ProjectAuthorizationContract) (project.CreateProjectContract, error) {
 	if ctx == nil {
 		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
 	}
@@ -54,10 +59,15 @@ func NewCreateProject(
 		return nil, commonErrors.NewArgumentNilError("projectClientService", "projectClientService is required")
 	}
 
+	if projectAuthorizationService == nil {
+		return nil, commonErrors.NewArgumentNilError("projectAuthorizationService", "projectAuthorizationService is required")
+	}
+
 	return &createProject{
-		logger:               logger,
-		resolverCreator:      resolverCreator,
-		projectClientService: projectClientService,
+		logger:                      logger,
+		resolverCreator:             resolverCreator,
+		projectClientService:        projectClientService,
+		projectAuthorizationService: projectAuthorizationService,
 	}, nil
 }
 
@@ -112,14 +122,11 @@ func NewCreateProjectPayloadResolver(
 func (m *createProject) MutateAndGetPayload(
 	ctx context.Context,
 	args project.CreateProjectInputArgument) (project.CreateProjectPayloadResolverContract, error) {
-	connection, projectServiceClient, err := m.projectClientService.CreateClient()
-	if err != nil {
+	if err := m.projectAuthorizationService.AuthorizeProjectAction(ctx, "", policy.ActionCreateProject); err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		_ = connection.Close()
-	}()
+	projectServiceClient := m.projectClientService.GetClient()
 
 	response, err := projectServiceClient.CreateProject(
 		ctx,
@@ -128,11 +135,20 @@ func (m *createProject) MutateAndGetPayload(
 				Name: args.Input.Name,
 			}})
 	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	if err := errortranslation.FromProjectError(response.Error, response.ErrorMessage); err != nil {
 		return nil, err
 	}
 
-	if response.Error != projectGrpcContract.Error_NO_ERROR {
-		return nil, errors.New(response.ErrorMessage)
+	if err := m.projectAuthorizationService.GrantProjectOwnership(ctx, response.ProjectID); err != nil {
+		m.logger.Error("Failed to grant the ownership of the created project", zap.Error(err), zap.String("projectID", response.ProjectID))
+
+		// Nobody holds a role on the created project, so it is deleted rather than left behind where even its creator can not manage it
+		m.deleteCreatedProject(ctx, response.ProjectID)
+
+		return nil, errortranslation.FromError(err)
 	}
 
 	return m.resolverCreator.NewCreateProjectPayloadResolver(
@@ -145,6 +161,23 @@ func (m *createProject) MutateAndGetPayload(
 		response.Cursor)
 }
 
+// deleteCreatedProject deletes the created project the ownership could not be granted on. The failures are logged as
+// the mutation already fails with the error the ownership could not be granted with.
+func (m *createProject) deleteCreatedProject(ctx context.Context, projectID string) {
+	response, err := m.projectClientService.GetClient().DeleteProject(
+		ctx,
+		&projectGrpcContract.DeleteProjectRequest{
+			ProjectID: projectID,
+		})
+	if err == nil {
+		err = errortranslation.FromProjectError(response.Error, response.ErrorMessage)
+	}
+
+	if err != nil {
+		m.logger.Error("Failed to delete the created project the ownership could not be granted on", zap.Error(err), zap.String("projectID", projectID))
+	}
+}
+
 // Project returns the new project inforamtion
 // ctx: Mandatory. Reference to the context
 // Returns the new project inforamtion
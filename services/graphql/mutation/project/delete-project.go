@@ -3,10 +3,13 @@ package project
 
 import (
 	"context"
-	"errors"
 
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	"github.com/decentralized-cloud/api-gateway/services/policy"
 	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
 	"github.com/graph-gophers/graphql-go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
@@ -14,13 +17,16 @@ import (
 )
 
 type deleteProject struct {
-	logger               *zap.Logger
-	resolverCreator      types.ResolverCreatorContract
-	projectClientService project.ProjectClientContract
+	logger                      *zap.Logger
+	globalIDService             globalid.GlobalIDContract
+	resolverCreator             types.ResolverCreatorContract
+	projectClientService        project.ProjectClientContract
+	projectAuthorizationService authorization.ProjectAuthorizationContract
 }
 
 type deleteProjectPayloadResolver struct {
 	resolverCreator  types.ResolverCreatorContract
+	globalIDService  globalid.GlobalIDContract
 	projectID        string
 	clientMutationId *string
 }
@@ -29,13 +35,17 @@ type deleteProjectPayloadResolver struct {
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can delete new instances of resolvers
 // logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
 // projectClientService: Mandatory. the project client service that creates gRPC connection and client to the project
+// projectAuthorizationService: Mandatory. the service that authorizes the action on the target project
 // Returns the new instance or error if something goes wrong
 func NewDeleteProject(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
 	logger *zap.Logger,
-	projectClientService project.ProjectClientContract) (project.DeleteProjectContract, error) {
+	globalIDService globalid.GlobalIDContract,
+	projectClientService project.ProjectClientContract,
+	projectAuthorizationService authorization.ProjectAuthorizationContract) (project.DeleteProjectContract, error) {
 	if ctx == nil {
 		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
 	}
@@ -48,26 +58,38 @@ func NewDeleteProject(
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
 	if projectClientService == nil {
 		return nil, commonErrors.NewArgumentNilError("projectClientService", "projectClientService is required")
 	}
 
+	if projectAuthorizationService == nil {
+		return nil, commonErrors.NewArgumentNilError("projectAuthorizationService", "projectAuthorizationService is required")
+	}
+
 	return &deleteProject{
-		logger:               logger,
-		resolverCreator:      resolverCreator,
-		projectClientService: projectClientService,
+		logger:                      logger,
+		globalIDService:             globalIDService,
+		resolverCreator:             resolverCreator,
+		projectClientService:        projectClientService,
+		projectAuthorizationService: projectAuthorizationService,
 	}, nil
 }
 
 // NewDeleteProjectPayloadResolver updates new instance of the deleteProjectPayloadResolver, setting up all dependencies and returns the instance
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can update new instances of resolvers
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
 // projectID: Mandatory. The project unique identifier
 // clientMutationId: Optional. Reference to the client mutation ID
 // Returns the new instance or error if something goes wrong
 func NewDeleteProjectPayloadResolver(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
+	globalIDService globalid.GlobalIDContract,
 	projectID string,
 	clientMutationId *string) (project.DeleteProjectPayloadResolverContract, error) {
 	if ctx == nil {
@@ -78,8 +100,13 @@ func NewDeleteProjectPayloadResolver(
 		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
 	}
 
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
 	return &deleteProjectPayloadResolver{
 		resolverCreator:  resolverCreator,
+		globalIDService:  globalIDService,
 		projectID:        projectID,
 		clientMutationId: clientMutationId,
 	}, nil
@@ -92,15 +119,16 @@ func NewDeleteProjectPayloadResolver(
 func (m *deleteProject) MutateAndGetPayload(
 	ctx context.Context,
 	args project.DeleteProjectInputArgument) (project.DeleteProjectPayloadResolverContract, error) {
-	projectID := string(args.Input.ProjectID)
-	connection, projectServiceClient, err := m.projectClientService.CreateClient()
+	projectID, err := m.globalIDService.FromGlobalID(args.Input.ProjectID, globalid.TypeProject, globalid.TypeEdgeClusterProject)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		_ = connection.Close()
-	}()
+	if err := m.projectAuthorizationService.AuthorizeProjectAction(ctx, projectID, policy.ActionDeleteProject); err != nil {
+		return nil, err
+	}
+
+	projectServiceClient := m.projectClientService.GetClient()
 
 	response, err := projectServiceClient.DeleteProject(
 		ctx,
@@ -108,11 +136,16 @@ func (m *deleteProject) MutateAndGetPayload(
 			ProjectID: projectID,
 		})
 	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	if err := errortranslation.FromProjectError(response.Error, response.ErrorMessage); err != nil {
 		return nil, err
 	}
 
-	if response.Error != projectGrpcContract.Error_NO_ERROR {
-		return nil, errors.New(response.ErrorMessage)
+	// The project is already deleted, the roles left behind only grant access to a project that no longer exists
+	if err := m.projectAuthorizationService.RevokeProjectRoles(ctx, projectID); err != nil {
+		m.logger.Warn("Failed to revoke the roles granted on the deleted project", zap.Error(err), zap.String("projectID", projectID))
 	}
 
 	return m.resolverCreator.NewDeleteProjectPayloadResolver(
@@ -126,7 +159,7 @@ func (m *deleteProject) MutateAndGetPayload(
 // ctx: Mandatory. Reference to the context
 // Returns the unique identifier of the the project that got deleted
 func (r *deleteProjectPayloadResolver) DeletedProjectID(ctx context.Context) graphql.ID {
-	return graphql.ID(r.projectID)
+	return r.globalIDService.ToGlobalID(globalid.TypeProject, r.projectID)
 }
 
 // ClientMutationId returns the client mutation ID that was provided as part of the mutation request
@@ -1 +0,0 @@
-package project_test
@@ -0,0 +1,215 @@
+package project_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	mock_authorization "github.com/decentralized-cloud/api-gateway/services/authorization/mock"
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/mutation/project"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	projectTypes "github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/decentralized-cloud/api-gateway/services/policy"
+	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeProjectServiceClient implements the project service calls made by the project mutations
+type fakeProjectServiceClient struct {
+	projectGrpcContract.ServiceClient
+	updatedProjectIDs []string
+	deletedProjectIDs []string
+	deleteFailure     error
+}
+
+func (client *fakeProjectServiceClient) CreateProject(
+	ctx context.Context,
+	request *projectGrpcContract.CreateProjectRequest,
+	opts ...grpc.CallOption) (*projectGrpcContract.CreateProjectResponse, error) {
+	return &projectGrpcContract.CreateProjectResponse{
+		ProjectID: "p-new",
+		Project:   request.Project,
+		Cursor:    "c-new",
+	}, nil
+}
+
+func (client *fakeProjectServiceClient) UpdateProject(
+	ctx context.Context,
+	request *projectGrpcContract.UpdateProjectRequest,
+	opts ...grpc.CallOption) (*projectGrpcContract.UpdateProjectResponse, error) {
+	client.updatedProjectIDs = append(client.updatedProjectIDs, request.ProjectID)
+
+	return &projectGrpcContract.UpdateProjectResponse{
+		Project: request.Project,
+		Cursor:  "c-new",
+	}, nil
+}
+
+func (client *fakeProjectServiceClient) DeleteProject(
+	ctx context.Context,
+	request *projectGrpcContract.DeleteProjectRequest,
+	opts ...grpc.CallOption) (*projectGrpcContract.DeleteProjectResponse, error) {
+	client.deletedProjectIDs = append(client.deletedProjectIDs, request.ProjectID)
+
+	if client.deleteFailure != nil {
+		return nil, client.deleteFailure
+	}
+
+	return &projectGrpcContract.DeleteProjectResponse{}, nil
+}
+
+type fakeProjectClientService struct {
+	projectTypes.ProjectClientContract
+	client *fakeProjectServiceClient
+}
+
+func (service *fakeProjectClientService) GetClient() projectGrpcContract.ServiceClient {
+	return service.client
+}
+
+// fakeResolverCreator creates the payload resolvers returned from the project mutations
+type fakeResolverCreator struct {
+	types.ResolverCreatorContract
+}
+
+func (creator *fakeResolverCreator) NewCreateProjectPayloadResolver(
+	ctx context.Context,
+	clientMutationId *string,
+	projectID string,
+	projectDetail *projectTypes.ProjectDetail,
+	cursor string) (projectTypes.CreateProjectPayloadResolverContract, error) {
+	return nil, nil
+}
+
+func (creator *fakeResolverCreator) NewUpdateProjectPayloadResolver(
+	ctx context.Context,
+	clientMutationId *string,
+	projectID string,
+	projectDetail *projectTypes.ProjectDetail,
+	cursor string) (projectTypes.UpdateProjectPayloadResolverContract, error) {
+	return nil, nil
+}
+
+func TestProjectMutations_CreatorCanUpdateTheCreatedProject(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetProjectRoleBindingsFile().Return("", nil).AnyTimes()
+	configurationService.EXPECT().GetGraphQLRawIDs().Return(false, nil).AnyTimes()
+
+	logger := zap.NewNop()
+
+	roleBindingStore, err := policy.NewFileRoleBindingStore(logger, configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policyEngine, err := policy.NewRoleBindingPolicyEngine(roleBindingStore)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	projectAuthorizationService, err := authorization.NewPolicyProjectAuthorizationService(logger, policyEngine)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	globalIDService, err := globalid.NewBase64GlobalIDService(configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	projectServiceClient := &fakeProjectServiceClient{}
+	projectClientService := &fakeProjectClientService{client: projectServiceClient}
+	resolverCreator := &fakeResolverCreator{}
+
+	creatorCtx := identity.NewContext(context.Background(), &identity.Principal{Subject: "creator"})
+	strangerCtx := identity.NewContext(context.Background(), &identity.Principal{Subject: "stranger"})
+
+	createProject, err := project.NewCreateProject(creatorCtx, resolverCreator, logger, projectClientService, projectAuthorizationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := createProject.MutateAndGetPayload(
+		creatorCtx,
+		projectTypes.CreateProjectInputArgument{Input: projectTypes.CreateProjectInput{Name: "new project"}}); err != nil {
+		t.Fatalf("failed to create the project: %v", err)
+	}
+
+	updateProject, err := project.NewUpdateProject(creatorCtx, resolverCreator, logger, globalIDService, projectClientService, projectAuthorizationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updateInput := projectTypes.UpdateProjectInputArgument{
+		Input: projectTypes.UpdateProjectInput{
+			ProjectID: globalIDService.ToGlobalID(globalid.TypeProject, "p-new"),
+			Name:      "renamed project",
+		},
+	}
+
+	if _, err := updateProject.MutateAndGetPayload(creatorCtx, updateInput); err != nil {
+		t.Fatalf("expected the creator to update the created project: %v", err)
+	}
+
+	_, err = updateProject.MutateAndGetPayload(strangerCtx, updateInput)
+
+	var extendedErr interface{ Extensions() map[string]interface{} }
+	if !errors.As(err, &extendedErr) || extendedErr.Extensions()["code"] != authorization.ErrorCodeForbidden {
+		t.Fatalf("expected a non-member to be forbidden from updating the project, got %v", err)
+	}
+
+	if len(projectServiceClient.updatedProjectIDs) != 1 || projectServiceClient.updatedProjectIDs[0] != "p-new" {
+		t.Fatalf("expected only the creator update to reach the project service, got %v", projectServiceClient.updatedProjectIDs)
+	}
+}
+
+func TestProjectMutations_CreatedProjectIsDeletedWhenTheOwnershipCanNotBeGranted(t *testing.T) {
+	for name, deleteFailure := range map[string]error{
+		"project deleted":        nil,
+		"project delete failure": status.Error(codes.Unavailable, "project service is not available"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+
+			grantFailure := errors.New("role binding store is not available")
+
+			projectAuthorizationService := mock_authorization.NewMockProjectAuthorizationContract(mockCtrl)
+			projectAuthorizationService.EXPECT().AuthorizeProjectAction(gomock.Any(), "", policy.ActionCreateProject).Return(nil)
+			projectAuthorizationService.EXPECT().GrantProjectOwnership(gomock.Any(), "p-new").Return(grantFailure)
+
+			projectServiceClient := &fakeProjectServiceClient{deleteFailure: deleteFailure}
+			ctx := identity.NewContext(context.Background(), &identity.Principal{Subject: "creator"})
+
+			createProject, err := project.NewCreateProject(
+				ctx,
+				&fakeResolverCreator{},
+				zap.NewNop(),
+				&fakeProjectClientService{client: projectServiceClient},
+				projectAuthorizationService)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = createProject.MutateAndGetPayload(
+				ctx,
+				projectTypes.CreateProjectInputArgument{Input: projectTypes.CreateProjectInput{Name: "new project"}})
+			if !errors.Is(err, grantFailure) {
+				t.Fatalf("expected the mutation to fail with the error the ownership could not be granted with, got %v", err)
+			}
+
+			if len(projectServiceClient.deletedProjectIDs) != 1 || projectServiceClient.deletedProjectIDs[0] != "p-new" {
+				t.Fatalf("expected the created project to be deleted, got %v", projectServiceClient.deletedProjectIDs)
+			}
+		})
+	}
+}
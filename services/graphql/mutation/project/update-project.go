@@ -3,20 +3,25 @@ package project
 
 import (
 	"context"
-	"errors"
 	"strings"
 
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	"github.com/decentralized-cloud/api-gateway/services/policy"
 	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
 )
 
 type updateProject struct {
-	logger               *zap.Logger
-	resolverCreator      types.ResolverCreatorContract
-	projectClientService project.ProjectClientContract
+	logger                      *zap.Logger
+	globalIDService             globalid.GlobalIDContract
+	resolverCreator             types.ResolverCreatorContract
+	projectClientService        project.ProjectClientContract
+	projectAuthorizationService authorization.ProjectAuthorizationContract
 }
 
 type updateProjectPayloadResolver struct {
@@ -31,13 +36,17 @@ type updateProjectPayloadResolver struct {
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can update new instances of resolvers
 // logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
 // projectClientService: Mandatory. the project client service that creates gRPC connection and client to the project
+// projectAuthorizationService: Mandatory. the service that authorizes the action on the target project
 // Returns the new instance or error if something goes wrong
 func NewUpdateProject(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
 	logger *zap.Logger,
-	projectClientService project.ProjectClientContract) (project.UpdateProjectContract, error) {
+	globalIDService globalid.GlobalIDContract,
+	projectClientService project.ProjectClientContract,
+	projectAuthorizationService authorization.ProjectAuthorizationContract) (project.UpdateProjectContract, error) {
 	if ctx == nil {
 		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
 	}
@@ -50,14 +59,24 @@ func NewUpdateProject(
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
 	if projectClientService == nil {
 		return nil, commonErrors.NewArgumentNilError("projectClientService", "projectClientService is required")
 	}
 
+	if projectAuthorizationService == nil {
+		return nil, commonErrors.NewArgumentNilError("projectAuthorizationService", "projectAuthorizationService is required")
+	}
+
 	return &updateProject{
-		logger:               logger,
-		resolverCreator:      resolverCreator,
-		projectClientService: projectClientService,
+		logger:                      logger,
+		globalIDService:             globalIDService,
+		resolverCreator:             resolverCreator,
+		projectClientService:        projectClientService,
+		projectAuthorizationService: projectAuthorizationService,
 	}, nil
 }
 
@@ -112,15 +131,16 @@ func NewUpdateProjectPayloadResolver(
 func (m *updateProject) MutateAndGetPayload(
 	ctx context.Context,
 	args project.UpdateProjectInputArgument) (project.UpdateProjectPayloadResolverContract, error) {
-	projectID := string(args.Input.ProjectID)
-	connection, projectServiceClient, err := m.projectClientService.CreateClient()
+	projectID, err := m.globalIDService.FromGlobalID(args.Input.ProjectID, globalid.TypeProject, globalid.TypeEdgeClusterProject)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		_ = connection.Close()
-	}()
+	if err := m.projectAuthorizationService.AuthorizeProjectAction(ctx, projectID, policy.ActionUpdateProject); err != nil {
+		return nil, err
+	}
+
+	projectServiceClient := m.projectClientService.GetClient()
 
 	response, err := projectServiceClient.UpdateProject(
 		ctx,
@@ -131,11 +151,11 @@ func (m *updateProject) MutateAndGetPayload(
 			}})
 
 	if err != nil {
-		return nil, err
+		return nil, errortranslation.FromError(err)
 	}
 
-	if response.Error != projectGrpcContract.Error_NO_ERROR {
-		return nil, errors.New(response.ErrorMessage)
+	if err := errortranslation.FromProjectError(response.Error, response.ErrorMessage); err != nil {
+		return nil, err
 	}
 
 	return m.resolverCreator.NewUpdateProjectPayloadResolver(
@@ -2,6 +2,8 @@
 package graphql
 
 import (
+	"context"
+
 	"github.com/decentralized-cloud/api-gateway/services/configuration"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
 	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
@@ -10,7 +12,8 @@ import (
 )
 
 type projectClientService struct {
-	serviceAddress string
+	connection *grpc.ClientConn
+	client     projectGrpcContract.ServiceClient
 }
 
 // NewProjectClientService creates new instance of the projectClientService, setting up all dependencies and returns the instance
@@ -27,19 +30,33 @@ func NewProjectClientService(
 		return nil, err
 	}
 
+	connection, err := newClientConnection(configurationService, "project", serviceAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	return &projectClientService{
-		serviceAddress: serviceAddress,
+		connection: connection,
+		client:     projectGrpcContract.NewServiceClient(connection),
 	}, nil
 }
 
-// CreateClient creats a new project gRPC client and returns the connection
-// and the client to the caller.
-// Returns connection and the project gRPC client or error if something goes wrong.
-func (service *projectClientService) CreateClient() (*grpc.ClientConn, projectGrpcContract.ServiceClient, error) {
-	connection, err := grpc.Dial(service.serviceAddress, grpc.WithInsecure())
-	if err != nil {
-		return nil, nil, err
-	}
+// GetClient returns the project gRPC client that uses the long-lived connection shared
+// between all callers.
+// Returns the project gRPC client.
+func (service *projectClientService) GetClient() projectGrpcContract.ServiceClient {
+	return service.client
+}
+
+// CheckHealth queries the standard gRPC health service of the project service.
+// ctx: Mandatory. Reference to the context
+// Returns error if the project service is not serving or something goes wrong.
+func (service *projectClientService) CheckHealth(ctx context.Context) error {
+	return checkConnectionHealth(ctx, service.connection)
+}
 
-	return connection, projectGrpcContract.NewServiceClient(connection), nil
+// Close closes the shared connection to the project service.
+// Returns error if something goes wrong.
+func (service *projectClientService) Close() error {
+	return service.connection.Close()
 }
@@ -0,0 +1,59 @@
+// Package edgecluster implements different edge cluster GraphQL query resovlers required by the GraphQL transport layer
+package edgecluster
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type edgeClusterConfigMapResolver struct {
+	logger          *zap.Logger
+	resolverCreator types.ResolverCreatorContract
+	configMap       *metav1.PartialObjectMetadata
+}
+
+// NewEdgeClusterConfigMapResolver creates new instance of the edgeClusterConfigMapResolver, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// logger: Mandatory. Reference to the logger service
+// resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
+// configMap: Mandatory. Contains the metadata of the edge cluster config map.
+// Returns the new instance or error if something goes wrong
+func NewEdgeClusterConfigMapResolver(
+	ctx context.Context,
+	logger *zap.Logger,
+	resolverCreator types.ResolverCreatorContract,
+	configMap *metav1.PartialObjectMetadata) (edgecluster.ConfigMapResolverContract, error) {
+	if ctx == nil {
+		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
+	}
+
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if resolverCreator == nil {
+		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
+	}
+
+	if configMap == nil {
+		return nil, commonErrors.NewArgumentNilError("configMap", "configMap is required")
+	}
+
+	return &edgeClusterConfigMapResolver{
+		logger:          logger,
+		resolverCreator: resolverCreator,
+		configMap:       configMap,
+	}, nil
+}
+
+// Metadata contains the config map metadata
+// ctx: Mandatory. Reference to the context
+// Returns the config map metadata resolver or error if something goes wrong.
+func (r *edgeClusterConfigMapResolver) Metadata(ctx context.Context) (edgecluster.ObjectMetaResolverContract, error) {
+	return r.resolverCreator.NewObjectMetaResolver(ctx, fromKubernetesObjectMeta(r.configMap.ObjectMeta))
+}
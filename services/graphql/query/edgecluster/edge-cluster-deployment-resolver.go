@@ -0,0 +1,92 @@
+// Package edgecluster implements different edge cluster GraphQL query resovlers required by the GraphQL transport layer
+package edgecluster
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+type edgeClusterDeploymentResolver struct {
+	logger          *zap.Logger
+	resolverCreator types.ResolverCreatorContract
+	deployment      *appsv1.Deployment
+}
+
+// NewEdgeClusterDeploymentResolver creates new instance of the edgeClusterDeploymentResolver, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// logger: Mandatory. Reference to the logger service
+// resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
+// deployment: Mandatory. Contains information about the edge cluster deployment.
+// Returns the new instance or error if something goes wrong
+func NewEdgeClusterDeploymentResolver(
+	ctx context.Context,
+	logger *zap.Logger,
+	resolverCreator types.ResolverCreatorContract,
+	deployment *appsv1.Deployment) (edgecluster.DeploymentResolverContract, error) {
+	if ctx == nil {
+		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
+	}
+
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if resolverCreator == nil {
+		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
+	}
+
+	if deployment == nil {
+		return nil, commonErrors.NewArgumentNilError("deployment", "deployment is required")
+	}
+
+	return &edgeClusterDeploymentResolver{
+		logger:          logger,
+		resolverCreator: resolverCreator,
+		deployment:      deployment,
+	}, nil
+}
+
+// Metadata contains the deployment metadata
+// ctx: Mandatory. Reference to the context
+// Returns the deployment metadata resolver or error if something goes wrong.
+func (r *edgeClusterDeploymentResolver) Metadata(ctx context.Context) (edgecluster.ObjectMetaResolverContract, error) {
+	return r.resolverCreator.NewObjectMetaResolver(ctx, fromKubernetesObjectMeta(r.deployment.ObjectMeta))
+}
+
+// Replicas returns the number of desired pods
+// ctx: Mandatory. Reference to the context
+// Returns the number of desired pods
+func (r *edgeClusterDeploymentResolver) Replicas(ctx context.Context) int32 {
+	// Kubernetes defaults the number of desired pods to one when it is not specified
+	if r.deployment.Spec.Replicas == nil {
+		return 1
+	}
+
+	return *r.deployment.Spec.Replicas
+}
+
+// ReadyReplicas returns the number of pods targeted by the deployment with a ready condition
+// ctx: Mandatory. Reference to the context
+// Returns the number of pods targeted by the deployment with a ready condition
+func (r *edgeClusterDeploymentResolver) ReadyReplicas(ctx context.Context) int32 {
+	return r.deployment.Status.ReadyReplicas
+}
+
+// AvailableReplicas returns the number of pods targeted by the deployment that are available
+// ctx: Mandatory. Reference to the context
+// Returns the number of pods targeted by the deployment that are available
+func (r *edgeClusterDeploymentResolver) AvailableReplicas(ctx context.Context) int32 {
+	return r.deployment.Status.AvailableReplicas
+}
+
+// UpdatedReplicas returns the number of pods targeted by the deployment that have the desired template spec
+// ctx: Mandatory. Reference to the context
+// Returns the number of pods targeted by the deployment that have the desired template spec
+func (r *edgeClusterDeploymentResolver) UpdatedReplicas(ctx context.Context) int32 {
+	return r.deployment.Status.UpdatedReplicas
+}
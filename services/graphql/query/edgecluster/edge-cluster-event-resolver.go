@@ -0,0 +1,116 @@
+// Package edgecluster implements different edge cluster GraphQL query resovlers required by the GraphQL transport layer
+package edgecluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type edgeClusterEventResolver struct {
+	logger          *zap.Logger
+	resolverCreator types.ResolverCreatorContract
+	event           *corev1.Event
+}
+
+// NewEdgeClusterEventResolver creates new instance of the edgeClusterEventResolver, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// logger: Mandatory. Reference to the logger service
+// resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
+// event: Mandatory. Contains information about the edge cluster event.
+// Returns the new instance or error if something goes wrong
+func NewEdgeClusterEventResolver(
+	ctx context.Context,
+	logger *zap.Logger,
+	resolverCreator types.ResolverCreatorContract,
+	event *corev1.Event) (edgecluster.EventResolverContract, error) {
+	if ctx == nil {
+		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
+	}
+
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if resolverCreator == nil {
+		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
+	}
+
+	if event == nil {
+		return nil, commonErrors.NewArgumentNilError("event", "event is required")
+	}
+
+	return &edgeClusterEventResolver{
+		logger:          logger,
+		resolverCreator: resolverCreator,
+		event:           event,
+	}, nil
+}
+
+// Metadata contains the event metadata
+// ctx: Mandatory. Reference to the context
+// Returns the event metadata resolver or error if something goes wrong.
+func (r *edgeClusterEventResolver) Metadata(ctx context.Context) (edgecluster.ObjectMetaResolverContract, error) {
+	return r.resolverCreator.NewObjectMetaResolver(ctx, fromKubernetesObjectMeta(r.event.ObjectMeta))
+}
+
+// Type returns the type of the event
+// ctx: Mandatory. Reference to the context
+// Returns the type of the event
+func (r *edgeClusterEventResolver) Type(ctx context.Context) string {
+	return r.event.Type
+}
+
+// Reason returns the unique, one-word, CamelCase reason for the event
+// ctx: Mandatory. Reference to the context
+// Returns the unique, one-word, CamelCase reason for the event
+func (r *edgeClusterEventResolver) Reason(ctx context.Context) string {
+	return r.event.Reason
+}
+
+// Message returns the human-readable description of the event
+// ctx: Mandatory. Reference to the context
+// Returns the human-readable description of the event
+func (r *edgeClusterEventResolver) Message(ctx context.Context) string {
+	return r.event.Message
+}
+
+// InvolvedObject returns the object the event is about
+// ctx: Mandatory. Reference to the context
+// Returns the object reference resolver or error if something goes wrong.
+func (r *edgeClusterEventResolver) InvolvedObject(ctx context.Context) (edgecluster.ObjectReferenceResolverContract, error) {
+	return r.resolverCreator.NewObjectReferenceResolver(ctx, &r.event.InvolvedObject)
+}
+
+// Count returns the number of times the event has occurred
+// ctx: Mandatory. Reference to the context
+// Returns the number of times the event has occurred
+func (r *edgeClusterEventResolver) Count(ctx context.Context) int32 {
+	return r.event.Count
+}
+
+// LastTimestamp returns the time the most recent occurrence of the event was recorded
+// ctx: Mandatory. Reference to the context
+// Returns the time the most recent occurrence of the event was recorded or nil if the time is not recorded
+func (r *edgeClusterEventResolver) LastTimestamp(ctx context.Context) *string {
+	// The events reported through the events.k8s.io API only record the event time
+	var timestamp time.Time
+
+	switch {
+	case !r.event.LastTimestamp.IsZero():
+		timestamp = r.event.LastTimestamp.Time
+	case !r.event.EventTime.IsZero():
+		timestamp = r.event.EventTime.Time
+	default:
+		return nil
+	}
+
+	formatted := timestamp.Format(time.RFC3339)
+
+	return &formatted
+}
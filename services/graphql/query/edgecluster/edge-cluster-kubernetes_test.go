@@ -0,0 +1,414 @@
+package edgecluster_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	mock_dataloader "github.com/decentralized-cloud/api-gateway/services/dataloader/mock"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
+	queryedgecluster "github.com/decentralized-cloud/api-gateway/services/graphql/query/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/kubeclient"
+	"github.com/decentralized-cloud/api-gateway/services/warning"
+	edgeclusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const requestTimeout = 200 * time.Millisecond
+
+// fakeResolverCreator creates the resolvers of the edge cluster Kubernetes objects
+type fakeResolverCreator struct {
+	types.ResolverCreatorContract
+	logger *zap.Logger
+}
+
+func (creator *fakeResolverCreator) NewObjectMetaResolver(
+	ctx context.Context,
+	metadata *edgeclusterGrpcContract.ObjectMeta) (edgecluster.ObjectMetaResolverContract, error) {
+	return queryedgecluster.NewObjectMetaResolver(ctx, creator.logger, metadata)
+}
+
+func (creator *fakeResolverCreator) NewObjectReferenceResolver(
+	ctx context.Context,
+	objectReference *corev1.ObjectReference) (edgecluster.ObjectReferenceResolverContract, error) {
+	return queryedgecluster.NewObjectReferenceResolver(ctx, creator.logger, objectReference)
+}
+
+func (creator *fakeResolverCreator) NewEdgeClusterNamespaceResolver(
+	ctx context.Context,
+	namespace *corev1.Namespace) (edgecluster.NamespaceResolverContract, error) {
+	return queryedgecluster.NewEdgeClusterNamespaceResolver(ctx, creator.logger, creator, namespace)
+}
+
+func (creator *fakeResolverCreator) NewEdgeClusterDeploymentResolver(
+	ctx context.Context,
+	deployment *appsv1.Deployment) (edgecluster.DeploymentResolverContract, error) {
+	return queryedgecluster.NewEdgeClusterDeploymentResolver(ctx, creator.logger, creator, deployment)
+}
+
+func (creator *fakeResolverCreator) NewEdgeClusterEventResolver(
+	ctx context.Context,
+	event *corev1.Event) (edgecluster.EventResolverContract, error) {
+	return queryedgecluster.NewEdgeClusterEventResolver(ctx, creator.logger, creator, event)
+}
+
+func (creator *fakeResolverCreator) NewEdgeClusterConfigMapResolver(
+	ctx context.Context,
+	configMap *metav1.PartialObjectMetadata) (edgecluster.ConfigMapResolverContract, error) {
+	return queryedgecluster.NewEdgeClusterConfigMapResolver(ctx, creator.logger, creator, configMap)
+}
+
+type fakeEdgeClusterClientService struct {
+	edgecluster.EdgeClusterClientContract
+}
+
+// serveKubernetesAPI serves the edge cluster Kubernetes API. The missing, forbidden and slow namespaces respond with
+// 404, 403 and no response at all respectively. The namespaces are listed page by page, the continue token is the
+// offset of the next page.
+func serveKubernetesAPI(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+
+		writeStatus := func(code int, reason metav1.StatusReason) {
+			writer.WriteHeader(code)
+			_ = json.NewEncoder(writer).Encode(metav1.Status{
+				TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+				Status:   metav1.StatusFailure,
+				Reason:   reason,
+				Code:     int32(code),
+				Message:  string(reason),
+			})
+		}
+
+		switch {
+		case strings.HasPrefix(request.URL.Path, "/api/v1/namespaces/missing/"),
+			strings.HasPrefix(request.URL.Path, "/apis/apps/v1/namespaces/missing/"):
+			writeStatus(http.StatusNotFound, metav1.StatusReasonNotFound)
+
+		case strings.HasPrefix(request.URL.Path, "/api/v1/namespaces/forbidden/"),
+			strings.HasPrefix(request.URL.Path, "/apis/apps/v1/namespaces/forbidden/"):
+			writeStatus(http.StatusForbidden, metav1.StatusReasonForbidden)
+
+		case strings.HasPrefix(request.URL.Path, "/api/v1/namespaces/slow/"),
+			strings.HasPrefix(request.URL.Path, "/apis/apps/v1/namespaces/slow/"):
+			<-request.Context().Done()
+
+		case request.URL.Path == "/api/v1/namespaces":
+			namespaces := []corev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "n1"}, Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "old", UID: "n2"}, Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating}},
+			}
+
+			offset, _ := strconv.Atoi(request.URL.Query().Get("continue"))
+			end := len(namespaces)
+			if limit, _ := strconv.Atoi(request.URL.Query().Get("limit")); limit > 0 && offset+limit < end {
+				end = offset + limit
+			}
+
+			continueToken := ""
+			if end < len(namespaces) {
+				continueToken = strconv.Itoa(end)
+			}
+
+			_ = json.NewEncoder(writer).Encode(corev1.NamespaceList{
+				TypeMeta: metav1.TypeMeta{Kind: "NamespaceList", APIVersion: "v1"},
+				ListMeta: metav1.ListMeta{Continue: continueToken},
+				Items:    namespaces[offset:end],
+			})
+
+		case request.URL.Path == "/apis/apps/v1/namespaces/default/deployments":
+			replicas := int32(3)
+			_ = json.NewEncoder(writer).Encode(appsv1.DeploymentList{
+				TypeMeta: metav1.TypeMeta{Kind: "DeploymentList", APIVersion: "apps/v1"},
+				Items: []appsv1.Deployment{{
+					ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "d1"},
+					Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+					Status:     appsv1.DeploymentStatus{ReadyReplicas: 2, AvailableReplicas: 2, UpdatedReplicas: 3},
+				}},
+			})
+
+		case request.URL.Path == "/api/v1/events":
+			_ = json.NewEncoder(writer).Encode(corev1.EventList{
+				TypeMeta: metav1.TypeMeta{Kind: "EventList", APIVersion: "v1"},
+				Items: []corev1.Event{{
+					ObjectMeta:     metav1.ObjectMeta{Name: "web.1", Namespace: "default", UID: "ev1"},
+					Type:           corev1.EventTypeWarning,
+					Reason:         "BackOff",
+					Message:        "Back-off restarting failed container",
+					InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-1", Namespace: "default"},
+					Count:          4,
+				}},
+			})
+
+		case request.URL.Path == "/api/v1/configmaps":
+			if !strings.Contains(request.Header.Get("Accept"), "as=PartialObjectMetadataList") {
+				t.Errorf("expected only the config map metadata to be requested, got the Accept header %q", request.Header.Get("Accept"))
+			}
+
+			_ = json.NewEncoder(writer).Encode(metav1.PartialObjectMetadataList{
+				TypeMeta: metav1.TypeMeta{Kind: "PartialObjectMetadataList", APIVersion: "meta.k8s.io/v1"},
+				Items: []metav1.PartialObjectMetadata{{
+					TypeMeta:   metav1.TypeMeta{Kind: "PartialObjectMetadata", APIVersion: "meta.k8s.io/v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default", UID: "c1"},
+				}},
+			})
+
+		default:
+			writeStatus(http.StatusNotFound, metav1.StatusReasonNotFound)
+		}
+	}))
+}
+
+func newKubeconfig(server string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: edge
+  cluster:
+    server: %s
+users:
+- name: edge
+  user:
+    token: token
+contexts:
+- name: edge
+  context:
+    cluster: edge
+    user: edge
+current-context: edge
+`, server)
+}
+
+func newEdgeClusterResolver(t *testing.T, server *httptest.Server, listMaxItems int) edgecluster.EdgeClusterResolverContract {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetGraphQLRawIDs().Return(false, nil).AnyTimes()
+	configurationService.EXPECT().GetKubernetesClientCacheSize().Return(10, nil).AnyTimes()
+	configurationService.EXPECT().GetKubernetesRequestTimeout().Return(requestTimeout, nil).AnyTimes()
+	configurationService.EXPECT().GetKubernetesListPageSize().Return(100, nil).AnyTimes()
+	configurationService.EXPECT().GetKubernetesListMaxItems().Return(listMaxItems, nil).AnyTimes()
+
+	logger := zap.NewNop()
+
+	globalIDService, err := globalid.NewBase64GlobalIDService(configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kubeClientService, err := kubeclient.NewCachedKubeClientService(configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fieldAuthorizationService, err := authorization.NewDirectiveFieldAuthorizationService(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver, err := queryedgecluster.NewEdgeClusterResolver(
+		context.Background(),
+		&fakeResolverCreator{logger: logger},
+		logger,
+		globalIDService,
+		mock_dataloader.NewMockDataLoaderContract(mockCtrl),
+		&fakeEdgeClusterClientService{},
+		fieldAuthorizationService,
+		kubeClientService,
+		"e1",
+		&edgecluster.EdgeClusterDetail{
+			EdgeCluster:      &edgeclusterGrpcContract.EdgeCluster{Name: "edge"},
+			ProvisionDetails: &edgeclusterGrpcContract.ProvisionDetail{KubeConfigContent: newKubeconfig(server.URL)},
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resolver
+}
+
+func metadataName(t *testing.T, getMetadata func(ctx context.Context) (edgecluster.ObjectMetaResolverContract, error)) string {
+	t.Helper()
+
+	metadata, err := getMetadata(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return metadata.Name(context.Background())
+}
+
+func TestEdgeClusterResolver_ReadsTheKubernetesObjects(t *testing.T) {
+	server := serveKubernetesAPI(t)
+	defer server.Close()
+
+	ctx := context.Background()
+	resolver := newEdgeClusterResolver(t, server, 1000)
+	defaultNamespace := "default"
+
+	namespaces, err := resolver.Namespaces(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(namespaces) != 2 || metadataName(t, namespaces[0].Metadata) != "default" || namespaces[1].Phase(ctx) != "Terminating" {
+		t.Fatalf("unexpected namespaces %v", namespaces)
+	}
+
+	deployments, err := resolver.Deployments(ctx, edgecluster.EdgeClusterKubernetesInputArgument{Namespace: &defaultNamespace})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deployments) != 1 || metadataName(t, deployments[0].Metadata) != "web" ||
+		deployments[0].Replicas(ctx) != 3 || deployments[0].ReadyReplicas(ctx) != 2 {
+		t.Fatalf("unexpected deployments %v", deployments)
+	}
+
+	events, err := resolver.Events(ctx, edgecluster.EdgeClusterKubernetesInputArgument{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events) != 1 || events[0].Reason(ctx) != "BackOff" || events[0].Count(ctx) != 4 {
+		t.Fatalf("unexpected events %v", events)
+	}
+
+	involvedObject, err := events[0].InvolvedObject(ctx)
+	if err != nil || involvedObject.Kind(ctx) != "Pod" || involvedObject.Name(ctx) != "web-1" {
+		t.Fatalf("unexpected involved object %v (%v)", involvedObject, err)
+	}
+
+	configMaps, err := resolver.ConfigMaps(ctx, edgecluster.EdgeClusterKubernetesInputArgument{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(configMaps) != 1 || metadataName(t, configMaps[0].Metadata) != "settings" {
+		t.Fatalf("unexpected config maps %v", configMaps)
+	}
+}
+
+func TestEdgeClusterResolver_ReportsTheTruncatedLists(t *testing.T) {
+	server := serveKubernetesAPI(t)
+	defer server.Close()
+
+	for name, test := range map[string]struct {
+		listMaxItems       int
+		expectedNamespaces int
+		expectedWarnings   int
+	}{
+		"truncated list":     {listMaxItems: 1, expectedNamespaces: 1, expectedWarnings: 1},
+		"not truncated list": {listMaxItems: 2, expectedNamespaces: 2, expectedWarnings: 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			collector := warning.NewWarningCollector()
+			ctx := warning.NewContext(context.Background(), collector)
+
+			namespaces, err := newEdgeClusterResolver(t, server, test.listMaxItems).Namespaces(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(namespaces) != test.expectedNamespaces {
+				t.Fatalf("expected %d namespaces, got %d", test.expectedNamespaces, len(namespaces))
+			}
+
+			warnings := collector.Warnings()
+			if len(warnings) != test.expectedWarnings {
+				t.Fatalf("expected %d warnings, got %v", test.expectedWarnings, warnings)
+			}
+
+			if test.expectedWarnings == 0 {
+				return
+			}
+
+			extensions := warnings[0].Extensions
+			if extensions["code"] != warning.CodeListTruncated || extensions["field"] != "namespaces" || extensions["edgeClusterID"] == "e1" {
+				t.Fatalf("expected the truncation of the namespaces of the edge cluster global ID to be reported, got %v", extensions)
+			}
+		})
+	}
+}
+
+func TestEdgeClusterResolver_TranslatesTheKubernetesErrors(t *testing.T) {
+	server := serveKubernetesAPI(t)
+	defer server.Close()
+
+	ctx := context.Background()
+	resolver := newEdgeClusterResolver(t, server, 1000)
+
+	for namespace, expectedCode := range map[string]string{
+		"missing":   errortranslation.CodeNotFound,
+		"forbidden": errortranslation.CodeForbidden,
+		"slow":      errortranslation.CodeUnavailable,
+	} {
+		namespace := namespace
+		args := edgecluster.EdgeClusterKubernetesInputArgument{Namespace: &namespace}
+
+		_, deploymentsErr := resolver.Deployments(ctx, args)
+		_, eventsErr := resolver.Events(ctx, args)
+		_, configMapsErr := resolver.ConfigMaps(ctx, args)
+
+		for _, err := range []error{deploymentsErr, eventsErr, configMapsErr} {
+			var extendedErr interface{ Extensions() map[string]interface{} }
+			if !errors.As(err, &extendedErr) || extendedErr.Extensions()["code"] != expectedCode {
+				t.Fatalf("expected the %s namespace error to be translated to %s, got %v", namespace, expectedCode, err)
+			}
+		}
+	}
+}
+
+func TestEdgeClusterResolver_RejectsTheNotProvisionedEdgeClusters(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetGraphQLRawIDs().Return(false, nil).AnyTimes()
+	configurationService.EXPECT().GetKubernetesClientCacheSize().Return(10, nil)
+	configurationService.EXPECT().GetKubernetesRequestTimeout().Return(requestTimeout, nil)
+	configurationService.EXPECT().GetKubernetesListPageSize().Return(100, nil)
+	configurationService.EXPECT().GetKubernetesListMaxItems().Return(1000, nil)
+
+	logger := zap.NewNop()
+	globalIDService, _ := globalid.NewBase64GlobalIDService(configurationService)
+	kubeClientService, _ := kubeclient.NewCachedKubeClientService(configurationService)
+	fieldAuthorizationService, _ := authorization.NewDirectiveFieldAuthorizationService(logger)
+
+	resolver, err := queryedgecluster.NewEdgeClusterResolver(
+		context.Background(),
+		&fakeResolverCreator{logger: logger},
+		logger,
+		globalIDService,
+		mock_dataloader.NewMockDataLoaderContract(mockCtrl),
+		&fakeEdgeClusterClientService{},
+		fieldAuthorizationService,
+		kubeClientService,
+		"e1",
+		&edgecluster.EdgeClusterDetail{EdgeCluster: &edgeclusterGrpcContract.EdgeCluster{Name: "edge"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = resolver.Namespaces(context.Background())
+
+	var extendedErr interface{ Extensions() map[string]interface{} }
+	if !errors.As(err, &extendedErr) || extendedErr.Extensions()["code"] != errortranslation.CodeInvalidArgument {
+		t.Fatalf("expected the not provisioned edge cluster to be rejected, got %v", err)
+	}
+}
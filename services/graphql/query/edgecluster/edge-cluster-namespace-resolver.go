@@ -0,0 +1,66 @@
+// Package edgecluster implements different edge cluster GraphQL query resovlers required by the GraphQL transport layer
+package edgecluster
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type edgeClusterNamespaceResolver struct {
+	logger          *zap.Logger
+	resolverCreator types.ResolverCreatorContract
+	namespace       *corev1.Namespace
+}
+
+// NewEdgeClusterNamespaceResolver creates new instance of the edgeClusterNamespaceResolver, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// logger: Mandatory. Reference to the logger service
+// resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
+// namespace: Mandatory. Contains information about the edge cluster namespace.
+// Returns the new instance or error if something goes wrong
+func NewEdgeClusterNamespaceResolver(
+	ctx context.Context,
+	logger *zap.Logger,
+	resolverCreator types.ResolverCreatorContract,
+	namespace *corev1.Namespace) (edgecluster.NamespaceResolverContract, error) {
+	if ctx == nil {
+		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
+	}
+
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if resolverCreator == nil {
+		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
+	}
+
+	if namespace == nil {
+		return nil, commonErrors.NewArgumentNilError("namespace", "namespace is required")
+	}
+
+	return &edgeClusterNamespaceResolver{
+		logger:          logger,
+		resolverCreator: resolverCreator,
+		namespace:       namespace,
+	}, nil
+}
+
+// Metadata contains the namespace metadata
+// ctx: Mandatory. Reference to the context
+// Returns the namespace metadata resolver or error if something goes wrong.
+func (r *edgeClusterNamespaceResolver) Metadata(ctx context.Context) (edgecluster.ObjectMetaResolverContract, error) {
+	return r.resolverCreator.NewObjectMetaResolver(ctx, fromKubernetesObjectMeta(r.namespace.ObjectMeta))
+}
+
+// Phase returns the current lifecycle phase of the namespace
+// ctx: Mandatory. Reference to the context
+// Returns the current lifecycle phase of the namespace
+func (r *edgeClusterNamespaceResolver) Phase(ctx context.Context) string {
+	return string(r.namespace.Status.Phase)
+}
@@ -5,30 +5,37 @@ import (
 	"context"
 	"strings"
 
+	"github.com/decentralized-cloud/api-gateway/services/dataloader"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
 	"github.com/graph-gophers/graphql-go"
-	"github.com/lucsky/cuid"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
 )
 
 type edgeClusterProjectResolver struct {
 	logger          *zap.Logger
+	globalIDService globalid.GlobalIDContract
 	resolverCreator types.ResolverCreatorContract
+	dataLoader      dataloader.DataLoaderContract
 	projectID       string
-	name            string
 }
 
 // NewEdgeClusterProjectResolver creates new instance of the edgeClusterProjectResolver, setting up all dependencies and returns the instance
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
+// logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
+// dataLoader: Mandatory. the data loader that batches the project lookups made while resolving the request
 // projectID: Mandatory. the project unique identifier
 // Returns the new instance or error if something goes wrong
 func NewEdgeClusterProjectResolver(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
 	logger *zap.Logger,
+	globalIDService globalid.GlobalIDContract,
+	dataLoader dataloader.DataLoaderContract,
 	projectID string) (edgecluster.EdgeClusterProjectResolverContract, error) {
 	if ctx == nil {
 		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
@@ -42,15 +49,24 @@ func NewEdgeClusterProjectResolver(
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
+	if dataLoader == nil {
+		return nil, commonErrors.NewArgumentNilError("dataLoader", "dataLoader is required")
+	}
+
 	if strings.Trim(projectID, " ") == "" {
 		return nil, commonErrors.NewArgumentError("projectID", "projectID is required")
 	}
 
 	return &edgeClusterProjectResolver{
 		logger:          logger,
+		globalIDService: globalIDService,
 		resolverCreator: resolverCreator,
+		dataLoader:      dataLoader,
 		projectID:       projectID,
-		name:            cuid.New(),
 	}, nil
 }
 
@@ -58,12 +74,18 @@ func NewEdgeClusterProjectResolver(
 // ctx: Mandatory. Reference to the context
 // Returns the project unique identifier
 func (r *edgeClusterProjectResolver) ID(ctx context.Context) graphql.ID {
-	return graphql.ID(r.projectID)
+	return r.globalIDService.ToGlobalID(globalid.TypeEdgeClusterProject, r.projectID)
 }
 
-// Name returns project name
+// Name returns project name. The project is loaded on demand so the projects of all the edge clusters
+// being resolved are fetched in a single batch.
 // ctx: Mandatory. Reference to the context
-// Returns the project name or error
-func (r *edgeClusterProjectResolver) Name(ctx context.Context) string {
-	return r.name
+// Returns the project name or error if something goes wrong
+func (r *edgeClusterProjectResolver) Name(ctx context.Context) (string, error) {
+	projectDetail, err := r.dataLoader.LoadProject(ctx, r.projectID)
+	if err != nil {
+		return "", err
+	}
+
+	return projectDetail.Project.Name, nil
 }
@@ -3,30 +3,46 @@ package edgecluster
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	"github.com/decentralized-cloud/api-gateway/services/dataloader"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/kubeclient"
+	"github.com/decentralized-cloud/api-gateway/services/warning"
 	edgeclusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
 	"github.com/graph-gophers/graphql-go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type edgeClusterResolver struct {
-	logger                   *zap.Logger
-	resolverCreator          types.ResolverCreatorContract
-	edgeclusterID            string
-	edgeClusterDetail        *edgecluster.EdgeClusterDetail
-	edgeClusterClientService edgecluster.EdgeClusterClientContract
+	logger                    *zap.Logger
+	globalIDService           globalid.GlobalIDContract
+	resolverCreator           types.ResolverCreatorContract
+	edgeclusterID             string
+	edgeClusterDetail         *edgecluster.EdgeClusterDetail
+	edgeClusterClientService  edgecluster.EdgeClusterClientContract
+	fieldAuthorizationService authorization.FieldAuthorizationContract
+	kubeClientService         kubeclient.KubeClientContract
 }
 
 // NewEdgeClusterResolver creates new instance of the edgeClusterResolver, setting up all dependencies and returns the instance
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
 // logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
+// dataLoader: Mandatory. the data loader that batches the edge cluster lookups made while resolving the request
+// edgeClusterClientService: Mandatory. the edge cluster client service that creates gRPC connection and client to the edge cluster
+// fieldAuthorizationService: Mandatory. the service that authorizes the access to the edge cluster sensitive fields
+// kubeClientService: Mandatory. the service that creates the clients of the edge cluster Kubernetes API
 // edgeClusterID: Mandatory. the edge cluster unique identifier
 // edgeClusterDetail: Optional. The edge cluster details, if provided, the value be used instead of contacting  the edge cluster service
 // Returns the new instance or error if something goes wrong
@@ -34,7 +50,11 @@ func NewEdgeClusterResolver(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
 	logger *zap.Logger,
+	globalIDService globalid.GlobalIDContract,
+	dataLoader dataloader.DataLoaderContract,
 	edgeClusterClientService edgecluster.EdgeClusterClientContract,
+	fieldAuthorizationService authorization.FieldAuthorizationContract,
+	kubeClientService kubeclient.KubeClientContract,
 	edgeClusterID string,
 	edgeClusterDetail *edgecluster.EdgeClusterDetail) (edgecluster.EdgeClusterResolverContract, error) {
 	if ctx == nil {
@@ -49,48 +69,47 @@ func NewEdgeClusterResolver(
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
+	if dataLoader == nil {
+		return nil, commonErrors.NewArgumentNilError("dataLoader", "dataLoader is required")
+	}
+
 	if edgeClusterClientService == nil {
 		return nil, commonErrors.NewArgumentNilError("edgeClusterClientService", "edgeClusterClientService is required")
 	}
 
+	if fieldAuthorizationService == nil {
+		return nil, commonErrors.NewArgumentNilError("fieldAuthorizationService", "fieldAuthorizationService is required")
+	}
+
+	if kubeClientService == nil {
+		return nil, commonErrors.NewArgumentNilError("kubeClientService", "kubeClientService is required")
+	}
+
 	if strings.Trim(edgeClusterID, " ") == "" {
 		return nil, commonErrors.NewArgumentError("edgeClusterID", "edgeClusterID is required")
 	}
 
 	resolver := edgeClusterResolver{
-		logger:                   logger,
-		resolverCreator:          resolverCreator,
-		edgeclusterID:            edgeClusterID,
-		edgeClusterClientService: edgeClusterClientService,
+		logger:                    logger,
+		globalIDService:           globalIDService,
+		resolverCreator:           resolverCreator,
+		edgeclusterID:             edgeClusterID,
+		edgeClusterClientService:  edgeClusterClientService,
+		fieldAuthorizationService: fieldAuthorizationService,
+		kubeClientService:         kubeClientService,
 	}
 
 	if edgeClusterDetail == nil {
-		connection, edgeClusterServiceClient, err := edgeClusterClientService.CreateClient()
+		loadedEdgeClusterDetail, err := dataLoader.LoadEdgeCluster(ctx, edgeClusterID)
 		if err != nil {
 			return nil, err
 		}
 
-		defer func() {
-			_ = connection.Close()
-		}()
-
-		response, err := edgeClusterServiceClient.ReadEdgeCluster(
-			ctx,
-			&edgeclusterGrpcContract.ReadEdgeClusterRequest{
-				EdgeClusterID: edgeClusterID,
-			})
-		if err != nil {
-			return nil, err
-		}
-
-		if response.Error != edgeclusterGrpcContract.Error_NO_ERROR {
-			return nil, errors.New(response.ErrorMessage)
-		}
-
-		resolver.edgeClusterDetail = &edgecluster.EdgeClusterDetail{
-			EdgeCluster:      response.EdgeCluster,
-			ProvisionDetails: response.ProvisionDetail,
-		}
+		resolver.edgeClusterDetail = loadedEdgeClusterDetail
 	} else {
 		resolver.edgeClusterDetail = edgeClusterDetail
 	}
@@ -102,7 +121,7 @@ func NewEdgeClusterResolver(
 // ctx: Mandatory. Reference to the context
 // Returns the edge cluster unique identifier
 func (r *edgeClusterResolver) ID(ctx context.Context) graphql.ID {
-	return graphql.ID(r.edgeclusterID)
+	return r.globalIDService.ToGlobalID(globalid.TypeEdgeCluster, r.edgeclusterID)
 }
 
 // Name returns edge cluster name
@@ -114,9 +133,13 @@ func (r *edgeClusterResolver) Name(ctx context.Context) string {
 
 // ClusterSecret returns edge cluster secret
 // ctx: Mandatory. Reference to the context
-// Returns the edge cluster secret
-func (r *edgeClusterResolver) ClusterSecret(ctx context.Context) string {
-	return r.edgeClusterDetail.EdgeCluster.ClusterSecret
+// Returns the edge cluster secret or error if the access to the edge cluster secret is denied
+func (r *edgeClusterResolver) ClusterSecret(ctx context.Context) (*string, error) {
+	if err := r.fieldAuthorizationService.AuthorizeField(ctx, "EdgeCluster", "clusterSecret"); err != nil {
+		return nil, err
+	}
+
+	return &r.edgeClusterDetail.EdgeCluster.ClusterSecret, nil
 }
 
 // ClusterType returns the edge cluster current type
@@ -152,14 +175,7 @@ func (r *edgeClusterResolver) ProvisionDetails(ctx context.Context) (edgecluster
 // ctx: Mandatory. Reference to the context
 // Returns the resolver that resolves the nodes that are part of the given edge cluster or error if something goes wrong.
 func (r *edgeClusterResolver) Nodes(ctx context.Context) ([]edgecluster.NodeResolverContract, error) {
-	connection, edgeClusterServiceClient, err := r.edgeClusterClientService.CreateClient()
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = connection.Close()
-	}()
+	edgeClusterServiceClient := r.edgeClusterClientService.GetClient()
 
 	listEdgeClusterNodesResponse, err := edgeClusterServiceClient.ListEdgeClusterNodes(
 		ctx,
@@ -167,12 +183,11 @@ func (r *edgeClusterResolver) Nodes(ctx context.Context) ([]edgecluster.NodeReso
 			EdgeClusterID: r.edgeclusterID,
 		})
 	if err != nil {
-		return nil, err
+		return nil, errortranslation.FromError(err)
 	}
 
-	if listEdgeClusterNodesResponse.Error != edgeclusterGrpcContract.Error_NO_ERROR {
-		// if failed to retrieve nodes, return empty list
-		return []edgecluster.NodeResolverContract{}, err
+	if err := errortranslation.FromEdgeClusterError(listEdgeClusterNodesResponse.Error, listEdgeClusterNodesResponse.ErrorMessage); err != nil {
+		return nil, err
 	}
 
 	response := []edgecluster.NodeResolverContract{}
@@ -192,14 +207,7 @@ func (r *edgeClusterResolver) Nodes(ctx context.Context) ([]edgecluster.NodeReso
 // args: Mandatory. Reference to the query argument
 // Returns the resolver that resolves the pods that are part of the given edge cluster or error if something goes wrong.
 func (r *edgeClusterResolver) Pods(ctx context.Context, args edgecluster.EdgeClusterPodInputArgument) ([]edgecluster.PodResolverContract, error) {
-	connection, edgeClusterServiceClient, err := r.edgeClusterClientService.CreateClient()
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = connection.Close()
-	}()
+	edgeClusterServiceClient := r.edgeClusterClientService.GetClient()
 
 	request := &edgeclusterGrpcContract.ListEdgeClusterPodsRequest{
 		EdgeClusterID: r.edgeclusterID,
@@ -217,12 +225,11 @@ func (r *edgeClusterResolver) Pods(ctx context.Context, args edgecluster.EdgeClu
 		ctx,
 		request)
 	if err != nil {
-		return nil, err
+		return nil, errortranslation.FromError(err)
 	}
 
-	if listEdgeClusterNodesResponse.Error != edgeclusterGrpcContract.Error_NO_ERROR {
-		// if failed to retrieve pods, return empty list
-		return []edgecluster.PodResolverContract{}, nil
+	if err := errortranslation.FromEdgeClusterError(listEdgeClusterNodesResponse.Error, listEdgeClusterNodesResponse.ErrorMessage); err != nil {
+		return nil, err
 	}
 
 	response := []edgecluster.PodResolverContract{}
@@ -242,14 +249,7 @@ func (r *edgeClusterResolver) Pods(ctx context.Context, args edgecluster.EdgeClu
 // args: Mandatory. Reference to the query argument
 // Returns the resolver that resolves the services that are part of the given edge cluster or error if something goes wrong.
 func (r *edgeClusterResolver) Services(ctx context.Context, args edgecluster.EdgeClusterServiceInputArgument) ([]edgecluster.ServiceResolverContract, error) {
-	connection, edgeClusterServiceClient, err := r.edgeClusterClientService.CreateClient()
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = connection.Close()
-	}()
+	edgeClusterServiceClient := r.edgeClusterClientService.GetClient()
 
 	request := &edgeclusterGrpcContract.ListEdgeClusterServicesRequest{
 		EdgeClusterID: r.edgeclusterID,
@@ -263,12 +263,11 @@ func (r *edgeClusterResolver) Services(ctx context.Context, args edgecluster.Edg
 		ctx,
 		request)
 	if err != nil {
-		return nil, err
+		return nil, errortranslation.FromError(err)
 	}
 
-	if listEdgeClusterNodesResponse.Error != edgeclusterGrpcContract.Error_NO_ERROR {
-		// if failed to retrieve services, return empty list
-		return []edgecluster.ServiceResolverContract{}, nil
+	if err := errortranslation.FromEdgeClusterError(listEdgeClusterNodesResponse.Error, listEdgeClusterNodesResponse.ErrorMessage); err != nil {
+		return nil, err
 	}
 
 	response := []edgecluster.ServiceResolverContract{}
@@ -282,3 +281,192 @@ func (r *edgeClusterResolver) Services(ctx context.Context, args edgecluster.Edg
 
 	return response, nil
 }
+
+// Namespaces returns the resolver that resolves the namespaces of the given edge cluster read directly from the edge cluster Kubernetes API
+// ctx: Mandatory. Reference to the context
+// Returns the resolver that resolves the namespaces of the given edge cluster or error if something goes wrong.
+func (r *edgeClusterResolver) Namespaces(ctx context.Context) ([]edgecluster.NamespaceResolverContract, error) {
+	client, err := r.kubeClientService.GetClient(r.edgeclusterID, r.getKubeconfigContent())
+	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	namespaces := []corev1.Namespace{}
+	if err := r.listKubernetesObjects(ctx, "namespaces", func(ctx context.Context, options metav1.ListOptions) (string, int, error) {
+		namespaceList, err := client.CoreV1().Namespaces().List(ctx, options)
+		if err != nil {
+			return "", 0, err
+		}
+
+		namespaces = append(namespaces, namespaceList.Items...)
+
+		return namespaceList.Continue, len(namespaceList.Items), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	response := []edgecluster.NamespaceResolverContract{}
+	for idx := range namespaces {
+		if resolver, err := r.resolverCreator.NewEdgeClusterNamespaceResolver(ctx, &namespaces[idx]); err != nil {
+			return nil, err
+		} else {
+			response = append(response, resolver)
+		}
+	}
+
+	return response, nil
+}
+
+// Deployments returns the resolver that resolves the deployments of the given edge cluster read directly from the edge cluster Kubernetes API
+// ctx: Mandatory. Reference to the context
+// args: Mandatory. Reference to the query argument
+// Returns the resolver that resolves the deployments of the given edge cluster or error if something goes wrong.
+func (r *edgeClusterResolver) Deployments(ctx context.Context, args edgecluster.EdgeClusterKubernetesInputArgument) ([]edgecluster.DeploymentResolverContract, error) {
+	client, err := r.kubeClientService.GetClient(r.edgeclusterID, r.getKubeconfigContent())
+	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	deployments := []appsv1.Deployment{}
+	if err := r.listKubernetesObjects(ctx, "deployments", func(ctx context.Context, options metav1.ListOptions) (string, int, error) {
+		deploymentList, err := client.AppsV1().Deployments(getNamespace(args)).List(ctx, options)
+		if err != nil {
+			return "", 0, err
+		}
+
+		deployments = append(deployments, deploymentList.Items...)
+
+		return deploymentList.Continue, len(deploymentList.Items), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	response := []edgecluster.DeploymentResolverContract{}
+	for idx := range deployments {
+		if resolver, err := r.resolverCreator.NewEdgeClusterDeploymentResolver(ctx, &deployments[idx]); err != nil {
+			return nil, err
+		} else {
+			response = append(response, resolver)
+		}
+	}
+
+	return response, nil
+}
+
+// Events returns the resolver that resolves the events of the given edge cluster read directly from the edge cluster Kubernetes API
+// ctx: Mandatory. Reference to the context
+// args: Mandatory. Reference to the query argument
+// Returns the resolver that resolves the events of the given edge cluster or error if something goes wrong.
+func (r *edgeClusterResolver) Events(ctx context.Context, args edgecluster.EdgeClusterKubernetesInputArgument) ([]edgecluster.EventResolverContract, error) {
+	client, err := r.kubeClientService.GetClient(r.edgeclusterID, r.getKubeconfigContent())
+	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	events := []corev1.Event{}
+	if err := r.listKubernetesObjects(ctx, "events", func(ctx context.Context, options metav1.ListOptions) (string, int, error) {
+		eventList, err := client.CoreV1().Events(getNamespace(args)).List(ctx, options)
+		if err != nil {
+			return "", 0, err
+		}
+
+		events = append(events, eventList.Items...)
+
+		return eventList.Continue, len(eventList.Items), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	response := []edgecluster.EventResolverContract{}
+	for idx := range events {
+		if resolver, err := r.resolverCreator.NewEdgeClusterEventResolver(ctx, &events[idx]); err != nil {
+			return nil, err
+		} else {
+			response = append(response, resolver)
+		}
+	}
+
+	return response, nil
+}
+
+// ConfigMaps returns the resolver that resolves the config maps of the given edge cluster read directly from the edge cluster Kubernetes API.
+// Only the config map metadata is read from the edge cluster Kubernetes API.
+// ctx: Mandatory. Reference to the context
+// args: Mandatory. Reference to the query argument
+// Returns the resolver that resolves the config maps of the given edge cluster or error if something goes wrong.
+func (r *edgeClusterResolver) ConfigMaps(ctx context.Context, args edgecluster.EdgeClusterKubernetesInputArgument) ([]edgecluster.ConfigMapResolverContract, error) {
+	client, err := r.kubeClientService.GetMetadataClient(r.edgeclusterID, r.getKubeconfigContent())
+	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	configMaps := []metav1.PartialObjectMetadata{}
+	if err := r.listKubernetesObjects(ctx, "configMaps", func(ctx context.Context, options metav1.ListOptions) (string, int, error) {
+		configMapList, err := client.Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).Namespace(getNamespace(args)).List(ctx, options)
+		if err != nil {
+			return "", 0, err
+		}
+
+		configMaps = append(configMaps, configMapList.Items...)
+
+		return configMapList.Continue, len(configMapList.Items), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	response := []edgecluster.ConfigMapResolverContract{}
+	for idx := range configMaps {
+		if resolver, err := r.resolverCreator.NewEdgeClusterConfigMapResolver(ctx, &configMaps[idx]); err != nil {
+			return nil, err
+		} else {
+			response = append(response, resolver)
+		}
+	}
+
+	return response, nil
+}
+
+// getKubeconfigContent returns the edge cluster kubeconfig content, empty if the edge cluster is not provisioned yet
+func (r *edgeClusterResolver) getKubeconfigContent() string {
+	if r.edgeClusterDetail.ProvisionDetails == nil {
+		return ""
+	}
+
+	return r.edgeClusterDetail.ProvisionDetails.KubeConfigContent
+}
+
+// listKubernetesObjects lists the edge cluster objects page by page, the list is truncated once the configured maximum
+// number of the listed objects is reached and the truncation is reported to the client as a warning
+func (r *edgeClusterResolver) listKubernetesObjects(ctx context.Context, field string, listPage kubeclient.ListPageFunc) error {
+	truncated, err := r.kubeClientService.List(ctx, listPage)
+	if err != nil {
+		return errortranslation.FromKubernetesError(err)
+	}
+
+	if truncated {
+		r.logger.Warn(
+			"The edge cluster Kubernetes objects were truncated to the configured maximum number of the listed objects",
+			zap.String("edgeClusterID", r.edgeclusterID),
+			zap.String("field", field))
+
+		warning.Report(ctx, warning.Warning{
+			Message: fmt.Sprintf("The %s of the edge cluster were truncated to the configured maximum number of the listed objects", field),
+			Extensions: map[string]interface{}{
+				"code":          warning.CodeListTruncated,
+				"edgeClusterID": r.globalIDService.ToGlobalID(globalid.TypeEdgeCluster, r.edgeclusterID),
+				"field":         field,
+			},
+		})
+	}
+
+	return nil
+}
+
+// getNamespace returns the namespace the edge cluster objects are listed from, all namespaces are used if no namespace is provided
+func getNamespace(args edgecluster.EdgeClusterKubernetesInputArgument) string {
+	if args.Namespace == nil {
+		return metav1.NamespaceAll
+	}
+
+	return *args.Namespace
+}
@@ -0,0 +1,67 @@
+package edgecluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	queryedgecluster "github.com/decentralized-cloud/api-gateway/services/graphql/query/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	edgeclusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
+	"go.uber.org/zap"
+)
+
+func TestEdgeClusterResolver_RestrictsTheSecrets(t *testing.T) {
+	server := serveKubernetesAPI(t)
+	defer server.Close()
+
+	logger := zap.NewNop()
+	fieldAuthorizationService, err := authorization.NewDirectiveFieldAuthorizationService(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provisionDetailsResolver, err := queryedgecluster.NewProvisionDetailsResolver(
+		context.Background(),
+		logger,
+		&fakeResolverCreator{logger: logger},
+		fieldAuthorizationService,
+		&edgeclusterGrpcContract.ProvisionDetail{KubeConfigContent: "kubeconfig"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edgeClusterResolver := newEdgeClusterResolver(t, server, 1000)
+
+	user := identity.NewContext(context.Background(), &identity.Principal{Subject: "user1"})
+
+	clusterSecret, err := edgeClusterResolver.ClusterSecret(user)
+	assertForbidden(t, clusterSecret, err)
+
+	kubeconfigContent, err := provisionDetailsResolver.KubeconfigContent(user)
+	assertForbidden(t, kubeconfigContent, err)
+
+	granted := identity.NewContext(context.Background(), &identity.Principal{Subject: "user1", Scopes: []string{"edgecluster:secrets:read"}})
+
+	if clusterSecret, err := edgeClusterResolver.ClusterSecret(granted); err != nil || clusterSecret == nil {
+		t.Fatalf("expected the caller granted the scope to read the cluster secret, got %v", err)
+	}
+
+	if kubeconfigContent, err := provisionDetailsResolver.KubeconfigContent(granted); err != nil || kubeconfigContent == nil || *kubeconfigContent != "kubeconfig" {
+		t.Fatalf("expected the caller granted the scope to read the kubeconfig content, got %v", err)
+	}
+}
+
+func assertForbidden(t *testing.T, value *string, err error) {
+	t.Helper()
+
+	var extendedErr interface{ Extensions() map[string]interface{} }
+	if value != nil || !errors.As(err, &extendedErr) || extendedErr.Extensions()["code"] != authorization.ErrorCodeForbidden {
+		t.Fatalf("expected the access to be denied, got %v %v", value, err)
+	}
+
+	if extendedErr.Extensions()["requiredScope"] != "edgecluster:secrets:read" {
+		t.Fatalf("expected the required scope to be reported, got %v", extendedErr.Extensions())
+	}
+}
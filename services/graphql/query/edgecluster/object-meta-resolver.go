@@ -9,6 +9,7 @@ import (
 	"github.com/graph-gophers/graphql-go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type objectMetaResolver struct {
@@ -63,3 +64,13 @@ func (r *objectMetaResolver) Name(ctx context.Context) string {
 func (r *objectMetaResolver) Namespace(ctx context.Context) string {
 	return r.metadata.Namespace
 }
+
+// fromKubernetesObjectMeta converts the metadata of the object read from the edge cluster Kubernetes API to the edge
+// cluster object metadata so the same metadata resolver is used for all edge cluster objects
+func fromKubernetesObjectMeta(metadata metav1.ObjectMeta) *edgeclusterGrpcContract.ObjectMeta {
+	return &edgeclusterGrpcContract.ObjectMeta{
+		Id:        string(metadata.UID),
+		Name:      metadata.Name,
+		Namespace: metadata.Namespace,
+	}
+}
@@ -0,0 +1,64 @@
+// Package edgecluster implements different edge cluster GraphQL query resovlers required by the GraphQL transport layer
+package edgecluster
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type objectReferenceResolver struct {
+	logger          *zap.Logger
+	objectReference *corev1.ObjectReference
+}
+
+// NewObjectReferenceResolver creates new instance of the objectReferenceResolver, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// logger: Mandatory. Reference to the logger service
+// objectReference: Mandatory. Contains enough information to identify the referred edge cluster object.
+// Returns the new instance or error if something goes wrong
+func NewObjectReferenceResolver(
+	ctx context.Context,
+	logger *zap.Logger,
+	objectReference *corev1.ObjectReference) (edgecluster.ObjectReferenceResolverContract, error) {
+	if ctx == nil {
+		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
+	}
+
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if objectReference == nil {
+		return nil, commonErrors.NewArgumentNilError("objectReference", "objectReference is required")
+	}
+
+	return &objectReferenceResolver{
+		logger:          logger,
+		objectReference: objectReference,
+	}, nil
+}
+
+// Kind returns the kind of the referred object
+// ctx: Mandatory. Reference to the context
+// Returns the kind of the referred object
+func (r *objectReferenceResolver) Kind(ctx context.Context) string {
+	return r.objectReference.Kind
+}
+
+// Name returns the name of the referred object
+// ctx: Mandatory. Reference to the context
+// Returns the name of the referred object
+func (r *objectReferenceResolver) Name(ctx context.Context) string {
+	return r.objectReference.Name
+}
+
+// Namespace returns the namespace of the referred object
+// ctx: Mandatory. Reference to the context
+// Returns the namespace of the referred object
+func (r *objectReferenceResolver) Namespace(ctx context.Context) string {
+	return r.objectReference.Namespace
+}
@@ -4,6 +4,7 @@ package edgecluster
 import (
 	"context"
 
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
 	edgeclusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
@@ -12,20 +13,24 @@ import (
 )
 
 type provisionDetailsResolver struct {
-	logger           *zap.Logger
-	resolverCreator  types.ResolverCreatorContract
-	provisionDetails *edgeclusterGrpcContract.ProvisionDetail
+	logger                    *zap.Logger
+	resolverCreator           types.ResolverCreatorContract
+	fieldAuthorizationService authorization.FieldAuthorizationContract
+	provisionDetails          *edgeclusterGrpcContract.ProvisionDetail
 }
 
 // NewProvisionDetailsResolver creates new instance of the provisionDetailsResolver, setting up all dependencies and returns the instance
 // ctx: Mandatory. Reference to the context
 // logger: Mandatory. Reference to the logger service
+// resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
+// fieldAuthorizationService: Mandatory. the service that authorizes the access to the edge cluster sensitive fields
 // provisionDetails: Optional. The edge cluster provisioning details
 // Returns the new instance or error if something goes wrong
 func NewProvisionDetailsResolver(
 	ctx context.Context,
 	logger *zap.Logger,
 	resolverCreator types.ResolverCreatorContract,
+	fieldAuthorizationService authorization.FieldAuthorizationContract,
 	provisionDetails *edgeclusterGrpcContract.ProvisionDetail) (edgecluster.ProvisionDetailsResolverContract, error) {
 	if ctx == nil {
 		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
@@ -39,14 +44,19 @@ func NewProvisionDetailsResolver(
 		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
 	}
 
+	if fieldAuthorizationService == nil {
+		return nil, commonErrors.NewArgumentNilError("fieldAuthorizationService", "fieldAuthorizationService is required")
+	}
+
 	if provisionDetails == nil {
 		return nil, commonErrors.NewArgumentNilError("provisionDetails", "provisionDetails is required")
 	}
 
 	return &provisionDetailsResolver{
-		logger:           logger,
-		resolverCreator:  resolverCreator,
-		provisionDetails: provisionDetails,
+		logger:                    logger,
+		resolverCreator:           resolverCreator,
+		fieldAuthorizationService: fieldAuthorizationService,
+		provisionDetails:          provisionDetails,
 	}, nil
 }
 
@@ -63,13 +73,17 @@ func (r *provisionDetailsResolver) LoadBalancer(ctx context.Context) (edgecluste
 
 // KubeconfigContent returns the edge cluster Kubeconfig content
 // ctx: Mandatory. Reference to the context
-// Returns the edge cluster Kubeconfig content
-func (r *provisionDetailsResolver) KubeconfigContent(ctx context.Context) *string {
+// Returns the edge cluster Kubeconfig content or error if the access to the Kubeconfig content is denied
+func (r *provisionDetailsResolver) KubeconfigContent(ctx context.Context) (*string, error) {
+	if err := r.fieldAuthorizationService.AuthorizeField(ctx, "ProvisionDetails", "kubeconfigContent"); err != nil {
+		return nil, err
+	}
+
 	if r.provisionDetails.KubeConfigContent == "" {
-		return nil
+		return nil, nil
 	}
 
-	return &r.provisionDetails.KubeConfigContent
+	return &r.provisionDetails.KubeConfigContent, nil
 }
 
 // Ports returns the ports that are exposed by the service
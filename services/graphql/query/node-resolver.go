@@ -0,0 +1,127 @@
+// Package query implements different GraphQL query resovlers required by the GraphQL transport layer
+package query
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/graph-gophers/graphql-go"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+type nodeResolver struct {
+	userResolver               types.UserResolverContract
+	projectResolver            project.ProjectResolverContract
+	edgeClusterResolver        edgecluster.EdgeClusterResolverContract
+	edgeClusterProjectResolver edgecluster.EdgeClusterProjectResolverContract
+}
+
+// NewNodeResolver creates new instance of the nodeResolver, setting up all dependencies and returns the instance. The
+// global identifier is routed to the resolver of the type it carries. The users can only fetch their own user object.
+// ctx: Mandatory. Reference to the context
+// resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
+// logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that decodes the global identifiers
+// id: Mandatory. the object global identifier
+// Returns the new instance, nil if the object does not exist, or error if something goes wrong
+func NewNodeResolver(
+	ctx context.Context,
+	resolverCreator types.ResolverCreatorContract,
+	logger *zap.Logger,
+	globalIDService globalid.GlobalIDContract,
+	id graphql.ID) (types.NodeResolverContract, error) {
+	if ctx == nil {
+		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
+	}
+
+	if resolverCreator == nil {
+		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
+	}
+
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
+	typeName, objectID, err := globalIDService.ParseGlobalID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := nodeResolver{}
+
+	switch typeName {
+	case globalid.TypeUser:
+		principal, ok := identity.FromContext(ctx)
+		if !ok || principal.Subject != objectID {
+			return nil, nil
+		}
+
+		resolver.userResolver, err = resolverCreator.NewUserResolver(ctx, objectID)
+	case globalid.TypeProject:
+		resolver.projectResolver, err = resolverCreator.NewProjectResolver(ctx, objectID, nil)
+	case globalid.TypeEdgeCluster:
+		resolver.edgeClusterResolver, err = resolverCreator.NewEdgeClusterResolver(ctx, objectID, nil)
+	case globalid.TypeEdgeClusterProject:
+		resolver.edgeClusterProjectResolver, err = resolverCreator.NewEdgeClusterProjectResolver(ctx, objectID)
+	}
+
+	if errortranslation.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &resolver, nil
+}
+
+// ID returns the object global identifier
+// ctx: Mandatory. Reference to the context
+// Returns the object global identifier
+func (r *nodeResolver) ID(ctx context.Context) (graphql.ID, error) {
+	switch {
+	case r.userResolver != nil:
+		return r.userResolver.ID(ctx), nil
+	case r.projectResolver != nil:
+		return r.projectResolver.ID(ctx), nil
+	case r.edgeClusterResolver != nil:
+		return r.edgeClusterResolver.ID(ctx), nil
+	default:
+		return r.edgeClusterProjectResolver.ID(ctx), nil
+	}
+}
+
+// ToUser returns the user resolver if the object is a user
+// Returns the user resolver and true if the object is a user, otherwise false
+func (r *nodeResolver) ToUser() (types.UserResolverContract, bool) {
+	return r.userResolver, r.userResolver != nil
+}
+
+// ToProject returns the project resolver if the object is a project
+// Returns the project resolver and true if the object is a project, otherwise false
+func (r *nodeResolver) ToProject() (project.ProjectResolverContract, bool) {
+	return r.projectResolver, r.projectResolver != nil
+}
+
+// ToEdgeCluster returns the edge cluster resolver if the object is an edge cluster
+// Returns the edge cluster resolver and true if the object is an edge cluster, otherwise false
+func (r *nodeResolver) ToEdgeCluster() (edgecluster.EdgeClusterResolverContract, bool) {
+	return r.edgeClusterResolver, r.edgeClusterResolver != nil
+}
+
+// ToEdgeClusterProject returns the edge cluster project resolver if the object is the project of an edge cluster
+// Returns the edge cluster project resolver and true if the object is the project of an edge cluster, otherwise false
+func (r *nodeResolver) ToEdgeClusterProject() (edgecluster.EdgeClusterProjectResolverContract, bool) {
+	return r.edgeClusterProjectResolver, r.edgeClusterProjectResolver != nil
+}
@@ -3,14 +3,15 @@ package project
 
 import (
 	"context"
-	"errors"
 	"strings"
 
+	"github.com/decentralized-cloud/api-gateway/services/dataloader"
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
 	edgeClusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
-	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
 	"github.com/graph-gophers/graphql-go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
@@ -18,6 +19,7 @@ import (
 
 type projectResolver struct {
 	logger                   *zap.Logger
+	globalIDService          globalid.GlobalIDContract
 	resolverCreator          types.ResolverCreatorContract
 	projectID                string
 	projectDetail            *project.ProjectDetail
@@ -28,7 +30,9 @@ type projectResolver struct {
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
 // logger: Mandatory. Reference to the logger service
-// projectClientService: Mandatory. the project client service that creates gRPC connection and client to the project
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
+// dataLoader: Mandatory. the data loader that batches the project lookups made while resolving the request
+// edgeClusterClientService: Mandatory. the edge cluster client service that creates gRPC connection and client to the edge cluster
 // projectID: Mandatory. the project unique identifier
 // projectDetail: Optional. The tennat details, if provided, the value be used instead of contacting  the edge cluster service
 // Returns the new instance or error if something goes wrong
@@ -36,7 +40,8 @@ func NewProjectResolver(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
 	logger *zap.Logger,
-	projectClientService project.ProjectClientContract,
+	globalIDService globalid.GlobalIDContract,
+	dataLoader dataloader.DataLoaderContract,
 	edgeClusterClientService edgecluster.EdgeClusterClientContract,
 	projectID string,
 	projectDetail *project.ProjectDetail) (project.ProjectResolverContract, error) {
@@ -52,8 +57,12 @@ func NewProjectResolver(
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
-	if projectClientService == nil {
-		return nil, commonErrors.NewArgumentNilError("projectClientService", "projectClientService is required")
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
+	if dataLoader == nil {
+		return nil, commonErrors.NewArgumentNilError("dataLoader", "dataLoader is required")
 	}
 
 	if edgeClusterClientService == nil {
@@ -66,37 +75,19 @@ func NewProjectResolver(
 
 	resolver := projectResolver{
 		logger:                   logger,
+		globalIDService:          globalIDService,
 		resolverCreator:          resolverCreator,
 		edgeClusterClientService: edgeClusterClientService,
 		projectID:                projectID,
 	}
 
 	if projectDetail == nil {
-		connection, projectServiceClient, err := projectClientService.CreateClient()
-		if err != nil {
-			return nil, err
-		}
-
-		defer func() {
-			_ = connection.Close()
-		}()
-
-		response, err := projectServiceClient.ReadProject(
-			ctx,
-			&projectGrpcContract.ReadProjectRequest{
-				ProjectID: projectID,
-			})
+		loadedProjectDetail, err := dataLoader.LoadProject(ctx, projectID)
 		if err != nil {
 			return nil, err
 		}
 
-		if response.Error != projectGrpcContract.Error_NO_ERROR {
-			return nil, errors.New(response.ErrorMessage)
-		}
-
-		resolver.projectDetail = &project.ProjectDetail{
-			Project: response.Project,
-		}
+		resolver.projectDetail = loadedProjectDetail
 	} else {
 		resolver.projectDetail = projectDetail
 	}
@@ -108,7 +99,7 @@ func NewProjectResolver(
 // ctx: Mandatory. Reference to the context
 // Returns the project unique identifier
 func (r *projectResolver) ID(ctx context.Context) graphql.ID {
-	return graphql.ID(r.projectID)
+	return r.globalIDService.ToGlobalID(globalid.TypeProject, r.projectID)
 }
 
 // Name returns project name
@@ -125,9 +116,14 @@ func (r *projectResolver) Name(ctx context.Context) string {
 func (r *projectResolver) EdgeCluster(
 	ctx context.Context,
 	args project.ProjectClusterEdgeClusterInputArgument) (edgecluster.EdgeClusterResolverContract, error) {
+	edgeClusterID, err := r.globalIDService.FromGlobalID(args.EdgeClusterID, globalid.TypeEdgeCluster)
+	if err != nil {
+		return nil, err
+	}
+
 	return r.resolverCreator.NewEdgeClusterResolver(
 		ctx,
-		string(args.EdgeClusterID),
+		edgeClusterID,
 		nil)
 }
 
@@ -141,14 +137,7 @@ func (r *projectResolver) EdgeClusters(
 	projectIDs := []string{r.projectID}
 	sortingOptions := []*edgeClusterGrpcContract.SortingOptionPair{}
 
-	connection, edgeClusterServiceClient, err := r.edgeClusterClientService.CreateClient()
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = connection.Close()
-	}()
+	edgeClusterServiceClient := r.edgeClusterClientService.GetClient()
 
 	response, err := edgeClusterServiceClient.ListEdgeClusters(
 		ctx,
@@ -163,11 +152,11 @@ func (r *projectResolver) EdgeClusters(
 			ProjectIDs:     projectIDs,
 		})
 	if err != nil {
-		return nil, err
+		return nil, errortranslation.FromError(err)
 	}
 
-	if response.Error != edgeClusterGrpcContract.Error_NO_ERROR {
-		return nil, errors.New(response.ErrorMessage)
+	if err := errortranslation.FromEdgeClusterError(response.Error, response.ErrorMessage); err != nil {
+		return nil, err
 	}
 
 	return r.resolverCreator.NewEdgeClusterTypeConnectionResolver(
@@ -3,9 +3,10 @@ package query
 
 import (
 	"context"
-	"errors"
 	"strings"
 
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
@@ -19,6 +20,7 @@ import (
 
 type userResolver struct {
 	logger                   *zap.Logger
+	globalIDService          globalid.GlobalIDContract
 	resolverCreator          types.ResolverCreatorContract
 	userID                   string
 	projectClientService     project.ProjectClientContract
@@ -29,6 +31,7 @@ type userResolver struct {
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
 // logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
 // userID: Mandatory. the project unique identifier
 // projectClientService: Mandatory. the project client service that creates gRPC connection and client to the project
 // Returns the new instance or error if something goes wrong
@@ -36,6 +39,7 @@ func NewUserResolver(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
 	logger *zap.Logger,
+	globalIDService globalid.GlobalIDContract,
 	userID string,
 	projectClientService project.ProjectClientContract,
 	edgeClusterClientService edgecluster.EdgeClusterClientContract) (types.UserResolverContract, error) {
@@ -51,6 +55,10 @@ func NewUserResolver(
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
 	if strings.Trim(userID, " ") == "" {
 		return nil, commonErrors.NewArgumentError("userID", "userID is required")
 	}
@@ -65,6 +73,7 @@ func NewUserResolver(
 
 	return &userResolver{
 		logger:                   logger,
+		globalIDService:          globalIDService,
 		resolverCreator:          resolverCreator,
 		userID:                   userID,
 		projectClientService:     projectClientService,
@@ -76,7 +85,7 @@ func NewUserResolver(
 // ctx: Mandatory. Reference to the context
 // Returns the user unique identifier
 func (r *userResolver) ID(ctx context.Context) graphql.ID {
-	return graphql.ID(r.userID)
+	return r.globalIDService.ToGlobalID(globalid.TypeUser, r.userID)
 }
 
 // Project returns project resolver
@@ -86,9 +95,14 @@ func (r *userResolver) ID(ctx context.Context) graphql.ID {
 func (r *userResolver) Project(
 	ctx context.Context,
 	args types.UserProjectInputArgument) (project.ProjectResolverContract, error) {
+	projectID, err := r.globalIDService.FromGlobalID(args.ProjectID, globalid.TypeProject, globalid.TypeEdgeClusterProject)
+	if err != nil {
+		return nil, err
+	}
+
 	return r.resolverCreator.NewProjectResolver(
 		ctx,
-		string(args.ProjectID),
+		projectID,
 		nil)
 }
 
@@ -141,19 +155,13 @@ func (r *userResolver) Projects(
 
 	projectIDs := []string{}
 	if args.ProjectIDs != nil {
-		projectIDs = funk.Map(*args.ProjectIDs, func(projectID graphql.ID) string {
-			return string(projectID)
-		}).([]string)
+		var err error
+		if projectIDs, err = r.globalIDService.FromGlobalIDs(*args.ProjectIDs, globalid.TypeProject, globalid.TypeEdgeClusterProject); err != nil {
+			return nil, err
+		}
 	}
 
-	connection, projectServiceClient, err := r.projectClientService.CreateClient()
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = connection.Close()
-	}()
+	projectServiceClient := r.projectClientService.GetClient()
 
 	response, err := projectServiceClient.ListProjects(
 		ctx,
@@ -163,11 +171,11 @@ func (r *userResolver) Projects(
 			ProjectIDs:     projectIDs,
 		})
 	if err != nil {
-		return nil, err
+		return nil, errortranslation.FromError(err)
 	}
 
-	if response.Error != projectGrpcContract.Error_NO_ERROR {
-		return nil, errors.New(response.ErrorMessage)
+	if err := errortranslation.FromProjectError(response.Error, response.ErrorMessage); err != nil {
+		return nil, err
 	}
 
 	return r.resolverCreator.NewProjectTypeConnectionResolver(
@@ -186,9 +194,14 @@ func (r *userResolver) Projects(
 func (r *userResolver) EdgeCluster(
 	ctx context.Context,
 	args types.UserEdgeClusterInputArgument) (edgecluster.EdgeClusterResolverContract, error) {
+	edgeClusterID, err := r.globalIDService.FromGlobalID(args.EdgeClusterID, globalid.TypeEdgeCluster)
+	if err != nil {
+		return nil, err
+	}
+
 	return r.resolverCreator.NewEdgeClusterResolver(
 		ctx,
-		string(args.EdgeClusterID),
+		edgeClusterID,
 		nil)
 }
 
@@ -240,26 +253,21 @@ func (r *userResolver) EdgeClusters(
 
 	projectIDs := []string{}
 	if args.ProjectIDs != nil {
-		projectIDs = funk.Map(*args.ProjectIDs, func(projectID graphql.ID) string {
-			return string(projectID)
-		}).([]string)
+		var err error
+		if projectIDs, err = r.globalIDService.FromGlobalIDs(*args.ProjectIDs, globalid.TypeProject, globalid.TypeEdgeClusterProject); err != nil {
+			return nil, err
+		}
 	}
 
 	edgeClusterIDs := []string{}
 	if args.EdgeClusterIDs != nil {
-		edgeClusterIDs = funk.Map(*args.EdgeClusterIDs, func(edgeClusterIDs graphql.ID) string {
-			return string(edgeClusterIDs)
-		}).([]string)
+		var err error
+		if edgeClusterIDs, err = r.globalIDService.FromGlobalIDs(*args.EdgeClusterIDs, globalid.TypeEdgeCluster); err != nil {
+			return nil, err
+		}
 	}
 
-	connection, edgeClusterServiceClient, err := r.edgeClusterClientService.CreateClient()
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = connection.Close()
-	}()
+	edgeClusterServiceClient := r.edgeClusterClientService.GetClient()
 
 	response, err := edgeClusterServiceClient.ListEdgeClusters(
 		ctx,
@@ -270,11 +278,11 @@ func (r *userResolver) EdgeClusters(
 			ProjectIDs:     projectIDs,
 		})
 	if err != nil {
-		return nil, err
+		return nil, errortranslation.FromError(err)
 	}
 
-	if response.Error != edgeClusterGrpcContract.Error_NO_ERROR {
-		return nil, errors.New(response.ErrorMessage)
+	if err := errortranslation.FromEdgeClusterError(response.Error, response.ErrorMessage); err != nil {
+		return nil, err
 	}
 
 	return r.resolverCreator.NewEdgeClusterTypeConnectionResolver(
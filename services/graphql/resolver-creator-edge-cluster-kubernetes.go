@@ -0,0 +1,81 @@
+// Package graphql implements functions to expose api-gateway service endpoint using GraphQL protocol.
+package graphql
+
+import (
+	"context"
+
+	queryedgecluster "github.com/decentralized-cloud/api-gateway/services/graphql/query/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewEdgeClusterNamespaceResolver creates new instance of the NamespaceResolverContract, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// namespace: Mandatory. Contains information about the edge cluster namespace
+// Returns the new instance or error if something goes wrong
+func (creator *resolverCreator) NewEdgeClusterNamespaceResolver(
+	ctx context.Context,
+	namespace *corev1.Namespace) (edgecluster.NamespaceResolverContract, error) {
+	return queryedgecluster.NewEdgeClusterNamespaceResolver(
+		ctx,
+		creator.logger,
+		creator,
+		namespace)
+}
+
+// NewEdgeClusterDeploymentResolver creates new instance of the DeploymentResolverContract, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// deployment: Mandatory. Contains information about the edge cluster deployment
+// Returns the new instance or error if something goes wrong
+func (creator *resolverCreator) NewEdgeClusterDeploymentResolver(
+	ctx context.Context,
+	deployment *appsv1.Deployment) (edgecluster.DeploymentResolverContract, error) {
+	return queryedgecluster.NewEdgeClusterDeploymentResolver(
+		ctx,
+		creator.logger,
+		creator,
+		deployment)
+}
+
+// NewEdgeClusterEventResolver creates new instance of the EventResolverContract, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// event: Mandatory. Contains information about the edge cluster event
+// Returns the new instance or error if something goes wrong
+func (creator *resolverCreator) NewEdgeClusterEventResolver(
+	ctx context.Context,
+	event *corev1.Event) (edgecluster.EventResolverContract, error) {
+	return queryedgecluster.NewEdgeClusterEventResolver(
+		ctx,
+		creator.logger,
+		creator,
+		event)
+}
+
+// NewObjectReferenceResolver creates new instance of the ObjectReferenceResolverContract, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// objectReference: Mandatory. Contains enough information to identify the referred edge cluster object
+// Returns the new instance or error if something goes wrong
+func (creator *resolverCreator) NewObjectReferenceResolver(
+	ctx context.Context,
+	objectReference *corev1.ObjectReference) (edgecluster.ObjectReferenceResolverContract, error) {
+	return queryedgecluster.NewObjectReferenceResolver(
+		ctx,
+		creator.logger,
+		objectReference)
+}
+
+// NewEdgeClusterConfigMapResolver creates new instance of the ConfigMapResolverContract, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// configMap: Mandatory. Contains the metadata of the edge cluster config map
+// Returns the new instance or error if something goes wrong
+func (creator *resolverCreator) NewEdgeClusterConfigMapResolver(
+	ctx context.Context,
+	configMap *metav1.PartialObjectMetadata) (edgecluster.ConfigMapResolverContract, error) {
+	return queryedgecluster.NewEdgeClusterConfigMapResolver(
+		ctx,
+		creator.logger,
+		creator,
+		configMap)
+}
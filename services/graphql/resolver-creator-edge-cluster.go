@@ -22,7 +22,11 @@ func (creator *resolverCreator) NewEdgeClusterResolver(
 		ctx,
 		creator,
 		creator.logger,
+		creator.globalIDService,
+		creator.getDataLoader(ctx),
 		creator.edgeClusterClientService,
+		creator.fieldAuthorizationService,
+		creator.kubeClientService,
 		edgeClusterID,
 		edgeClusterDetail)
 }
@@ -79,6 +83,8 @@ func (creator *resolverCreator) NewEdgeClusterProjectResolver(
 		ctx,
 		creator,
 		creator.logger,
+		creator.globalIDService,
+		creator.getDataLoader(ctx),
 		projectID)
 }
 
@@ -93,5 +99,6 @@ func (creator *resolverCreator) NewProvisionDetailsResolver(
 		ctx,
 		creator.logger,
 		creator,
+		creator.fieldAuthorizationService,
 		provisionDetails)
 }
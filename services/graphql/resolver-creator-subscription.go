@@ -0,0 +1,35 @@
+// Package graphql implements functions to expose api-gateway service endpoint using GraphQL protocol.
+package graphql
+
+import (
+	"context"
+
+	subscriptionedgecluster "github.com/decentralized-cloud/api-gateway/services/graphql/subscription/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+)
+
+// NewEdgeClusterChanged creates new instance of the EdgeClusterChangedContract, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// Returns the new instance or error if something goes wrong
+func (creator *resolverCreator) NewEdgeClusterChanged(ctx context.Context) (edgecluster.EdgeClusterChangedContract, error) {
+	return subscriptionedgecluster.NewEdgeClusterChanged(
+		ctx,
+		creator,
+		creator.logger,
+		creator.globalIDService,
+		creator.edgeClusterClientService,
+		creator.subscriptionPollInterval)
+}
+
+// NewEdgeClusterNodesChanged creates new instance of the EdgeClusterNodesChangedContract, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// Returns the new instance or error if something goes wrong
+func (creator *resolverCreator) NewEdgeClusterNodesChanged(ctx context.Context) (edgecluster.EdgeClusterNodesChangedContract, error) {
+	return subscriptionedgecluster.NewEdgeClusterNodesChanged(
+		ctx,
+		creator,
+		creator.logger,
+		creator.globalIDService,
+		creator.edgeClusterClientService,
+		creator.subscriptionPollInterval)
+}
@@ -3,7 +3,13 @@ package graphql
 
 import (
 	"context"
+	"time"
 
+	"github.com/decentralized-cloud/api-gateway/services/audit"
+	"github.com/decentralized-cloud/api-gateway/services/authorization"
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"github.com/decentralized-cloud/api-gateway/services/dataloader"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
 	mutationedgecluster "github.com/decentralized-cloud/api-gateway/services/graphql/mutation/edgecluster"
 	mutationproject "github.com/decentralized-cloud/api-gateway/services/graphql/mutation/project"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/query"
@@ -14,30 +20,57 @@ import (
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/relay"
+	"github.com/decentralized-cloud/api-gateway/services/kubeclient"
 	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
+	"github.com/graph-gophers/graphql-go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
 )
 
 type resolverCreator struct {
-	logger                   *zap.Logger
-	projectClientService     project.ProjectClientContract
-	edgeClusterClientService edgecluster.EdgeClusterClientContract
+	logger                      *zap.Logger
+	projectClientService        project.ProjectClientContract
+	edgeClusterClientService    edgecluster.EdgeClusterClientContract
+	dataLoaderFactory           dataloader.DataLoaderFactoryContract
+	fieldAuthorizationService   authorization.FieldAuthorizationContract
+	projectAuthorizationService authorization.ProjectAuthorizationContract
+	globalIDService             globalid.GlobalIDContract
+	auditService                audit.AuditContract
+	kubeClientService           kubeclient.KubeClientContract
+	subscriptionPollInterval    time.Duration
 }
 
 // NewResolverCreator creates new instance of the resolverCreator, setting up all dependencies and returns the instance
 // logger: Mandatory. Reference to the logger service
 // configurationService: Mandatory. Reference to the configuration service
 // projectClientService: Mandatory. the project client service that creates gRPC connection and client to the project
+// edgeClusterClientService: Mandatory. the edge cluster client service that creates gRPC connection and client to the edge cluster
+// dataLoaderFactory: Mandatory. the factory that creates the data loaders used when no data loader is attached to the request context
+// fieldAuthorizationService: Mandatory. the service that authorizes the access to the GraphQL fields
+// projectAuthorizationService: Mandatory. the service that authorizes the mutations on the projects and their edge clusters
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
+// auditService: Mandatory. the service that records the audit events of the mutations
+// kubeClientService: Mandatory. the service that creates the clients of the edge cluster Kubernetes API
 // Returns the new instance or error if something goes wrong
 func NewResolverCreator(
 	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
 	projectClientService project.ProjectClientContract,
-	edgeClusterClientService edgecluster.EdgeClusterClientContract) (types.ResolverCreatorContract, error) {
+	edgeClusterClientService edgecluster.EdgeClusterClientContract,
+	dataLoaderFactory dataloader.DataLoaderFactoryContract,
+	fieldAuthorizationService authorization.FieldAuthorizationContract,
+	projectAuthorizationService authorization.ProjectAuthorizationContract,
+	globalIDService globalid.GlobalIDContract,
+	auditService audit.AuditContract,
+	kubeClientService kubeclient.KubeClientContract) (types.ResolverCreatorContract, error) {
 	if logger == nil {
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
 	if projectClientService == nil {
 		return nil, commonErrors.NewArgumentNilError("projectClientService", "projectClientService is required")
 	}
@@ -46,13 +79,59 @@ func NewResolverCreator(
 		return nil, commonErrors.NewArgumentNilError("edgeClusterClientService", "edgeClusterClientService is required")
 	}
 
+	if dataLoaderFactory == nil {
+		return nil, commonErrors.NewArgumentNilError("dataLoaderFactory", "dataLoaderFactory is required")
+	}
+
+	if fieldAuthorizationService == nil {
+		return nil, commonErrors.NewArgumentNilError("fieldAuthorizationService", "fieldAuthorizationService is required")
+	}
+
+	if projectAuthorizationService == nil {
+		return nil, commonErrors.NewArgumentNilError("projectAuthorizationService", "projectAuthorizationService is required")
+	}
+
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
+	if auditService == nil {
+		return nil, commonErrors.NewArgumentNilError("auditService", "auditService is required")
+	}
+
+	if kubeClientService == nil {
+		return nil, commonErrors.NewArgumentNilError("kubeClientService", "kubeClientService is required")
+	}
+
+	subscriptionPollInterval, err := configurationService.GetSubscriptionPollInterval()
+	if err != nil {
+		return nil, err
+	}
+
 	return &resolverCreator{
-		logger:                   logger,
-		projectClientService:     projectClientService,
-		edgeClusterClientService: edgeClusterClientService,
+		logger:                      logger,
+		projectClientService:        projectClientService,
+		edgeClusterClientService:    edgeClusterClientService,
+		dataLoaderFactory:           dataLoaderFactory,
+		fieldAuthorizationService:   fieldAuthorizationService,
+		projectAuthorizationService: projectAuthorizationService,
+		globalIDService:             globalIDService,
+		auditService:                auditService,
+		kubeClientService:           kubeClientService,
+		subscriptionPollInterval:    subscriptionPollInterval,
 	}, nil
 }
 
+// getDataLoader returns the data loader attached to the request context. A new data loader is created if the
+// context does not carry one, e.g. when resolving the events of a long running subscription.
+func (creator *resolverCreator) getDataLoader(ctx context.Context) dataloader.DataLoaderContract {
+	if dataLoader, ok := dataloader.FromContext(ctx); ok {
+		return dataLoader
+	}
+
+	return creator.dataLoaderFactory.NewDataLoader()
+}
+
 // NewPageInfoResolver creates new PageInfoResolverContract and returns it
 // ctx: Mandatory. Reference to the context
 // startCursor: Mandatory. Reference to the start cursor
@@ -80,7 +159,8 @@ func (creator *resolverCreator) NewRootResolver(ctx context.Context) (types.Root
 	return root.NewRootResolver(
 		ctx,
 		creator,
-		creator.logger)
+		creator.logger,
+		creator.auditService)
 }
 
 // NewUserResolver creates new UserResolverContract and returns it
@@ -94,11 +174,27 @@ func (creator *resolverCreator) NewUserResolver(
 		ctx,
 		creator,
 		creator.logger,
+		creator.globalIDService,
 		userID,
 		creator.projectClientService,
 		creator.edgeClusterClientService)
 }
 
+// NewNodeResolver creates new NodeResolverContract for the object with the given global identifier and returns it
+// ctx: Mandatory. Reference to the context
+// id: Mandatory. The object global identifier
+// Returns the NodeResolverContract, nil if the object does not exist, or error if something goes wrong
+func (creator *resolverCreator) NewNodeResolver(
+	ctx context.Context,
+	id graphql.ID) (types.NodeResolverContract, error) {
+	return query.NewNodeResolver(
+		ctx,
+		creator,
+		creator.logger,
+		creator.globalIDService,
+		id)
+}
+
 // NewProjectResolver creates new ProjectResolverContract and returns it
 // ctx: Mandatory. Reference to the context
 // projectID: Mandatory. The project unique identifier
@@ -112,7 +208,8 @@ func (creator *resolverCreator) NewProjectResolver(
 		ctx,
 		creator,
 		creator.logger,
-		creator.projectClientService,
+		creator.globalIDService,
+		creator.getDataLoader(ctx),
 		creator.edgeClusterClientService,
 		projectID,
 		projectDetail)
@@ -167,7 +264,8 @@ func (creator *resolverCreator) NewCreateProject(ctx context.Context) (project.C
 		ctx,
 		creator,
 		creator.logger,
-		creator.projectClientService)
+		creator.projectClientService,
+		creator.projectAuthorizationService)
 }
 
 // NewCreateProjectPayloadResolver creates new instance of the createProjectPayloadResolver, setting up all dependencies and returns the instance
@@ -200,7 +298,9 @@ func (creator *resolverCreator) NewUpdateProject(ctx context.Context) (project.U
 		ctx,
 		creator,
 		creator.logger,
-		creator.projectClientService)
+		creator.globalIDService,
+		creator.projectClientService,
+		creator.projectAuthorizationService)
 }
 
 // NewUpdateProjectPayloadResolver creates new instance of the updateProjectPayloadResolver, setting up all dependencies and returns the instance
@@ -233,7 +333,9 @@ func (creator *resolverCreator) NewDeleteProject(ctx context.Context) (project.D
 		ctx,
 		creator,
 		creator.logger,
-		creator.projectClientService)
+		creator.globalIDService,
+		creator.projectClientService,
+		creator.projectAuthorizationService)
 }
 
 // NewDeleteProjectPayloadResolver creates new instance of the deleteProjectPayloadResolver, setting up all dependencies and returns the instance
@@ -248,6 +350,7 @@ func (creator *resolverCreator) NewDeleteProjectPayloadResolver(
 	return mutationproject.NewDeleteProjectPayloadResolver(
 		ctx,
 		creator,
+		creator.globalIDService,
 		projectID,
 		clientMutationId)
 }
@@ -260,7 +363,9 @@ func (creator *resolverCreator) NewCreateEdgeCluster(ctx context.Context) (edgec
 		ctx,
 		creator,
 		creator.logger,
-		creator.edgeClusterClientService)
+		creator.globalIDService,
+		creator.edgeClusterClientService,
+		creator.projectAuthorizationService)
 }
 
 // NewCreateEdgeClusterPayloadResolver creates new instance of the createEdgeClusterPayloadResolver, setting up all dependencies and returns the instance
@@ -293,7 +398,10 @@ func (creator *resolverCreator) NewUpdateEdgeCluster(ctx context.Context) (edgec
 		ctx,
 		creator,
 		creator.logger,
-		creator.edgeClusterClientService)
+		creator.globalIDService,
+		creator.edgeClusterClientService,
+		creator.getDataLoader(ctx),
+		creator.projectAuthorizationService)
 }
 
 // NewUpdateEdgeClusterPayloadResolver creates new instance of the updateEdgeClusterPayloadResolver, setting up all dependencies and returns the instance
@@ -326,7 +434,10 @@ func (creator *resolverCreator) NewDeleteEdgeCluster(ctx context.Context) (edgec
 		ctx,
 		creator,
 		creator.logger,
-		creator.edgeClusterClientService)
+		creator.globalIDService,
+		creator.edgeClusterClientService,
+		creator.getDataLoader(ctx),
+		creator.projectAuthorizationService)
 }
 
 // NewDeleteEdgeClusterPayloadResolver creates new instance of the deleteEdgeClusterPayloadResolver, setting up all dependencies and returns the instance
@@ -341,6 +452,7 @@ func (creator *resolverCreator) NewDeleteEdgeClusterPayloadResolver(
 	return mutationedgecluster.NewDeleteEdgeClusterPayloadResolver(
 		ctx,
 		creator,
+		creator.globalIDService,
 		edgeClusterID,
 		clientMutationId)
 }
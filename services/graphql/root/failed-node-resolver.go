@@ -0,0 +1,48 @@
+// Package root implements GraphQL root resolvers required by the GraphQL transport layer
+package root
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// failedNodeResolver stands for an entry of the nodes field whose object failed to load. Resolving its id reports the
+// error, and as the id is not nullable the entry resolves to null.
+type failedNodeResolver struct {
+	err error
+}
+
+// ID returns the error the object failed to load with
+// ctx: Mandatory. Reference to the context
+// Returns the error the object failed to load with
+func (r *failedNodeResolver) ID(ctx context.Context) (graphql.ID, error) {
+	return "", r.err
+}
+
+// ToUser returns false as the type of the object is not known
+// Returns nil and false
+func (r *failedNodeResolver) ToUser() (types.UserResolverContract, bool) {
+	return nil, false
+}
+
+// ToProject returns false as the type of the object is not known
+// Returns nil and false
+func (r *failedNodeResolver) ToProject() (project.ProjectResolverContract, bool) {
+	return nil, false
+}
+
+// ToEdgeCluster returns false as the type of the object is not known
+// Returns nil and false
+func (r *failedNodeResolver) ToEdgeCluster() (edgecluster.EdgeClusterResolverContract, bool) {
+	return nil, false
+}
+
+// ToEdgeClusterProject returns false as the type of the object is not known
+// Returns nil and false
+func (r *failedNodeResolver) ToEdgeClusterProject() (edgecluster.EdgeClusterProjectResolverContract, bool) {
+	return nil, false
+}
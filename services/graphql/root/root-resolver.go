@@ -3,28 +3,38 @@ package root
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"github.com/decentralized-cloud/api-gateway/services/audit"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/graph-gophers/graphql-go"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type rootResolver struct {
 	logger          *zap.Logger
 	resolverCreator types.ResolverCreatorContract
+	auditService    audit.AuditContract
 }
 
 // NewRootResolver creates new instance of the rootResolver, setting up all dependencies and returns the instance
 // ctx: Mandatory. Reference to the context
 // resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
 // logger: Mandatory. Reference to the logger service
+// auditService: Mandatory. Reference to the service that records the audit events of the mutations
 // Returns the new instance or error if something goes wrong
 func NewRootResolver(
 	ctx context.Context,
 	resolverCreator types.ResolverCreatorContract,
-	logger *zap.Logger) (types.RootResolverContract, error) {
+	logger *zap.Logger,
+	auditService audit.AuditContract) (types.RootResolverContract, error) {
 	if ctx == nil {
 		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
 	}
@@ -37,9 +47,14 @@ func NewRootResolver(
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if auditService == nil {
+		return nil, commonErrors.NewArgumentNilError("auditService", "auditService is required")
+	}
+
 	return &rootResolver{
 		logger:          logger,
 		resolverCreator: resolverCreator,
+		auditService:    auditService,
 	}, nil
 }
 
@@ -47,16 +62,75 @@ func NewRootResolver(
 // ctx: Mandatory. Reference to the context
 // Returns the user resolver or error if something goes wrong
 func (r *rootResolver) User(ctx context.Context) (types.UserResolverContract, error) {
-	// TODO: mortezaalizadeh, 16/11/2019 user ID hard coded until we have authentication flow implemented
-	return r.resolverCreator.NewUserResolver(ctx, "ck30lptw000060133f1rg1uf9")
+	principal, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to find the authenticated principal in the context")
+	}
+
+	return r.resolverCreator.NewUserResolver(ctx, principal.Subject)
+}
+
+// Node returns the resolver of the object with the given global identifier
+// ctx: Mandatory. Reference to the context
+// args: Mandatory. The argument list
+// Returns the node resolver, nil if the object does not exist, or error if something goes wrong
+func (r *rootResolver) Node(
+	ctx context.Context,
+	args types.NodeInputArgument) (types.NodeResolverContract, error) {
+	return r.resolverCreator.NewNodeResolver(ctx, args.ID)
+}
+
+// Nodes returns the resolvers of the objects with the given global identifiers. The objects are resolved concurrently
+// so the data loader fetches the objects of the same type in a single batch. The number of identifiers is bounded by the
+// maximum list size enforced by the query limits. An object that fails to load does not fail the whole list, its entry
+// resolves to null and the error is reported at the id field of the entry.
+// ctx: Mandatory. Reference to the context
+// args: Mandatory. The argument list
+// Returns the node resolvers in the order of the identifiers, nil for the objects that do not exist
+func (r *rootResolver) Nodes(
+	ctx context.Context,
+	args types.NodesInputArgument) ([]types.NodeResolverContract, error) {
+	nodes := make([]types.NodeResolverContract, len(args.IDs))
+
+	var waitGroup sync.WaitGroup
+
+	for index, id := range args.IDs {
+		waitGroup.Add(1)
+
+		go func(index int, id graphql.ID) {
+			defer waitGroup.Done()
+
+			node, err := r.resolverCreator.NewNodeResolver(ctx, id)
+			if err != nil {
+				r.logger.Warn("Failed to resolve the node", zap.String("id", string(id)), zap.Error(err))
+
+				node = &failedNodeResolver{err: err}
+			}
+
+			nodes[index] = node
+		}(index, id)
+	}
+
+	waitGroup.Wait()
+
+	return nodes, nil
 }
 
-// CreateProject returns create project mutator
+// CreateProject returns create project mutator. The mutation attempt is audit logged.
 // ctx: Mandatory. Reference to the context
 // Returns the create project mutator or error if something goes wrong
 func (r *rootResolver) CreateProject(
 	ctx context.Context,
-	args project.CreateProjectInputArgument) (project.CreateProjectPayloadResolverContract, error) {
+	args project.CreateProjectInputArgument) (payload project.CreateProjectPayloadResolverContract, err error) {
+	defer func(start time.Time) {
+		var targetIDs []graphql.ID
+		if err == nil {
+			targetIDs = append(targetIDs, createdProjectID(ctx, payload))
+		}
+
+		r.recordMutation(ctx, "createProject", args.Input, targetIDs, start, err)
+	}(time.Now())
+
 	mutation, err := r.resolverCreator.NewCreateProject(ctx)
 	if err != nil {
 		return nil, err
@@ -65,12 +139,16 @@ func (r *rootResolver) CreateProject(
 	return mutation.MutateAndGetPayload(ctx, args)
 }
 
-// UpdateProject returns update project mutator
+// UpdateProject returns update project mutator. The mutation attempt is audit logged.
 // ctx: Mandatory. Reference to the context
 // Returns the update project mutator or error if something goes wrong
 func (r *rootResolver) UpdateProject(
 	ctx context.Context,
-	args project.UpdateProjectInputArgument) (project.UpdateProjectPayloadResolverContract, error) {
+	args project.UpdateProjectInputArgument) (payload project.UpdateProjectPayloadResolverContract, err error) {
+	defer func(start time.Time) {
+		r.recordMutation(ctx, "updateProject", args.Input, []graphql.ID{args.Input.ProjectID}, start, err)
+	}(time.Now())
+
 	mutation, err := r.resolverCreator.NewUpdateProject(ctx)
 	if err != nil {
 		return nil, err
@@ -79,12 +157,16 @@ func (r *rootResolver) UpdateProject(
 	return mutation.MutateAndGetPayload(ctx, args)
 }
 
-// DeleteProject returns delete project mutator
+// DeleteProject returns delete project mutator. The mutation attempt is audit logged.
 // ctx: Mandatory. Reference to the context
 // Returns the delete project mutator or error if something goes wrong
 func (r *rootResolver) DeleteProject(
 	ctx context.Context,
-	args project.DeleteProjectInputArgument) (project.DeleteProjectPayloadResolverContract, error) {
+	args project.DeleteProjectInputArgument) (payload project.DeleteProjectPayloadResolverContract, err error) {
+	defer func(start time.Time) {
+		r.recordMutation(ctx, "deleteProject", args.Input, []graphql.ID{args.Input.ProjectID}, start, err)
+	}(time.Now())
+
 	mutation, err := r.resolverCreator.NewDeleteProject(ctx)
 	if err != nil {
 		return nil, err
@@ -93,12 +175,21 @@ func (r *rootResolver) DeleteProject(
 	return mutation.MutateAndGetPayload(ctx, args)
 }
 
-// CreateEdgeCluster returns create edge cluster mutator
+// CreateEdgeCluster returns create edge cluster mutator. The mutation attempt is audit logged.
 // ctx: Mandatory. Reference to the context
 // Returns the create edge cluster mutator or error if something goes wrong
 func (r *rootResolver) CreateEdgeCluster(
 	ctx context.Context,
-	args edgecluster.CreateEdgeClusterInputArgument) (edgecluster.CreateEdgeClusterPayloadResolverContract, error) {
+	args edgecluster.CreateEdgeClusterInputArgument) (payload edgecluster.CreateEdgeClusterPayloadResolverContract, err error) {
+	defer func(start time.Time) {
+		targetIDs := []graphql.ID{args.Input.ProjectID}
+		if err == nil {
+			targetIDs = append(targetIDs, createdEdgeClusterID(ctx, payload))
+		}
+
+		r.recordMutation(ctx, "createEdgeCluster", args.Input, targetIDs, start, err)
+	}(time.Now())
+
 	mutation, err := r.resolverCreator.NewCreateEdgeCluster(ctx)
 	if err != nil {
 		return nil, err
@@ -107,12 +198,16 @@ func (r *rootResolver) CreateEdgeCluster(
 	return mutation.MutateAndGetPayload(ctx, args)
 }
 
-// UpdateEdgeCluster returns update edge cluster mutator
+// UpdateEdgeCluster returns update edge cluster mutator. The mutation attempt is audit logged.
 // ctx: Mandatory. Reference to the context
 // Returns the update edge cluster mutator or error if something goes wrong
 func (r *rootResolver) UpdateEdgeCluster(
 	ctx context.Context,
-	args edgecluster.UpdateEdgeClusterInputArgument) (edgecluster.UpdateEdgeClusterPayloadResolverContract, error) {
+	args edgecluster.UpdateEdgeClusterInputArgument) (payload edgecluster.UpdateEdgeClusterPayloadResolverContract, err error) {
+	defer func(start time.Time) {
+		r.recordMutation(ctx, "updateEdgeCluster", args.Input, []graphql.ID{args.Input.EdgeClusterID, args.Input.ProjectID}, start, err)
+	}(time.Now())
+
 	mutation, err := r.resolverCreator.NewUpdateEdgeCluster(ctx)
 	if err != nil {
 		return nil, err
@@ -121,12 +216,16 @@ func (r *rootResolver) UpdateEdgeCluster(
 	return mutation.MutateAndGetPayload(ctx, args)
 }
 
-// DeleteEdgeCluster returns delete edge cluster mutator
+// DeleteEdgeCluster returns delete edge cluster mutator. The mutation attempt is audit logged.
 // ctx: Mandatory. Reference to the context
 // Returns the delete edge cluster mutator or error if something goes wrong
 func (r *rootResolver) DeleteEdgeCluster(
 	ctx context.Context,
-	args edgecluster.DeleteEdgeClusterInputArgument) (edgecluster.DeleteEdgeClusterPayloadResolverContract, error) {
+	args edgecluster.DeleteEdgeClusterInputArgument) (payload edgecluster.DeleteEdgeClusterPayloadResolverContract, err error) {
+	defer func(start time.Time) {
+		r.recordMutation(ctx, "deleteEdgeCluster", args.Input, []graphql.ID{args.Input.EdgeClusterID}, start, err)
+	}(time.Now())
+
 	mutation, err := r.resolverCreator.NewDeleteEdgeCluster(ctx)
 	if err != nil {
 		return nil, err
@@ -134,3 +233,80 @@ func (r *rootResolver) DeleteEdgeCluster(
 
 	return mutation.MutateAndGetPayload(ctx, args)
 }
+
+// EdgeClusterChanged returns the channel that receives the edge cluster every time it changes
+// ctx: Mandatory. Reference to the context
+// Returns the channel that receives the changed edge cluster or error if something goes wrong
+func (r *rootResolver) EdgeClusterChanged(
+	ctx context.Context,
+	args edgecluster.EdgeClusterChangedInputArgument) (<-chan edgecluster.EdgeClusterResolverContract, error) {
+	subscription, err := r.resolverCreator.NewEdgeClusterChanged(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription.Subscribe(ctx, args)
+}
+
+// EdgeClusterNodesChanged returns the channel that receives the edge cluster nodes every time they change
+// ctx: Mandatory. Reference to the context
+// Returns the channel that receives the changed edge cluster nodes or error if something goes wrong
+func (r *rootResolver) EdgeClusterNodesChanged(
+	ctx context.Context,
+	args edgecluster.EdgeClusterNodesChangedInputArgument) (<-chan []edgecluster.NodeResolverContract, error) {
+	subscription, err := r.resolverCreator.NewEdgeClusterNodesChanged(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription.Subscribe(ctx, args)
+}
+
+// recordMutation writes the audit event of the mutation attempt
+func (r *rootResolver) recordMutation(
+	ctx context.Context,
+	operation string,
+	input interface{},
+	targetIDs []graphql.ID,
+	start time.Time,
+	err error) {
+	r.auditService.RecordMutation(ctx, audit.Mutation{
+		Operation: operation,
+		Input:     input,
+		TargetIDs: targetIDs,
+		Latency:   time.Since(start),
+		Err:       err,
+	})
+}
+
+// createdProjectID returns the global identifier of the project created by the mutation. The project is already
+// loaded by the mutation, so no backend call is made.
+func createdProjectID(ctx context.Context, payload project.CreateProjectPayloadResolverContract) graphql.ID {
+	edge, err := payload.Project(ctx)
+	if err != nil {
+		return ""
+	}
+
+	node, err := edge.Node(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return node.ID(ctx)
+}
+
+// createdEdgeClusterID returns the global identifier of the edge cluster created by the mutation. The edge cluster
+// is already loaded by the mutation, so no backend call is made.
+func createdEdgeClusterID(ctx context.Context, payload edgecluster.CreateEdgeClusterPayloadResolverContract) graphql.ID {
+	edge, err := payload.EdgeCluster(ctx)
+	if err != nil {
+		return ""
+	}
+
+	node, err := edge.Node(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return node.ID(ctx)
+}
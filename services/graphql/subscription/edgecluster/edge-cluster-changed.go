@@ -0,0 +1,167 @@
+// Package edgecluster implements edge cluster subscriptions required by the GraphQL transport layer
+package edgecluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	edgeclusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type edgeClusterChanged struct {
+	logger                   *zap.Logger
+	globalIDService          globalid.GlobalIDContract
+	resolverCreator          types.ResolverCreatorContract
+	edgeClusterClientService edgecluster.EdgeClusterClientContract
+	pollInterval             time.Duration
+}
+
+// NewEdgeClusterChanged creates new instance of the edgeClusterChanged, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
+// logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
+// edgeClusterClientService: Mandatory. the edge cluster client service that provides the edge cluster gRPC client
+// pollInterval: Mandatory. The interval the edge cluster service is polled for changes
+// Returns the new instance or error if something goes wrong
+func NewEdgeClusterChanged(
+	ctx context.Context,
+	resolverCreator types.ResolverCreatorContract,
+	logger *zap.Logger,
+	globalIDService globalid.GlobalIDContract,
+	edgeClusterClientService edgecluster.EdgeClusterClientContract,
+	pollInterval time.Duration) (edgecluster.EdgeClusterChangedContract, error) {
+	if ctx == nil {
+		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
+	}
+
+	if resolverCreator == nil {
+		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
+	}
+
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
+	if edgeClusterClientService == nil {
+		return nil, commonErrors.NewArgumentNilError("edgeClusterClientService", "edgeClusterClientService is required")
+	}
+
+	if pollInterval <= 0 {
+		return nil, commonErrors.NewArgumentError("pollInterval", "pollInterval must be greater than zero")
+	}
+
+	return &edgeClusterChanged{
+		logger:                   logger,
+		globalIDService:          globalIDService,
+		resolverCreator:          resolverCreator,
+		edgeClusterClientService: edgeClusterClientService,
+		pollInterval:             pollInterval,
+	}, nil
+}
+
+// Subscribe starts watching the edge cluster and returns the channel that receives the edge cluster every time it changes.
+// The channel is closed when the context is done or the edge cluster is deleted.
+// ctx: Mandatory. Reference to the context
+// args: Mandatory. Reference to the input argument contains the edge cluster to watch
+// Returns the channel that receives the changed edge cluster or error if something goes wrong
+func (s *edgeClusterChanged) Subscribe(
+	ctx context.Context,
+	args edgecluster.EdgeClusterChangedInputArgument) (<-chan edgecluster.EdgeClusterResolverContract, error) {
+	edgeClusterID, err := s.globalIDService.FromGlobalID(args.EdgeClusterID, globalid.TypeEdgeCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	lastResponse, err := s.readEdgeCluster(ctx, edgeClusterID)
+	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	if err := errortranslation.FromEdgeClusterError(lastResponse.Error, lastResponse.ErrorMessage); err != nil {
+		return nil, err
+	}
+
+	changes := make(chan edgecluster.EdgeClusterResolverContract)
+
+	go func() {
+		defer close(changes)
+
+		ticker := time.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			response, err := s.readEdgeCluster(ctx, edgeClusterID)
+			if err != nil {
+				s.logger.Warn("Failed to poll the edge cluster for changes", zap.String("edgeClusterID", edgeClusterID), zap.Error(err))
+
+				continue
+			}
+
+			if response.Error == edgeclusterGrpcContract.Error_EDGE_CLUSTER_NOT_FOUND {
+				return
+			}
+
+			if response.Error != edgeclusterGrpcContract.Error_NO_ERROR {
+				s.logger.Warn("Failed to poll the edge cluster for changes", zap.String("edgeClusterID", edgeClusterID), zap.String("error", response.ErrorMessage))
+
+				continue
+			}
+
+			if proto.Equal(response.EdgeCluster, lastResponse.EdgeCluster) &&
+				proto.Equal(response.ProvisionDetail, lastResponse.ProvisionDetail) {
+				continue
+			}
+
+			lastResponse = response
+
+			resolver, err := s.resolverCreator.NewEdgeClusterResolver(
+				ctx,
+				edgeClusterID,
+				&edgecluster.EdgeClusterDetail{
+					EdgeCluster:      response.EdgeCluster,
+					ProvisionDetails: response.ProvisionDetail,
+				})
+			if err != nil {
+				s.logger.Error("Failed to create the edge cluster resolver", zap.String("edgeClusterID", edgeClusterID), zap.Error(err))
+
+				return
+			}
+
+			select {
+			case changes <- resolver:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+func (s *edgeClusterChanged) readEdgeCluster(
+	ctx context.Context,
+	edgeClusterID string) (*edgeclusterGrpcContract.ReadEdgeClusterResponse, error) {
+	return s.edgeClusterClientService.GetClient().ReadEdgeCluster(
+		ctx,
+		&edgeclusterGrpcContract.ReadEdgeClusterRequest{
+			EdgeClusterID: edgeClusterID,
+		})
+}
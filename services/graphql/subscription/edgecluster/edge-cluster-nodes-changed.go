@@ -0,0 +1,179 @@
+// Package edgecluster implements edge cluster subscriptions required by the GraphQL transport layer
+package edgecluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/errortranslation"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	edgeclusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type edgeClusterNodesChanged struct {
+	logger                   *zap.Logger
+	globalIDService          globalid.GlobalIDContract
+	resolverCreator          types.ResolverCreatorContract
+	edgeClusterClientService edgecluster.EdgeClusterClientContract
+	pollInterval             time.Duration
+}
+
+// NewEdgeClusterNodesChanged creates new instance of the edgeClusterNodesChanged, setting up all dependencies and returns the instance
+// ctx: Mandatory. Reference to the context
+// resolverCreator: Mandatory. Reference to the resolver creator service that can create new instances of resolvers
+// logger: Mandatory. Reference to the logger service
+// globalIDService: Mandatory. the service that converts the backend identifiers to the global identifiers and back
+// edgeClusterClientService: Mandatory. the edge cluster client service that provides the edge cluster gRPC client
+// pollInterval: Mandatory. The interval the edge cluster service is polled for changes
+// Returns the new instance or error if something goes wrong
+func NewEdgeClusterNodesChanged(
+	ctx context.Context,
+	resolverCreator types.ResolverCreatorContract,
+	logger *zap.Logger,
+	globalIDService globalid.GlobalIDContract,
+	edgeClusterClientService edgecluster.EdgeClusterClientContract,
+	pollInterval time.Duration) (edgecluster.EdgeClusterNodesChangedContract, error) {
+	if ctx == nil {
+		return nil, commonErrors.NewArgumentNilError("ctx", "ctx is required")
+	}
+
+	if resolverCreator == nil {
+		return nil, commonErrors.NewArgumentNilError("resolverCreator", "resolverCreator is required")
+	}
+
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
+	if edgeClusterClientService == nil {
+		return nil, commonErrors.NewArgumentNilError("edgeClusterClientService", "edgeClusterClientService is required")
+	}
+
+	if pollInterval <= 0 {
+		return nil, commonErrors.NewArgumentError("pollInterval", "pollInterval must be greater than zero")
+	}
+
+	return &edgeClusterNodesChanged{
+		logger:                   logger,
+		globalIDService:          globalIDService,
+		resolverCreator:          resolverCreator,
+		edgeClusterClientService: edgeClusterClientService,
+		pollInterval:             pollInterval,
+	}, nil
+}
+
+// Subscribe starts watching the edge cluster nodes and returns the channel that receives the nodes every time they change.
+// The channel is closed when the context is done or the edge cluster is deleted.
+// ctx: Mandatory. Reference to the context
+// args: Mandatory. Reference to the input argument contains the edge cluster to watch
+// Returns the channel that receives the changed edge cluster nodes or error if something goes wrong
+func (s *edgeClusterNodesChanged) Subscribe(
+	ctx context.Context,
+	args edgecluster.EdgeClusterNodesChangedInputArgument) (<-chan []edgecluster.NodeResolverContract, error) {
+	edgeClusterID, err := s.globalIDService.FromGlobalID(args.EdgeClusterID, globalid.TypeEdgeCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	lastResponse, err := s.listEdgeClusterNodes(ctx, edgeClusterID)
+	if err != nil {
+		return nil, errortranslation.FromError(err)
+	}
+
+	if err := errortranslation.FromEdgeClusterError(lastResponse.Error, lastResponse.ErrorMessage); err != nil {
+		return nil, err
+	}
+
+	changes := make(chan []edgecluster.NodeResolverContract)
+
+	go func() {
+		defer close(changes)
+
+		ticker := time.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			response, err := s.listEdgeClusterNodes(ctx, edgeClusterID)
+			if err != nil {
+				s.logger.Warn("Failed to poll the edge cluster nodes for changes", zap.String("edgeClusterID", edgeClusterID), zap.Error(err))
+
+				continue
+			}
+
+			if response.Error == edgeclusterGrpcContract.Error_EDGE_CLUSTER_NOT_FOUND {
+				return
+			}
+
+			if response.Error != edgeclusterGrpcContract.Error_NO_ERROR {
+				s.logger.Warn("Failed to poll the edge cluster nodes for changes", zap.String("edgeClusterID", edgeClusterID), zap.String("error", response.ErrorMessage))
+
+				continue
+			}
+
+			if nodesEqual(response.Nodes, lastResponse.Nodes) {
+				continue
+			}
+
+			lastResponse = response
+
+			resolvers := []edgecluster.NodeResolverContract{}
+			for _, node := range response.Nodes {
+				resolver, err := s.resolverCreator.NewEdgeClusterNodeResolver(ctx, node)
+				if err != nil {
+					s.logger.Error("Failed to create the edge cluster node resolver", zap.String("edgeClusterID", edgeClusterID), zap.Error(err))
+
+					return
+				}
+
+				resolvers = append(resolvers, resolver)
+			}
+
+			select {
+			case changes <- resolvers:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+func (s *edgeClusterNodesChanged) listEdgeClusterNodes(
+	ctx context.Context,
+	edgeClusterID string) (*edgeclusterGrpcContract.ListEdgeClusterNodesResponse, error) {
+	return s.edgeClusterClientService.GetClient().ListEdgeClusterNodes(
+		ctx,
+		&edgeclusterGrpcContract.ListEdgeClusterNodesRequest{
+			EdgeClusterID: edgeClusterID,
+		})
+}
+
+func nodesEqual(first, second []*edgeclusterGrpcContract.EdgeClusterNode) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for idx := range first {
+		if !proto.Equal(first[idx], second[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
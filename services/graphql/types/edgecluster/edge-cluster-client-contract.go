@@ -2,14 +2,24 @@
 package edgecluster
 
 import (
+	"context"
+
 	edgeClusterGrpcContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
-	"google.golang.org/grpc"
 )
 
 // EdgeClusterClientContract wraps the edge cluser gRPC client to make it easy for testing
 type EdgeClusterClientContract interface {
-	// CreateClient creats a new edge cluster gRPC client and returns the connection
-	// and the client to the caller.
-	// Returns connection and the edge cluster gRPC client or error if something goes wrong.
-	CreateClient() (*grpc.ClientConn, edgeClusterGrpcContract.ServiceClient, error)
+	// GetClient returns the edge cluster gRPC client that uses the long-lived connection shared
+	// between all callers.
+	// Returns the edge cluster gRPC client.
+	GetClient() edgeClusterGrpcContract.ServiceClient
+
+	// CheckHealth queries the standard gRPC health service of the edge cluster service.
+	// ctx: Mandatory. Reference to the context
+	// Returns error if the edge cluster service is not serving or something goes wrong.
+	CheckHealth(ctx context.Context) error
+
+	// Close closes the shared connection to the edge cluster service.
+	// Returns error if something goes wrong.
+	Close() error
 }
@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/graphql/types/edgecluster/edge-cluster-client-contract.go
+
+// Package mock_edgecluster is a generated GoMock package.
+package mock_edgecluster
+
+import (
+	context "context"
+	reflect "reflect"
+
+	edgecluster "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEdgeClusterClientContract is a mock of EdgeClusterClientContract interface.
+type MockEdgeClusterClientContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockEdgeClusterClientContractMockRecorder
+}
+
+// MockEdgeClusterClientContractMockRecorder is the mock recorder for MockEdgeClusterClientContract.
+type MockEdgeClusterClientContractMockRecorder struct {
+	mock *MockEdgeClusterClientContract
+}
+
+// NewMockEdgeClusterClientContract creates a new mock instance.
+func NewMockEdgeClusterClientContract(ctrl *gomock.Controller) *MockEdgeClusterClientContract {
+	mock := &MockEdgeClusterClientContract{ctrl: ctrl}
+	mock.recorder = &MockEdgeClusterClientContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEdgeClusterClientContract) EXPECT() *MockEdgeClusterClientContractMockRecorder {
+	return m.recorder
+}
+
+// CheckHealth mocks base method.
+func (m *MockEdgeClusterClientContract) CheckHealth(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckHealth", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckHealth indicates an expected call of CheckHealth.
+func (mr *MockEdgeClusterClientContractMockRecorder) CheckHealth(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockEdgeClusterClientContract)(nil).CheckHealth), ctx)
+}
+
+// Close mocks base method.
+func (m *MockEdgeClusterClientContract) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockEdgeClusterClientContractMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockEdgeClusterClientContract)(nil).Close))
+}
+
+// GetClient mocks base method.
+func (m *MockEdgeClusterClientContract) GetClient() edgecluster.ServiceClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClient")
+	ret0, _ := ret[0].(edgecluster.ServiceClient)
+	return ret0
+}
+
+// GetClient indicates an expected call of GetClient.
+func (mr *MockEdgeClusterClientContractMockRecorder) GetClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockEdgeClusterClientContract)(nil).GetClient))
+}
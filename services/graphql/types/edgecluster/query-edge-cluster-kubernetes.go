@@ -0,0 +1,162 @@
+// packae edgecluster implements used edge cluster related types in the GraphQL transport layer
+package edgecluster
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type EdgeClusterKubernetesResolverCreatorContract interface {
+	// NewEdgeClusterNamespaceResolver creates new instance of the NamespaceResolverContract, setting up all dependencies and returns the instance
+	// ctx: Mandatory. Reference to the context
+	// namespace: Mandatory. Contains information about the edge cluster namespace
+	// Returns the new instance or error if something goes wrong
+	NewEdgeClusterNamespaceResolver(
+		ctx context.Context,
+		namespace *corev1.Namespace) (NamespaceResolverContract, error)
+
+	// NewEdgeClusterDeploymentResolver creates new instance of the DeploymentResolverContract, setting up all dependencies and returns the instance
+	// ctx: Mandatory. Reference to the context
+	// deployment: Mandatory. Contains information about the edge cluster deployment
+	// Returns the new instance or error if something goes wrong
+	NewEdgeClusterDeploymentResolver(
+		ctx context.Context,
+		deployment *appsv1.Deployment) (DeploymentResolverContract, error)
+
+	// NewEdgeClusterEventResolver creates new instance of the EventResolverContract, setting up all dependencies and returns the instance
+	// ctx: Mandatory. Reference to the context
+	// event: Mandatory. Contains information about the edge cluster event
+	// Returns the new instance or error if something goes wrong
+	NewEdgeClusterEventResolver(
+		ctx context.Context,
+		event *corev1.Event) (EventResolverContract, error)
+
+	// NewObjectReferenceResolver creates new instance of the ObjectReferenceResolverContract, setting up all dependencies and returns the instance
+	// ctx: Mandatory. Reference to the context
+	// objectReference: Mandatory. Contains enough information to identify the referred edge cluster object
+	// Returns the new instance or error if something goes wrong
+	NewObjectReferenceResolver(
+		ctx context.Context,
+		objectReference *corev1.ObjectReference) (ObjectReferenceResolverContract, error)
+
+	// NewEdgeClusterConfigMapResolver creates new instance of the ConfigMapResolverContract, setting up all dependencies and returns the instance
+	// ctx: Mandatory. Reference to the context
+	// configMap: Mandatory. Contains the metadata of the edge cluster config map
+	// Returns the new instance or error if something goes wrong
+	NewEdgeClusterConfigMapResolver(
+		ctx context.Context,
+		configMap *metav1.PartialObjectMetadata) (ConfigMapResolverContract, error)
+}
+
+// NamespaceResolverContract declares the resolver that contains information about the edge cluster namespace
+type NamespaceResolverContract interface {
+	// Metadata contains the namespace metadata
+	// ctx: Mandatory. Reference to the context
+	// Returns the namespace metadata resolver or error if something goes wrong.
+	Metadata(ctx context.Context) (ObjectMetaResolverContract, error)
+
+	// Phase returns the current lifecycle phase of the namespace
+	// ctx: Mandatory. Reference to the context
+	// Returns the current lifecycle phase of the namespace
+	Phase(ctx context.Context) string
+}
+
+// DeploymentResolverContract declares the resolver that contains information about the edge cluster deployment
+type DeploymentResolverContract interface {
+	// Metadata contains the deployment metadata
+	// ctx: Mandatory. Reference to the context
+	// Returns the deployment metadata resolver or error if something goes wrong.
+	Metadata(ctx context.Context) (ObjectMetaResolverContract, error)
+
+	// Replicas returns the number of desired pods
+	// ctx: Mandatory. Reference to the context
+	// Returns the number of desired pods
+	Replicas(ctx context.Context) int32
+
+	// ReadyReplicas returns the number of pods targeted by the deployment with a ready condition
+	// ctx: Mandatory. Reference to the context
+	// Returns the number of pods targeted by the deployment with a ready condition
+	ReadyReplicas(ctx context.Context) int32
+
+	// AvailableReplicas returns the number of pods targeted by the deployment that are available
+	// ctx: Mandatory. Reference to the context
+	// Returns the number of pods targeted by the deployment that are available
+	AvailableReplicas(ctx context.Context) int32
+
+	// UpdatedReplicas returns the number of pods targeted by the deployment that have the desired template spec
+	// ctx: Mandatory. Reference to the context
+	// Returns the number of pods targeted by the deployment that have the desired template spec
+	UpdatedReplicas(ctx context.Context) int32
+}
+
+// EventResolverContract declares the resolver that contains information about the edge cluster event
+type EventResolverContract interface {
+	// Metadata contains the event metadata
+	// ctx: Mandatory. Reference to the context
+	// Returns the event metadata resolver or error if something goes wrong.
+	Metadata(ctx context.Context) (ObjectMetaResolverContract, error)
+
+	// Type returns the type of the event
+	// ctx: Mandatory. Reference to the context
+	// Returns the type of the event
+	Type(ctx context.Context) string
+
+	// Reason returns the unique, one-word, CamelCase reason for the event
+	// ctx: Mandatory. Reference to the context
+	// Returns the unique, one-word, CamelCase reason for the event
+	Reason(ctx context.Context) string
+
+	// Message returns the human-readable description of the event
+	// ctx: Mandatory. Reference to the context
+	// Returns the human-readable description of the event
+	Message(ctx context.Context) string
+
+	// InvolvedObject returns the object the event is about
+	// ctx: Mandatory. Reference to the context
+	// Returns the object reference resolver or error if something goes wrong.
+	InvolvedObject(ctx context.Context) (ObjectReferenceResolverContract, error)
+
+	// Count returns the number of times the event has occurred
+	// ctx: Mandatory. Reference to the context
+	// Returns the number of times the event has occurred
+	Count(ctx context.Context) int32
+
+	// LastTimestamp returns the time the most recent occurrence of the event was recorded
+	// ctx: Mandatory. Reference to the context
+	// Returns the time the most recent occurrence of the event was recorded or nil if the time is not recorded
+	LastTimestamp(ctx context.Context) *string
+}
+
+// ObjectReferenceResolverContract declares the resolver that contains enough information to identify the referred edge cluster object
+type ObjectReferenceResolverContract interface {
+	// Kind returns the kind of the referred object
+	// ctx: Mandatory. Reference to the context
+	// Returns the kind of the referred object
+	Kind(ctx context.Context) string
+
+	// Name returns the name of the referred object
+	// ctx: Mandatory. Reference to the context
+	// Returns the name of the referred object
+	Name(ctx context.Context) string
+
+	// Namespace returns the namespace of the referred object
+	// ctx: Mandatory. Reference to the context
+	// Returns the namespace of the referred object
+	Namespace(ctx context.Context) string
+}
+
+// ConfigMapResolverContract declares the resolver that contains information about the edge cluster config map.
+// Only the config map metadata is exposed, the config map data is never returned.
+type ConfigMapResolverContract interface {
+	// Metadata contains the config map metadata
+	// ctx: Mandatory. Reference to the context
+	// Returns the config map metadata resolver or error if something goes wrong.
+	Metadata(ctx context.Context) (ObjectMetaResolverContract, error)
+}
+
+type EdgeClusterKubernetesInputArgument struct {
+	Namespace *string
+}
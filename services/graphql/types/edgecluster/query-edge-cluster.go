@@ -77,8 +77,8 @@ type EdgeClusterResolverContract interface {
 
 	// ClusterSecret returns edge cluster secret
 	// ctx: Mandatory. Reference to the context
-	// Returns the edge cluster secret
-	ClusterSecret(ctx context.Context) string
+	// Returns the edge cluster secret or error if the access to the edge cluster secret is denied
+	ClusterSecret(ctx context.Context) (*string, error)
 
 	// ClusterType returns the edge cluster current type
 	// ctx: Mandatory. Reference to the context
@@ -111,6 +111,29 @@ type EdgeClusterResolverContract interface {
 	// args: Mandatory. Reference to the query argument
 	// Returns the resolver that resolves the services that are part of the given edge cluster or error if something goes wrong.
 	Services(ctx context.Context, args EdgeClusterServiceInputArgument) ([]ServiceResolverContract, error)
+
+	// Namespaces returns the resolver that resolves the namespaces of the given edge cluster read directly from the edge cluster Kubernetes API
+	// ctx: Mandatory. Reference to the context
+	// Returns the resolver that resolves the namespaces of the given edge cluster or error if something goes wrong.
+	Namespaces(ctx context.Context) ([]NamespaceResolverContract, error)
+
+	// Deployments returns the resolver that resolves the deployments of the given edge cluster read directly from the edge cluster Kubernetes API
+	// ctx: Mandatory. Reference to the context
+	// args: Mandatory. Reference to the query argument
+	// Returns the resolver that resolves the deployments of the given edge cluster or error if something goes wrong.
+	Deployments(ctx context.Context, args EdgeClusterKubernetesInputArgument) ([]DeploymentResolverContract, error)
+
+	// Events returns the resolver that resolves the events of the given edge cluster read directly from the edge cluster Kubernetes API
+	// ctx: Mandatory. Reference to the context
+	// args: Mandatory. Reference to the query argument
+	// Returns the resolver that resolves the events of the given edge cluster or error if something goes wrong.
+	Events(ctx context.Context, args EdgeClusterKubernetesInputArgument) ([]EventResolverContract, error)
+
+	// ConfigMaps returns the resolver that resolves the config maps of the given edge cluster read directly from the edge cluster Kubernetes API
+	// ctx: Mandatory. Reference to the context
+	// args: Mandatory. Reference to the query argument
+	// Returns the resolver that resolves the config maps of the given edge cluster or error if something goes wrong.
+	ConfigMaps(ctx context.Context, args EdgeClusterKubernetesInputArgument) ([]ConfigMapResolverContract, error)
 }
 
 // EdgeClusterTypeConnectionResolverContract declares the resolver that returns edge cluster edge compatible with graphql-relay
@@ -153,8 +176,8 @@ type EdgeClusterProjectResolverContract interface {
 
 	// Name returns project name
 	// ctx: Mandatory. Reference to the context
-	// Returns the project name
-	Name(ctx context.Context) string
+	// Returns the project name or error if something goes wrong
+	Name(ctx context.Context) (string, error)
 }
 
 // ProvisionDetailsResolverContract declares the resolver that returns edge cluster provisioning details
@@ -166,8 +189,8 @@ type ProvisionDetailsResolverContract interface {
 
 	// KubeconfigContent returns the edge cluster Kubeconfig content
 	// ctx: Mandatory. Reference to the context
-	// Returns the edge cluster Kubeconfig content
-	KubeconfigContent(ctx context.Context) *string
+	// Returns the edge cluster Kubeconfig content or error if the access to the Kubeconfig content is denied
+	KubeconfigContent(ctx context.Context) (*string, error)
 
 	// Ports returns the ports that are exposed by the service
 	// ctx: Mandatory. Reference to the context
@@ -13,6 +13,7 @@ type QueryResolverCreatorContract interface {
 	EdgeClusterNodeResolverCreatorContract
 	EdgeClusterPodResolverCreatorContract
 	EdgeClusterServiceResolverCreatorContract
+	EdgeClusterKubernetesResolverCreatorContract
 }
 
 type EdgeClusterDetail struct {
@@ -0,0 +1,71 @@
+// packae edgecluster implements used edge cluster related types in the GraphQL transport layer
+package edgecluster
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+type SubscriptionResolverCreatorContract interface {
+	// NewEdgeClusterChanged creates new instance of the EdgeClusterChangedContract, setting up all dependencies and returns the instance
+	// ctx: Mandatory. Reference to the context
+	// Returns the new instance or error if something goes wrong
+	NewEdgeClusterChanged(ctx context.Context) (EdgeClusterChangedContract, error)
+
+	// NewEdgeClusterNodesChanged creates new instance of the EdgeClusterNodesChangedContract, setting up all dependencies and returns the instance
+	// ctx: Mandatory. Reference to the context
+	// Returns the new instance or error if something goes wrong
+	NewEdgeClusterNodesChanged(ctx context.Context) (EdgeClusterNodesChangedContract, error)
+}
+
+// SubscriptionRootResolverContract declares the root resolver for the edge cluster subscriptions
+type SubscriptionRootResolverContract interface {
+	// EdgeClusterChanged returns the channel that receives the edge cluster every time it changes
+	// ctx: Mandatory. Reference to the context
+	// args: Mandatory. Reference to the input argument contains the edge cluster to watch
+	// Returns the channel that receives the changed edge cluster or error if something goes wrong
+	EdgeClusterChanged(
+		ctx context.Context,
+		args EdgeClusterChangedInputArgument) (<-chan EdgeClusterResolverContract, error)
+
+	// EdgeClusterNodesChanged returns the channel that receives the edge cluster nodes every time they change
+	// ctx: Mandatory. Reference to the context
+	// args: Mandatory. Reference to the input argument contains the edge cluster to watch
+	// Returns the channel that receives the changed edge cluster nodes or error if something goes wrong
+	EdgeClusterNodesChanged(
+		ctx context.Context,
+		args EdgeClusterNodesChangedInputArgument) (<-chan []NodeResolverContract, error)
+}
+
+// EdgeClusterChangedContract declares the type to use when watching an existing edge cluster for changes
+type EdgeClusterChangedContract interface {
+	// Subscribe starts watching the edge cluster and returns the channel that receives the edge cluster every time it changes.
+	// The channel is closed when the context is done or the edge cluster is deleted.
+	// ctx: Mandatory. Reference to the context
+	// args: Mandatory. Reference to the input argument contains the edge cluster to watch
+	// Returns the channel that receives the changed edge cluster or error if something goes wrong
+	Subscribe(
+		ctx context.Context,
+		args EdgeClusterChangedInputArgument) (<-chan EdgeClusterResolverContract, error)
+}
+
+// EdgeClusterNodesChangedContract declares the type to use when watching the nodes of an existing edge cluster for changes
+type EdgeClusterNodesChangedContract interface {
+	// Subscribe starts watching the edge cluster nodes and returns the channel that receives the nodes every time they change.
+	// The channel is closed when the context is done or the edge cluster is deleted.
+	// ctx: Mandatory. Reference to the context
+	// args: Mandatory. Reference to the input argument contains the edge cluster to watch
+	// Returns the channel that receives the changed edge cluster nodes or error if something goes wrong
+	Subscribe(
+		ctx context.Context,
+		args EdgeClusterNodesChangedInputArgument) (<-chan []NodeResolverContract, error)
+}
+
+type EdgeClusterChangedInputArgument struct {
+	EdgeClusterID graphql.ID
+}
+
+type EdgeClusterNodesChangedInputArgument struct {
+	EdgeClusterID graphql.ID
+}
@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/graphql/types/project/project-client-contract.go
+
+// Package mock_project is a generated GoMock package.
+package mock_project
+
+import (
+	context "context"
+	reflect "reflect"
+
+	project "github.com/decentralized-cloud/project/contract/grpc/go"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockProjectClientContract is a mock of ProjectClientContract interface.
+type MockProjectClientContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectClientContractMockRecorder
+}
+
+// MockProjectClientContractMockRecorder is the mock recorder for MockProjectClientContract.
+type MockProjectClientContractMockRecorder struct {
+	mock *MockProjectClientContract
+}
+
+// NewMockProjectClientContract creates a new mock instance.
+func NewMockProjectClientContract(ctrl *gomock.Controller) *MockProjectClientContract {
+	mock := &MockProjectClientContract{ctrl: ctrl}
+	mock.recorder = &MockProjectClientContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectClientContract) EXPECT() *MockProjectClientContractMockRecorder {
+	return m.recorder
+}
+
+// CheckHealth mocks base method.
+func (m *MockProjectClientContract) CheckHealth(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckHealth", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckHealth indicates an expected call of CheckHealth.
+func (mr *MockProjectClientContractMockRecorder) CheckHealth(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockProjectClientContract)(nil).CheckHealth), ctx)
+}
+
+// Close mocks base method.
+func (m *MockProjectClientContract) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockProjectClientContractMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockProjectClientContract)(nil).Close))
+}
+
+// GetClient mocks base method.
+func (m *MockProjectClientContract) GetClient() project.ServiceClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClient")
+	ret0, _ := ret[0].(project.ServiceClient)
+	return ret0
+}
+
+// GetClient indicates an expected call of GetClient.
+func (mr *MockProjectClientContractMockRecorder) GetClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockProjectClientContract)(nil).GetClient))
+}
@@ -2,14 +2,24 @@
 package project
 
 import (
+	"context"
+
 	projectGrpcContract "github.com/decentralized-cloud/project/contract/grpc/go"
-	"google.golang.org/grpc"
 )
 
 // ProjectClientContract wraps the tennat gRPC client to make it easy for testing
 type ProjectClientContract interface {
-	// CreateClient creats a new project gRPC client and returns the connection
-	// and the client to the caller.
-	// Returns connection and the project gRPC client or error if something goes wrong.
-	CreateClient() (*grpc.ClientConn, projectGrpcContract.ServiceClient, error)
+	// GetClient returns the project gRPC client that uses the long-lived connection shared
+	// between all callers.
+	// Returns the project gRPC client.
+	GetClient() projectGrpcContract.ServiceClient
+
+	// CheckHealth queries the standard gRPC health service of the project service.
+	// ctx: Mandatory. Reference to the context
+	// Returns error if the project service is not serving or something goes wrong.
+	CheckHealth(ctx context.Context) error
+
+	// Close closes the shared connection to the project service.
+	// Returns error if something goes wrong.
+	Close() error
 }
@@ -12,7 +12,7 @@ type SortingOptionPair struct {
 }
 
 type UserProjectInputArgument struct {
-	ProjectID string
+	ProjectID graphql.ID
 }
 
 type UserProjectsInputArgument struct {
@@ -31,3 +31,11 @@ type UserEdgeClustersInputArgument struct {
 	EdgeClusterIDs *[]graphql.ID
 	ProjectIDs     *[]graphql.ID
 }
+
+type NodeInputArgument struct {
+	ID graphql.ID
+}
+
+type NodesInputArgument struct {
+	IDs []graphql.ID
+}
@@ -48,3 +48,27 @@ type UserResolverContract interface {
 		ctx context.Context,
 		args UserEdgeClustersInputArgument) (edgecluster.EdgeClusterTypeConnectionResolverContract, error)
 }
+
+// NodeResolverContract declares the resolver that resolves the object fetched by its global identifier
+type NodeResolverContract interface {
+	// ID returns the object global identifier
+	// ctx: Mandatory. Reference to the context
+	// Returns the object global identifier or error if the object failed to load
+	ID(ctx context.Context) (graphql.ID, error)
+
+	// ToUser returns the user resolver if the object is a user
+	// Returns the user resolver and true if the object is a user, otherwise false
+	ToUser() (UserResolverContract, bool)
+
+	// ToProject returns the project resolver if the object is a project
+	// Returns the project resolver and true if the object is a project, otherwise false
+	ToProject() (project.ProjectResolverContract, bool)
+
+	// ToEdgeCluster returns the edge cluster resolver if the object is an edge cluster
+	// Returns the edge cluster resolver and true if the object is an edge cluster, otherwise false
+	ToEdgeCluster() (edgecluster.EdgeClusterResolverContract, bool)
+
+	// ToEdgeClusterProject returns the edge cluster project resolver if the object is the project of an edge cluster
+	// Returns the edge cluster project resolver and true if the object is the project of an edge cluster, otherwise false
+	ToEdgeClusterProject() (edgecluster.EdgeClusterProjectResolverContract, bool)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
 	"github.com/decentralized-cloud/api-gateway/services/graphql/types/relay"
+	"github.com/graph-gophers/graphql-go"
 )
 
 // ResolverCreatorContract declares the service that can create different resolvers
@@ -24,9 +25,18 @@ type ResolverCreatorContract interface {
 		ctx context.Context,
 		userID string) (UserResolverContract, error)
 
+	// NewNodeResolver creates new NodeResolverContract for the object with the given global identifier and returns it
+	// ctx: Mandatory. Reference to the context
+	// id: Mandatory. The object global identifier
+	// Returns the NodeResolverContract, nil if the object does not exist, or error if something goes wrong
+	NewNodeResolver(
+		ctx context.Context,
+		id graphql.ID) (NodeResolverContract, error)
+
 	relay.PageInfoResolverCreatorContract
 	project.QueryResolverCreatorContract
 	project.MutationResolverCreatorContract
 	edgecluster.QueryResolverCreatorContract
 	edgecluster.MutationResolverCreatorContract
+	edgecluster.SubscriptionResolverCreatorContract
 }
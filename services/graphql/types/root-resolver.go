@@ -15,6 +15,20 @@ type RootResolverContract interface {
 	// Returns the user resolver or error if something goes wrong
 	User(ctx context.Context) (UserResolverContract, error)
 
+	// Node returns the resolver of the object with the given global identifier
+	// ctx: Mandatory. Reference to the context
+	// args: Mandatory. The argument list
+	// Returns the node resolver, nil if the object does not exist, or error if something goes wrong
+	Node(ctx context.Context, args NodeInputArgument) (NodeResolverContract, error)
+
+	// Nodes returns the resolvers of the objects with the given global identifiers. The objects that fail to load are
+	// reported by their own entry instead of failing the whole list.
+	// ctx: Mandatory. Reference to the context
+	// args: Mandatory. The argument list
+	// Returns the node resolvers in the order of the identifiers, nil for the objects that do not exist, or error if something goes wrong
+	Nodes(ctx context.Context, args NodesInputArgument) ([]NodeResolverContract, error)
+
 	project.RootResolverContract
 	edgecluster.RootResolverContract
+	edgecluster.SubscriptionRootResolverContract
 }
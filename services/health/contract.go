@@ -0,0 +1,32 @@
+// Package health implements services that check the health of the backend services the api-gateway depends on
+package health
+
+import "context"
+
+const (
+	// StatusUp indicates the dependency is healthy
+	StatusUp = "UP"
+
+	// StatusDown indicates the dependency is not healthy
+	StatusDown = "DOWN"
+)
+
+// DependencyStatus contains the health status of a single backend service
+type DependencyStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// HealthReport contains the overall health status and the health status of every backend service
+type HealthReport struct {
+	Status       string                      `json:"status"`
+	Dependencies map[string]DependencyStatus `json:"dependencies"`
+}
+
+// HealthCheckContract declares the service that checks the health of the backend services
+type HealthCheckContract interface {
+	// Check checks the health of all the backend services. The result is cached for the configured duration.
+	// ctx: Mandatory. Reference to the context
+	// Returns the health report
+	Check(ctx context.Context) HealthReport
+}
@@ -0,0 +1,121 @@
+// Package health implements services that check the health of the backend services the api-gateway depends on
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+type dependency struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+type grpcHealthCheckService struct {
+	dependencies  []dependency
+	timeout       time.Duration
+	cacheDuration time.Duration
+	lock          sync.Mutex
+	lastReport    *HealthReport
+	lastCheckedAt time.Time
+}
+
+// NewGrpcHealthCheckService creates new instance of the grpcHealthCheckService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// projectClientService: Mandatory. Reference to the project client service
+// edgeClusterClientService: Mandatory. Reference to the edge cluster client service
+// Returns the new service or error if something goes wrong
+func NewGrpcHealthCheckService(
+	configurationService configuration.ConfigurationContract,
+	projectClientService project.ProjectClientContract,
+	edgeClusterClientService edgecluster.EdgeClusterClientContract) (HealthCheckContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if projectClientService == nil {
+		return nil, commonErrors.NewArgumentNilError("projectClientService", "projectClientService is required")
+	}
+
+	if edgeClusterClientService == nil {
+		return nil, commonErrors.NewArgumentNilError("edgeClusterClientService", "edgeClusterClientService is required")
+	}
+
+	timeout, err := configurationService.GetHealthCheckTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	cacheDuration, err := configurationService.GetHealthCheckCacheDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcHealthCheckService{
+		dependencies: []dependency{
+			{name: "project", check: projectClientService.CheckHealth},
+			{name: "edgeCluster", check: edgeClusterClientService.CheckHealth},
+		},
+		timeout:       timeout,
+		cacheDuration: cacheDuration,
+	}, nil
+}
+
+// Check checks the health of all the backend services. The result is cached for the configured duration.
+// ctx: Mandatory. Reference to the context
+// Returns the health report
+func (service *grpcHealthCheckService) Check(ctx context.Context) HealthReport {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	if service.lastReport != nil && time.Since(service.lastCheckedAt) < service.cacheDuration {
+		return *service.lastReport
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, service.timeout)
+	defer cancel()
+
+	statuses := make([]DependencyStatus, len(service.dependencies))
+
+	var waitGroup sync.WaitGroup
+
+	for idx, item := range service.dependencies {
+		waitGroup.Add(1)
+
+		go func(idx int, item dependency) {
+			defer waitGroup.Done()
+
+			if err := item.check(ctx); err != nil {
+				statuses[idx] = DependencyStatus{Status: StatusDown, Error: err.Error()}
+			} else {
+				statuses[idx] = DependencyStatus{Status: StatusUp}
+			}
+		}(idx, item)
+	}
+
+	waitGroup.Wait()
+
+	report := HealthReport{
+		Status:       StatusUp,
+		Dependencies: map[string]DependencyStatus{},
+	}
+
+	for idx, item := range service.dependencies {
+		report.Dependencies[item.name] = statuses[idx]
+
+		if statuses[idx].Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+
+	service.lastReport = &report
+	service.lastCheckedAt = time.Now()
+
+	return report
+}
@@ -0,0 +1,142 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	mock_edgecluster "github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster/mock"
+	mock_project "github.com/decentralized-cloud/api-gateway/services/graphql/types/project/mock"
+	"github.com/decentralized-cloud/api-gateway/services/health"
+	"github.com/golang/mock/gomock"
+)
+
+func newHealthCheckService(
+	t *testing.T,
+	timeout time.Duration,
+	cacheDuration time.Duration) (health.HealthCheckContract, *mock_project.MockProjectClientContract, *mock_edgecluster.MockEdgeClusterClientContract) {
+	mockCtrl := gomock.NewController(t)
+
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetHealthCheckTimeout().Return(timeout, nil)
+	configurationService.EXPECT().GetHealthCheckCacheDuration().Return(cacheDuration, nil)
+
+	projectClientService := mock_project.NewMockProjectClientContract(mockCtrl)
+	edgeClusterClientService := mock_edgecluster.NewMockEdgeClusterClientContract(mockCtrl)
+
+	service, err := health.NewGrpcHealthCheckService(configurationService, projectClientService, edgeClusterClientService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return service, projectClientService, edgeClusterClientService
+}
+
+func TestGrpcHealthCheckService_Check(t *testing.T) {
+	tests := []struct {
+		name           string
+		projectErr     error
+		edgeClusterErr error
+		expectedReport health.HealthReport
+	}{
+		{
+			name: "all backend services up",
+			expectedReport: health.HealthReport{
+				Status: health.StatusUp,
+				Dependencies: map[string]health.DependencyStatus{
+					"project":     {Status: health.StatusUp},
+					"edgeCluster": {Status: health.StatusUp},
+				},
+			},
+		},
+		{
+			name:       "one backend service down",
+			projectErr: errors.New("project service is not serving"),
+			expectedReport: health.HealthReport{
+				Status: health.StatusDown,
+				Dependencies: map[string]health.DependencyStatus{
+					"project":     {Status: health.StatusDown, Error: "project service is not serving"},
+					"edgeCluster": {Status: health.StatusUp},
+				},
+			},
+		},
+		{
+			name:           "all backend services down",
+			projectErr:     errors.New("project service is not serving"),
+			edgeClusterErr: errors.New("edge cluster service is not serving"),
+			expectedReport: health.HealthReport{
+				Status: health.StatusDown,
+				Dependencies: map[string]health.DependencyStatus{
+					"project":     {Status: health.StatusDown, Error: "project service is not serving"},
+					"edgeCluster": {Status: health.StatusDown, Error: "edge cluster service is not serving"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, projectClientService, edgeClusterClientService := newHealthCheckService(t, time.Second, 0)
+			projectClientService.EXPECT().CheckHealth(gomock.Any()).Return(test.projectErr)
+			edgeClusterClientService.EXPECT().CheckHealth(gomock.Any()).Return(test.edgeClusterErr)
+
+			if report := service.Check(context.Background()); !reflect.DeepEqual(report, test.expectedReport) {
+				t.Fatalf("expected the report %+v, got %+v", test.expectedReport, report)
+			}
+		})
+	}
+}
+
+func TestGrpcHealthCheckService_Check_CachesTheReport(t *testing.T) {
+	service, projectClientService, edgeClusterClientService := newHealthCheckService(t, time.Second, 50*time.Millisecond)
+	projectClientService.EXPECT().CheckHealth(gomock.Any()).Return(nil)
+	edgeClusterClientService.EXPECT().CheckHealth(gomock.Any()).Return(nil).Times(2)
+
+	// The backend services are checked once while the report is cached
+	for i := 0; i < 3; i++ {
+		if report := service.Check(context.Background()); report.Status != health.StatusUp {
+			t.Fatalf("expected the cached report to be %s, got %s", health.StatusUp, report.Status)
+		}
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	projectClientService.EXPECT().CheckHealth(gomock.Any()).Return(errors.New("project service is not serving"))
+
+	if report := service.Check(context.Background()); report.Status != health.StatusDown {
+		t.Fatalf("expected the backend services to be checked again once the cached report expired, got %s", report.Status)
+	}
+}
+
+func TestGrpcHealthCheckService_Check_TimesOut(t *testing.T) {
+	service, projectClientService, edgeClusterClientService := newHealthCheckService(t, 50*time.Millisecond, 0)
+	projectClientService.EXPECT().CheckHealth(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+	edgeClusterClientService.EXPECT().CheckHealth(gomock.Any()).Return(nil)
+
+	start := time.Now()
+	report := service.Check(context.Background())
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the check to stop after the timeout, took %v", elapsed)
+	}
+
+	if report.Status != health.StatusDown {
+		t.Fatalf("expected the report to be %s, got %s", health.StatusDown, report.Status)
+	}
+
+	expectedStatus := health.DependencyStatus{Status: health.StatusDown, Error: context.DeadlineExceeded.Error()}
+	if status := report.Dependencies["project"]; status != expectedStatus {
+		t.Fatalf("expected the project service status %+v, got %+v", expectedStatus, status)
+	}
+
+	if status := report.Dependencies["edgeCluster"]; status.Status != health.StatusUp {
+		t.Fatalf("expected the edge cluster service to be %s, got %s", health.StatusUp, status.Status)
+	}
+}
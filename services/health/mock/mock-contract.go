@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/health/contract.go
+
+// Package mock_health is a generated GoMock package.
+package mock_health
+
+import (
+	context "context"
+	reflect "reflect"
+
+	health "github.com/decentralized-cloud/api-gateway/services/health"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockHealthCheckContract is a mock of HealthCheckContract interface.
+type MockHealthCheckContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthCheckContractMockRecorder
+}
+
+// MockHealthCheckContractMockRecorder is the mock recorder for MockHealthCheckContract.
+type MockHealthCheckContractMockRecorder struct {
+	mock *MockHealthCheckContract
+}
+
+// NewMockHealthCheckContract creates a new mock instance.
+func NewMockHealthCheckContract(ctrl *gomock.Controller) *MockHealthCheckContract {
+	mock := &MockHealthCheckContract{ctrl: ctrl}
+	mock.recorder = &MockHealthCheckContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealthCheckContract) EXPECT() *MockHealthCheckContractMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockHealthCheckContract) Check(ctx context.Context) health.HealthReport {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx)
+	ret0, _ := ret[0].(health.HealthReport)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockHealthCheckContractMockRecorder) Check(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockHealthCheckContract)(nil).Check), ctx)
+}
@@ -0,0 +1,12 @@
+// Package identity implements the services that extract the authenticated principal from the verified access token
+package identity
+
+import "github.com/lestrrat-go/jwx/jwt"
+
+// IdentityContract declares the service that converts verified access tokens to principals
+type IdentityContract interface {
+	// NewPrincipal creates new principal from the verified access token using the configured claim mapping
+	// token: Mandatory. The verified access token
+	// Returns the new principal or error if something goes wrong
+	NewPrincipal(token jwt.Token) (*Principal, error)
+}
@@ -0,0 +1,126 @@
+// Package identity implements the services that extract the authenticated principal from the verified access token
+package identity
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"github.com/lestrrat-go/jwx/jwt"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+type jwtIdentityService struct {
+	subjectClaim string
+	emailClaim   string
+	scopesClaim  string
+}
+
+// NewJwtIdentityService creates new instance of the jwtIdentityService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new service or error if something goes wrong
+func NewJwtIdentityService(configurationService configuration.ConfigurationContract) (IdentityContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	subjectClaim, err := configurationService.GetJwtSubjectClaim()
+	if err != nil {
+		return nil, err
+	}
+
+	emailClaim, err := configurationService.GetJwtEmailClaim()
+	if err != nil {
+		return nil, err
+	}
+
+	scopesClaim, err := configurationService.GetJwtScopesClaim()
+	if err != nil {
+		return nil, err
+	}
+
+	return &jwtIdentityService{
+		subjectClaim: subjectClaim,
+		emailClaim:   emailClaim,
+		scopesClaim:  scopesClaim,
+	}, nil
+}
+
+// NewPrincipal creates new principal from the verified access token using the configured claim mapping
+// token: Mandatory. The verified access token
+// Returns the new principal or error if something goes wrong
+func (service *jwtIdentityService) NewPrincipal(token jwt.Token) (*Principal, error) {
+	if token == nil {
+		return nil, commonErrors.NewArgumentNilError("token", "token is required")
+	}
+
+	subject, err := getStringClaim(token, service.subjectClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.Trim(subject, " ") == "" {
+		return nil, commonErrors.NewArgumentError("token", fmt.Sprintf("%s claim is required", service.subjectClaim))
+	}
+
+	email, err := getStringClaim(token, service.emailClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := getScopesClaim(token, service.scopesClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Principal{
+		Subject: subject,
+		Email:   email,
+		Scopes:  scopes,
+	}, nil
+}
+
+func getStringClaim(token jwt.Token, claim string) (string, error) {
+	value, ok := token.Get(claim)
+	if !ok || value == nil {
+		return "", nil
+	}
+
+	stringValue, ok := value.(string)
+	if !ok {
+		return "", commonErrors.NewArgumentError("token", fmt.Sprintf("%s claim must be a string", claim))
+	}
+
+	return stringValue, nil
+}
+
+func getScopesClaim(token jwt.Token, claim string) ([]string, error) {
+	value, ok := token.Get(claim)
+	if !ok || value == nil {
+		return []string{}, nil
+	}
+
+	switch scopes := value.(type) {
+	case string:
+		return strings.Fields(scopes), nil
+
+	case []string:
+		return scopes, nil
+
+	case []interface{}:
+		result := make([]string, 0, len(scopes))
+		for _, scope := range scopes {
+			stringScope, ok := scope.(string)
+			if !ok {
+				return nil, commonErrors.NewArgumentError("token", fmt.Sprintf("%s claim must only contain strings", claim))
+			}
+
+			result = append(result, stringScope)
+		}
+
+		return result, nil
+
+	default:
+		return nil, commonErrors.NewArgumentError("token", fmt.Sprintf("%s claim must be either a space delimited string or an array of strings", claim))
+	}
+}
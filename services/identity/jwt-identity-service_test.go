@@ -0,0 +1,126 @@
+package identity_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/golang/mock/gomock"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+func newIdentityService(t *testing.T, subjectClaim string, emailClaim string, scopesClaim string) identity.IdentityContract {
+	mockCtrl := gomock.NewController(t)
+
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetJwtSubjectClaim().Return(subjectClaim, nil)
+	configurationService.EXPECT().GetJwtEmailClaim().Return(emailClaim, nil)
+	configurationService.EXPECT().GetJwtScopesClaim().Return(scopesClaim, nil)
+
+	service, err := identity.NewJwtIdentityService(configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return service
+}
+
+// newToken creates the token from the JSON claims, so the claim values have the types they have in the verified access tokens
+func newToken(t *testing.T, claims string) jwt.Token {
+	token := jwt.New()
+	if err := json.Unmarshal([]byte(claims), token); err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestJwtIdentityService_NewPrincipal(t *testing.T) {
+	tests := []struct {
+		name              string
+		claims            string
+		expectedPrincipal *identity.Principal
+	}{
+		{
+			name:              "scopes as a space delimited string",
+			claims:            `{"sub": "user-1", "email": "user@example.com", "scope": "project:read  project:write"}`,
+			expectedPrincipal: &identity.Principal{Subject: "user-1", Email: "user@example.com", Scopes: []string{"project:read", "project:write"}},
+		},
+		{
+			name:              "scopes as an array",
+			claims:            `{"sub": "user-1", "scope": ["project:read", "edgecluster:secrets:read"]}`,
+			expectedPrincipal: &identity.Principal{Subject: "user-1", Scopes: []string{"project:read", "edgecluster:secrets:read"}},
+		},
+		{
+			name:              "no email and no scopes",
+			claims:            `{"sub": "user-1"}`,
+			expectedPrincipal: &identity.Principal{Subject: "user-1", Scopes: []string{}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newIdentityService(t, "sub", "email", "scope")
+
+			principal, err := service.NewPrincipal(newToken(t, test.claims))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(principal, test.expectedPrincipal) {
+				t.Fatalf("expected the principal %+v, got %+v", test.expectedPrincipal, principal)
+			}
+		})
+	}
+}
+
+func TestJwtIdentityService_NewPrincipal_CustomClaimNames(t *testing.T) {
+	service := newIdentityService(t, "uid", "https://example.com/email", "permissions")
+
+	principal, err := service.NewPrincipal(newToken(
+		t,
+		`{"sub": "ignored", "uid": "user-1", "https://example.com/email": "user@example.com", "scope": "ignored", "permissions": ["project:read"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPrincipal := &identity.Principal{Subject: "user-1", Email: "user@example.com", Scopes: []string{"project:read"}}
+	if !reflect.DeepEqual(principal, expectedPrincipal) {
+		t.Fatalf("expected the principal %+v, got %+v", expectedPrincipal, principal)
+	}
+}
+
+func TestJwtIdentityService_NewPrincipal_RejectsInvalidClaims(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims string
+	}{
+		{name: "missing subject", claims: `{"email": "user@example.com"}`},
+		{name: "empty subject", claims: `{"uid": ""}`},
+		{name: "blank subject", claims: `{"uid": "   "}`},
+		{name: "non-string subject", claims: `{"uid": 42}`},
+		{name: "non-string email", claims: `{"uid": "user-1", "email": ["user@example.com"]}`},
+		{name: "non-string scope", claims: `{"uid": "user-1", "scope": ["project:read", 42]}`},
+		{name: "scopes as an object", claims: `{"uid": "user-1", "scope": {"project": "read"}}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newIdentityService(t, "uid", "email", "scope")
+
+			if principal, err := service.NewPrincipal(newToken(t, test.claims)); err == nil {
+				t.Fatalf("expected the token to be rejected, got the principal %+v", principal)
+			}
+		})
+	}
+}
+
+func TestJwtIdentityService_NewPrincipal_RejectsNilToken(t *testing.T) {
+	service := newIdentityService(t, "sub", "email", "scope")
+
+	if _, err := service.NewPrincipal(nil); err == nil {
+		t.Fatal("expected the nil token to be rejected")
+	}
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/identity/contract.go
+
+// Package mock_identity is a generated GoMock package.
+package mock_identity
+
+import (
+	reflect "reflect"
+
+	identity "github.com/decentralized-cloud/api-gateway/services/identity"
+	gomock "github.com/golang/mock/gomock"
+	jwt "github.com/lestrrat-go/jwx/jwt"
+)
+
+// MockIdentityContract is a mock of IdentityContract interface.
+type MockIdentityContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityContractMockRecorder
+}
+
+// MockIdentityContractMockRecorder is the mock recorder for MockIdentityContract.
+type MockIdentityContractMockRecorder struct {
+	mock *MockIdentityContract
+}
+
+// NewMockIdentityContract creates a new mock instance.
+func NewMockIdentityContract(ctrl *gomock.Controller) *MockIdentityContract {
+	mock := &MockIdentityContract{ctrl: ctrl}
+	mock.recorder = &MockIdentityContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityContract) EXPECT() *MockIdentityContractMockRecorder {
+	return m.recorder
+}
+
+// NewPrincipal mocks base method.
+func (m *MockIdentityContract) NewPrincipal(token jwt.Token) (*identity.Principal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPrincipal", token)
+	ret0, _ := ret[0].(*identity.Principal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewPrincipal indicates an expected call of NewPrincipal.
+func (mr *MockIdentityContractMockRecorder) NewPrincipal(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPrincipal", reflect.TypeOf((*MockIdentityContract)(nil).NewPrincipal), token)
+}
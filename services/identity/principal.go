@@ -0,0 +1,43 @@
+// Package identity implements the services that extract the authenticated principal from the verified access token
+package identity
+
+import "context"
+
+type principalContextKey struct{}
+
+// Principal contains information about the authenticated caller extracted from the verified access token
+type Principal struct {
+	Subject string
+	Email   string
+	Scopes  []string
+}
+
+// HasScope returns true if the principal has been granted the given scope, otherwise returns false
+// scope: Mandatory. The scope to look for
+// Returns true if the principal has been granted the given scope, otherwise returns false
+func (principal *Principal) HasScope(scope string) bool {
+	for _, grantedScope := range principal.Scopes {
+		if grantedScope == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewContext returns a copy of the parent context that carries the given principal
+// ctx: Mandatory. Reference to the parent context
+// principal: Mandatory. The authenticated principal
+// Returns the new context
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// FromContext retrieves the principal stored in the context
+// ctx: Mandatory. Reference to the context
+// Returns the principal and true if the principal exists in the context, otherwise returns nil and false
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,268 @@
+// Package kubeclient implements the service that creates the clients used to read the edge cluster resources directly from the edge cluster Kubernetes API
+package kubeclient
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// userAgent is the user agent reported to the edge cluster Kubernetes API
+const userAgent = "api-gateway"
+
+type cachedClient struct {
+	edgeClusterID  string
+	kubeconfigHash string
+	client         kubernetes.Interface
+	metadataClient metadata.Interface
+}
+
+type cachedKubeClientService struct {
+	cacheSize      int
+	requestTimeout time.Duration
+	listPageSize   int
+	listMaxItems   int
+	lock           sync.Mutex
+	clients        *list.List
+	index          map[string]*list.Element
+}
+
+// NewCachedKubeClientService creates new instance of the cachedKubeClientService, setting up all dependencies and returns the instance.
+// The service keeps the most recently used edge cluster clients in memory and evicts the least recently used clients
+// once the number of the cached clients exceeds the configured cache size.
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new service or error if something goes wrong
+func NewCachedKubeClientService(configurationService configuration.ConfigurationContract) (KubeClientContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	cacheSize, err := configurationService.GetKubernetesClientCacheSize()
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheSize <= 0 {
+		return nil, commonErrors.NewUnknownError("KUBERNETES_CLIENT_CACHE_SIZE must be greater than zero")
+	}
+
+	requestTimeout, err := configurationService.GetKubernetesRequestTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	listPageSize, err := configurationService.GetKubernetesListPageSize()
+	if err != nil {
+		return nil, err
+	}
+
+	if listPageSize <= 0 {
+		return nil, commonErrors.NewUnknownError("KUBERNETES_LIST_PAGE_SIZE must be greater than zero")
+	}
+
+	listMaxItems, err := configurationService.GetKubernetesListMaxItems()
+	if err != nil {
+		return nil, err
+	}
+
+	if listMaxItems <= 0 {
+		return nil, commonErrors.NewUnknownError("KUBERNETES_LIST_MAX_ITEMS must be greater than zero")
+	}
+
+	return &cachedKubeClientService{
+		cacheSize:      cacheSize,
+		requestTimeout: requestTimeout,
+		listPageSize:   listPageSize,
+		listMaxItems:   listMaxItems,
+		clients:        list.New(),
+		index:          map[string]*list.Element{},
+	}, nil
+}
+
+// GetClient returns the Kubernetes client of the edge cluster. The client is built from the edge cluster kubeconfig
+// and cached, a new client is built once the kubeconfig of the edge cluster changes.
+// edgeClusterID: Mandatory. The edge cluster unique identifier
+// kubeconfigContent: Mandatory. The edge cluster kubeconfig content
+// Returns the Kubernetes client or error if something goes wrong
+func (service *cachedKubeClientService) GetClient(edgeClusterID string, kubeconfigContent string) (kubernetes.Interface, error) {
+	cached, err := service.getCachedClient(edgeClusterID, kubeconfigContent)
+	if err != nil {
+		return nil, err
+	}
+
+	return cached.client, nil
+}
+
+// GetMetadataClient returns the Kubernetes client of the edge cluster that reads only the metadata of the objects. The
+// client is cached along with the client returned by GetClient.
+// edgeClusterID: Mandatory. The edge cluster unique identifier
+// kubeconfigContent: Mandatory. The edge cluster kubeconfig content
+// Returns the Kubernetes metadata client or error if something goes wrong
+func (service *cachedKubeClientService) GetMetadataClient(edgeClusterID string, kubeconfigContent string) (metadata.Interface, error) {
+	cached, err := service.getCachedClient(edgeClusterID, kubeconfigContent)
+	if err != nil {
+		return nil, err
+	}
+
+	return cached.metadataClient, nil
+}
+
+// List lists the edge cluster objects page by page using the configured page size, until all the objects are listed
+// or the configured maximum number of the listed objects is reached
+// ctx: Mandatory. Reference to the context
+// listPage: Mandatory. Lists a single page of the edge cluster objects
+// Returns true if the list was truncated as the maximum number of the listed objects was reached, otherwise false, or error if something goes wrong
+func (service *cachedKubeClientService) List(ctx context.Context, listPage ListPageFunc) (bool, error) {
+	if listPage == nil {
+		return false, commonErrors.NewArgumentNilError("listPage", "listPage is required")
+	}
+
+	options := metav1.ListOptions{}
+	listed := 0
+
+	for {
+		options.Limit = int64(service.listPageSize)
+		if remaining := service.listMaxItems - listed; remaining < service.listPageSize {
+			options.Limit = int64(remaining)
+		}
+
+		continueToken, count, err := listPage(ctx, options)
+		if err != nil {
+			return false, err
+		}
+
+		listed += count
+		if continueToken == "" {
+			return false, nil
+		}
+
+		if listed >= service.listMaxItems {
+			return true, nil
+		}
+
+		options.Continue = continueToken
+	}
+}
+
+func (service *cachedKubeClientService) getCachedClient(edgeClusterID string, kubeconfigContent string) (*cachedClient, error) {
+	if strings.Trim(edgeClusterID, " ") == "" {
+		return nil, commonErrors.NewArgumentError("edgeClusterID", "edgeClusterID is required")
+	}
+
+	if strings.Trim(kubeconfigContent, " ") == "" {
+		return nil, commonErrors.NewArgumentError("kubeconfigContent", "The edge cluster is not provisioned yet, its kubeconfig is not available")
+	}
+
+	hash := sha256.Sum256([]byte(kubeconfigContent))
+	kubeconfigHash := hex.EncodeToString(hash[:])
+
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	if element, ok := service.index[edgeClusterID]; ok {
+		cached := element.Value.(*cachedClient)
+		if cached.kubeconfigHash == kubeconfigHash {
+			service.clients.MoveToFront(element)
+
+			return cached, nil
+		}
+
+		service.remove(element)
+	}
+
+	cached, err := service.newCachedClient(kubeconfigContent)
+	if err != nil {
+		return nil, err
+	}
+
+	cached.edgeClusterID = edgeClusterID
+	cached.kubeconfigHash = kubeconfigHash
+	service.index[edgeClusterID] = service.clients.PushFront(cached)
+
+	for service.clients.Len() > service.cacheSize {
+		service.remove(service.clients.Back())
+	}
+
+	return cached, nil
+}
+
+func (service *cachedKubeClientService) newCachedClient(kubeconfigContent string) (*cachedClient, error) {
+	kubeconfig, err := clientcmd.Load([]byte(kubeconfigContent))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("Failed to parse the edge cluster kubeconfig", err)
+	}
+
+	if err := validateKubeconfig(kubeconfig); err != nil {
+		return nil, err
+	}
+
+	restConfig, err := clientcmd.NewDefaultClientConfig(*kubeconfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("Failed to parse the edge cluster kubeconfig", err)
+	}
+
+	restConfig.Timeout = service.requestTimeout
+	restConfig.UserAgent = userAgent
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("Failed to create the edge cluster Kubernetes client", err)
+	}
+
+	metadataClient, err := metadata.NewForConfig(restConfig)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("Failed to create the edge cluster Kubernetes metadata client", err)
+	}
+
+	return &cachedClient{
+		client:         client,
+		metadataClient: metadataClient,
+	}, nil
+}
+
+// validateKubeconfig rejects the kubeconfigs that make the api-gateway run commands or read its own files to
+// authenticate to the edge cluster. The kubeconfigs are provided along with the edge clusters, so they are not trusted
+// and must embed the credentials they authenticate with.
+func validateKubeconfig(kubeconfig *clientcmdapi.Config) error {
+	for name, authInfo := range kubeconfig.AuthInfos {
+		if authInfo.Exec != nil || authInfo.AuthProvider != nil {
+			return commonErrors.NewArgumentError(
+				"kubeconfigContent",
+				fmt.Sprintf("The user %s of the edge cluster kubeconfig must not use the exec or the auth provider plugins", name))
+		}
+
+		if authInfo.TokenFile != "" || authInfo.ClientCertificate != "" || authInfo.ClientKey != "" {
+			return commonErrors.NewArgumentError(
+				"kubeconfigContent",
+				fmt.Sprintf("The user %s of the edge cluster kubeconfig must embed its credentials instead of referencing files", name))
+		}
+	}
+
+	for name, cluster := range kubeconfig.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return commonErrors.NewArgumentError(
+				"kubeconfigContent",
+				fmt.Sprintf("The cluster %s of the edge cluster kubeconfig must embed its certificate authority instead of referencing a file", name))
+		}
+	}
+
+	return nil
+}
+
+func (service *cachedKubeClientService) remove(element *list.Element) {
+	service.clients.Remove(element)
+	delete(service.index, element.Value.(*cachedClient).edgeClusterID)
+}
@@ -0,0 +1,333 @@
+package kubeclient_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/kubeclient"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeKubernetesAPI serves the namespaces page by page, the continue token is the offset of the next page
+type fakeKubernetesAPI struct {
+	namespaces    int
+	lock          sync.Mutex
+	limits        []string
+	acceptHeaders []string
+}
+
+func (api *fakeKubernetesAPI) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	api.lock.Lock()
+	api.limits = append(api.limits, request.URL.Query().Get("limit"))
+	api.acceptHeaders = append(api.acceptHeaders, request.Header.Get("Accept"))
+	api.lock.Unlock()
+
+	offset, _ := strconv.Atoi(request.URL.Query().Get("continue"))
+	limit, _ := strconv.Atoi(request.URL.Query().Get("limit"))
+
+	end := api.namespaces
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+
+	continueToken := ""
+	if end < api.namespaces {
+		continueToken = strconv.Itoa(end)
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+
+	if strings.Contains(request.Header.Get("Accept"), "as=PartialObjectMetadataList") {
+		list := metav1.PartialObjectMetadataList{
+			TypeMeta: metav1.TypeMeta{Kind: "PartialObjectMetadataList", APIVersion: "meta.k8s.io/v1"},
+			ListMeta: metav1.ListMeta{Continue: continueToken},
+		}
+
+		for index := offset; index < end; index++ {
+			list.Items = append(list.Items, metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{Kind: "PartialObjectMetadata", APIVersion: "meta.k8s.io/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("namespace-%d", index)},
+			})
+		}
+
+		_ = json.NewEncoder(writer).Encode(list)
+
+		return
+	}
+
+	list := corev1.NamespaceList{
+		TypeMeta: metav1.TypeMeta{Kind: "NamespaceList", APIVersion: "v1"},
+		ListMeta: metav1.ListMeta{Continue: continueToken},
+	}
+
+	for index := offset; index < end; index++ {
+		list.Items = append(list.Items, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("namespace-%d", index)}})
+	}
+
+	_ = json.NewEncoder(writer).Encode(list)
+}
+
+func newKubeconfig(server string, token string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: edge
+  cluster:
+    server: %s
+users:
+- name: edge
+  user:
+    token: %s
+contexts:
+- name: edge
+  context:
+    cluster: edge
+    user: edge
+current-context: edge
+`, server, token)
+}
+
+func newKubeClientService(t *testing.T, cacheSize, listPageSize, listMaxItems int) kubeclient.KubeClientContract {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetKubernetesClientCacheSize().Return(cacheSize, nil)
+	configurationService.EXPECT().GetKubernetesRequestTimeout().Return(5*time.Second, nil)
+	configurationService.EXPECT().GetKubernetesListPageSize().Return(listPageSize, nil)
+	configurationService.EXPECT().GetKubernetesListMaxItems().Return(listMaxItems, nil)
+
+	service, err := kubeclient.NewCachedKubeClientService(configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return service
+}
+
+func listNamespaces(t *testing.T, service kubeclient.KubeClientContract, kubeconfig string) ([]string, bool) {
+	t.Helper()
+
+	client, err := service.GetClient("e1", kubeconfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{}
+	truncated, err := service.List(context.Background(), func(ctx context.Context, options metav1.ListOptions) (string, int, error) {
+		namespaceList, err := client.CoreV1().Namespaces().List(ctx, options)
+		if err != nil {
+			return "", 0, err
+		}
+
+		for _, namespace := range namespaceList.Items {
+			names = append(names, namespace.Name)
+		}
+
+		return namespaceList.Continue, len(namespaceList.Items), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return names, truncated
+}
+
+func TestCachedKubeClientService_ListFollowsTheContinueTokens(t *testing.T) {
+	api := &fakeKubernetesAPI{namespaces: 5}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	service := newKubeClientService(t, 10, 2, 100)
+
+	names, truncated := listNamespaces(t, service, newKubeconfig(server.URL, "token"))
+	if truncated || len(names) != 5 || names[4] != "namespace-4" {
+		t.Fatalf("expected all the namespaces to be listed, got %v (truncated %v)", names, truncated)
+	}
+
+	if strings.Join(api.limits, ",") != "2,2,2" {
+		t.Fatalf("expected three pages of two namespaces to be requested, got the limits %v", api.limits)
+	}
+}
+
+func TestCachedKubeClientService_ListStopsAtTheMaximumNumberOfItems(t *testing.T) {
+	api := &fakeKubernetesAPI{namespaces: 5}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	service := newKubeClientService(t, 10, 2, 3)
+
+	names, truncated := listNamespaces(t, service, newKubeconfig(server.URL, "token"))
+	if !truncated || len(names) != 3 {
+		t.Fatalf("expected the namespaces to be truncated to 3, got %v (truncated %v)", names, truncated)
+	}
+
+	if strings.Join(api.limits, ",") != "2,1" {
+		t.Fatalf("expected the last page to be limited to the remaining item, got the limits %v", api.limits)
+	}
+}
+
+func TestCachedKubeClientService_MetadataClientRequestsOnlyTheMetadata(t *testing.T) {
+	api := &fakeKubernetesAPI{namespaces: 2}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	service := newKubeClientService(t, 10, 100, 100)
+
+	client, err := service.GetMetadataClient("e1", newKubeconfig(server.URL, "token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := client.Resource(corev1.SchemeGroupVersion.WithResource("namespaces")).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list.Items) != 2 || list.Items[0].Name != "namespace-0" {
+		t.Fatalf("unexpected metadata list %v", list.Items)
+	}
+
+	if !strings.Contains(api.acceptHeaders[0], "as=PartialObjectMetadataList") {
+		t.Fatalf("expected only the metadata to be requested, got the Accept header %q", api.acceptHeaders[0])
+	}
+}
+
+func TestCachedKubeClientService_CachesTheClientsPerKubeconfig(t *testing.T) {
+	service := newKubeClientService(t, 1, 100, 100)
+
+	kubeconfig := newKubeconfig("https://127.0.0.1:6443", "token")
+
+	client, err := service.GetClient("e1", kubeconfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cached, _ := service.GetClient("e1", kubeconfig); cached != client {
+		t.Fatal("expected the cached client to be returned for the same kubeconfig")
+	}
+
+	if rebuilt, _ := service.GetClient("e1", newKubeconfig("https://127.0.0.1:6443", "rotated")); rebuilt == client {
+		t.Fatal("expected a new client to be built once the kubeconfig changes")
+	}
+
+	other, err := service.GetClient("e2", kubeconfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if evicted, _ := service.GetClient("e1", kubeconfig); evicted == client || evicted == other {
+		t.Fatal("expected the least recently used client to be evicted")
+	}
+
+	if _, err := service.GetClient("e3", ""); err == nil {
+		t.Fatal("expected the missing kubeconfig to be rejected")
+	}
+}
+
+// writeCertificateFiles writes the self signed certificate and its key to the files of the gateway the kubeconfig
+// could reference
+func writeCertificateFiles(t *testing.T) (certificateFile string, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gateway"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encodedKey, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificateFile = filepath.Join(t.TempDir(), "tls.crt")
+	keyFile = filepath.Join(t.TempDir(), "tls.key")
+
+	if err := ioutil.WriteFile(certificateFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: encodedKey}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certificateFile, keyFile
+}
+
+func TestCachedKubeClientService_RejectsTheKubeconfigsThatRunCommandsOrReadFiles(t *testing.T) {
+	certificateFile, keyFile := writeCertificateFiles(t)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("gateway-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		oldSection string
+		newSection string
+	}{
+		{name: "exec plugin", oldSection: "    token: token\n", newSection: `    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: /bin/sh
+      args: ["-c", "touch /tmp/kubeconfig-exec"]
+`},
+		{name: "auth provider plugin", oldSection: "    token: token\n", newSection: `    auth-provider:
+      name: oidc
+      config:
+        idp-issuer-url: https://issuer.example.com
+`},
+		{name: "token file", oldSection: "    token: token\n", newSection: fmt.Sprintf("    tokenFile: %s\n", tokenFile)},
+		{name: "client certificate file", oldSection: "    token: token\n", newSection: fmt.Sprintf("    client-certificate: %s\n    client-key: %s\n", certificateFile, keyFile)},
+		{name: "certificate authority file", oldSection: "    server: https://127.0.0.1:6443\n", newSection: fmt.Sprintf("    server: https://127.0.0.1:6443\n    certificate-authority: %s\n", certificateFile)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newKubeClientService(t, 10, 100, 100)
+			kubeconfig := strings.Replace(newKubeconfig("https://127.0.0.1:6443", "token"), test.oldSection, test.newSection, 1)
+
+			if _, err := service.GetClient("e1", kubeconfig); err == nil {
+				t.Fatal("expected the kubeconfig to be rejected")
+			}
+
+			if _, err := service.GetMetadataClient("e1", kubeconfig); err == nil {
+				t.Fatal("expected the kubeconfig to be rejected")
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Package kubeclient implements the service that creates the clients used to read the edge cluster resources directly from the edge cluster Kubernetes API
+package kubeclient
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+)
+
+// ListPageFunc lists a single page of the edge cluster objects
+// ctx: Mandatory. Reference to the context
+// options: Mandatory. The list options that contain the page size and the continue token of the page
+// Returns the continue token of the next page, empty if there are no more pages, and the number of the listed objects or error if something goes wrong
+type ListPageFunc func(ctx context.Context, options metav1.ListOptions) (continueToken string, count int, err error)
+
+// KubeClientContract declares the service that creates the Kubernetes clients of the edge clusters
+type KubeClientContract interface {
+	// GetClient returns the Kubernetes client of the edge cluster. The client is built from the edge cluster kubeconfig
+	// and cached, a new client is built once the kubeconfig of the edge cluster changes.
+	// edgeClusterID: Mandatory. The edge cluster unique identifier
+	// kubeconfigContent: Mandatory. The edge cluster kubeconfig content
+	// Returns the Kubernetes client or error if something goes wrong
+	GetClient(edgeClusterID string, kubeconfigContent string) (kubernetes.Interface, error)
+
+	// GetMetadataClient returns the Kubernetes client of the edge cluster that reads only the metadata of the objects. The
+	// client is cached along with the client returned by GetClient.
+	// edgeClusterID: Mandatory. The edge cluster unique identifier
+	// kubeconfigContent: Mandatory. The edge cluster kubeconfig content
+	// Returns the Kubernetes metadata client or error if something goes wrong
+	GetMetadataClient(edgeClusterID string, kubeconfigContent string) (metadata.Interface, error)
+
+	// List lists the edge cluster objects page by page using the configured page size, until all the objects are listed
+	// or the configured maximum number of the listed objects is reached
+	// ctx: Mandatory. Reference to the context
+	// listPage: Mandatory. Lists a single page of the edge cluster objects
+	// Returns true if the list was truncated as the maximum number of the listed objects was reached, otherwise false, or error if something goes wrong
+	List(ctx context.Context, listPage ListPageFunc) (bool, error)
+}
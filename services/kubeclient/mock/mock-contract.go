@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/kubeclient/contract.go
+
+// Package mock_kubeclient is a generated GoMock package.
+package mock_kubeclient
+
+import (
+	context "context"
+	reflect "reflect"
+
+	kubeclient "github.com/decentralized-cloud/api-gateway/services/kubeclient"
+	gomock "github.com/golang/mock/gomock"
+	kubernetes "k8s.io/client-go/kubernetes"
+	metadata "k8s.io/client-go/metadata"
+)
+
+// MockKubeClientContract is a mock of KubeClientContract interface.
+type MockKubeClientContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockKubeClientContractMockRecorder
+}
+
+// MockKubeClientContractMockRecorder is the mock recorder for MockKubeClientContract.
+type MockKubeClientContractMockRecorder struct {
+	mock *MockKubeClientContract
+}
+
+// NewMockKubeClientContract creates a new mock instance.
+func NewMockKubeClientContract(ctrl *gomock.Controller) *MockKubeClientContract {
+	mock := &MockKubeClientContract{ctrl: ctrl}
+	mock.recorder = &MockKubeClientContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKubeClientContract) EXPECT() *MockKubeClientContractMockRecorder {
+	return m.recorder
+}
+
+// GetClient mocks base method.
+func (m *MockKubeClientContract) GetClient(edgeClusterID, kubeconfigContent string) (kubernetes.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClient", edgeClusterID, kubeconfigContent)
+	ret0, _ := ret[0].(kubernetes.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClient indicates an expected call of GetClient.
+func (mr *MockKubeClientContractMockRecorder) GetClient(edgeClusterID, kubeconfigContent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockKubeClientContract)(nil).GetClient), edgeClusterID, kubeconfigContent)
+}
+
+// GetMetadataClient mocks base method.
+func (m *MockKubeClientContract) GetMetadataClient(edgeClusterID, kubeconfigContent string) (metadata.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadataClient", edgeClusterID, kubeconfigContent)
+	ret0, _ := ret[0].(metadata.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetadataClient indicates an expected call of GetMetadataClient.
+func (mr *MockKubeClientContractMockRecorder) GetMetadataClient(edgeClusterID, kubeconfigContent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadataClient", reflect.TypeOf((*MockKubeClientContract)(nil).GetMetadataClient), edgeClusterID, kubeconfigContent)
+}
+
+// List mocks base method.
+func (m *MockKubeClientContract) List(ctx context.Context, listPage kubeclient.ListPageFunc) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, listPage)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockKubeClientContractMockRecorder) List(ctx, listPage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKubeClientContract)(nil).List), ctx, listPage)
+}
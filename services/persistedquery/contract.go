@@ -0,0 +1,57 @@
+// Package persistedquery implements the automatic persisted queries protocol and the allow-list of the GraphQL queries the api-gateway executes
+package persistedquery
+
+import (
+	"context"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+const (
+	// ErrorCodePersistedQueryNotFound is reported when the requested hash is not registered. The client is expected
+	// to send the request again including the query text to register it.
+	ErrorCodePersistedQueryNotFound = "PERSISTED_QUERY_NOT_FOUND"
+
+	// ErrorCodePersistedQueryNotSupported is reported when the client uses an unsupported version of the protocol
+	ErrorCodePersistedQueryNotSupported = "PERSISTED_QUERY_NOT_SUPPORTED"
+
+	// ErrorCodePersistedQueryHashMismatch is reported when the provided hash is not the SHA-256 hash of the provided query text
+	ErrorCodePersistedQueryHashMismatch = "PERSISTED_QUERY_HASH_MISMATCH"
+
+	// ErrorCodePersistedQueryNotAllowed is reported in allow-list mode when the query is not in the allow-list
+	ErrorCodePersistedQueryNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+)
+
+// PersistedQuery contains the persistedQuery request extension as defined by the automatic persisted queries protocol
+type PersistedQuery struct {
+	Version    int    `json:"version"`
+	Sha256Hash string `json:"sha256Hash"`
+}
+
+// PersistedQueryContract declares the service that resolves the query text of the GraphQL requests
+type PersistedQueryContract interface {
+	// ResolveQuery returns the query text to execute. The query text is looked up by its hash if the client
+	// only sent the hash, and registered if the client sent both the query text and its hash. In allow-list
+	// mode only the queries in the allow-list are returned.
+	// ctx: Mandatory. Reference to the context
+	// query: Optional. The query text sent by the client
+	// persistedQuery: Optional. The persistedQuery extension sent by the client
+	// Returns the query text to execute or the error explaining why the request must be rejected
+	ResolveQuery(ctx context.Context, query string, persistedQuery *PersistedQuery) (string, *gqlerrors.QueryError)
+}
+
+// PersistedQueryStoreContract declares the store that keeps the registered persisted queries
+type PersistedQueryStoreContract interface {
+	// Get looks up the query text registered for the given hash
+	// ctx: Mandatory. Reference to the context
+	// sha256Hash: Mandatory. The SHA-256 hash of the query text
+	// Returns the query text, true if the query text is found, otherwise false, or error if something goes wrong
+	Get(ctx context.Context, sha256Hash string) (string, bool, error)
+
+	// Put registers the query text for the given hash
+	// ctx: Mandatory. Reference to the context
+	// sha256Hash: Mandatory. The SHA-256 hash of the query text
+	// query: Mandatory. The query text
+	// Returns error if something goes wrong
+	Put(ctx context.Context, sha256Hash string, query string) error
+}
@@ -0,0 +1,95 @@
+// Package persistedquery implements the automatic persisted queries protocol and the allow-list of the GraphQL queries the api-gateway executes
+package persistedquery
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+type lruEntry struct {
+	sha256Hash string
+	query      string
+}
+
+type lruPersistedQueryStore struct {
+	capacity int
+	lock     sync.Mutex
+	entries  *list.List
+	index    map[string]*list.Element
+}
+
+// NewLruPersistedQueryStore creates new instance of the lruPersistedQueryStore, setting up all dependencies and returns the instance.
+// The store keeps the queries in memory and evicts the least recently used query once the capacity is reached.
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new service or error if something goes wrong
+func NewLruPersistedQueryStore(configurationService configuration.ConfigurationContract) (PersistedQueryStoreContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	capacity, err := configurationService.GetPersistedQueryCacheSize()
+	if err != nil {
+		return nil, err
+	}
+
+	if capacity <= 0 {
+		return nil, commonErrors.NewUnknownError("PERSISTED_QUERY_CACHE_SIZE must be greater than zero")
+	}
+
+	return &lruPersistedQueryStore{
+		capacity: capacity,
+		entries:  list.New(),
+		index:    map[string]*list.Element{},
+	}, nil
+}
+
+// Get looks up the query text registered for the given hash
+// ctx: Mandatory. Reference to the context
+// sha256Hash: Mandatory. The SHA-256 hash of the query text
+// Returns the query text, true if the query text is found, otherwise false, or error if something goes wrong
+func (store *lruPersistedQueryStore) Get(ctx context.Context, sha256Hash string) (string, bool, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	element, ok := store.index[sha256Hash]
+	if !ok {
+		return "", false, nil
+	}
+
+	store.entries.MoveToFront(element)
+
+	return element.Value.(*lruEntry).query, true, nil
+}
+
+// Put registers the query text for the given hash
+// ctx: Mandatory. Reference to the context
+// sha256Hash: Mandatory. The SHA-256 hash of the query text
+// query: Mandatory. The query text
+// Returns error if something goes wrong
+func (store *lruPersistedQueryStore) Put(ctx context.Context, sha256Hash string, query string) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if element, ok := store.index[sha256Hash]; ok {
+		store.entries.MoveToFront(element)
+
+		return nil
+	}
+
+	store.index[sha256Hash] = store.entries.PushFront(&lruEntry{
+		sha256Hash: sha256Hash,
+		query:      query,
+	})
+
+	if store.entries.Len() > store.capacity {
+		oldest := store.entries.Back()
+		store.entries.Remove(oldest)
+		delete(store.index, oldest.Value.(*lruEntry).sha256Hash)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/persistedquery/contract.go
+
+// Package mock_persistedquery is a generated GoMock package.
+package mock_persistedquery
+
+import (
+	context "context"
+	reflect "reflect"
+
+	persistedquery "github.com/decentralized-cloud/api-gateway/services/persistedquery"
+	gomock "github.com/golang/mock/gomock"
+	errors "github.com/graph-gophers/graphql-go/errors"
+)
+
+// MockPersistedQueryContract is a mock of PersistedQueryContract interface.
+type MockPersistedQueryContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersistedQueryContractMockRecorder
+}
+
+// MockPersistedQueryContractMockRecorder is the mock recorder for MockPersistedQueryContract.
+type MockPersistedQueryContractMockRecorder struct {
+	mock *MockPersistedQueryContract
+}
+
+// NewMockPersistedQueryContract creates a new mock instance.
+func NewMockPersistedQueryContract(ctrl *gomock.Controller) *MockPersistedQueryContract {
+	mock := &MockPersistedQueryContract{ctrl: ctrl}
+	mock.recorder = &MockPersistedQueryContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersistedQueryContract) EXPECT() *MockPersistedQueryContractMockRecorder {
+	return m.recorder
+}
+
+// ResolveQuery mocks base method.
+func (m *MockPersistedQueryContract) ResolveQuery(ctx context.Context, query string, persistedQuery *persistedquery.PersistedQuery) (string, *errors.QueryError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveQuery", ctx, query, persistedQuery)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*errors.QueryError)
+	return ret0, ret1
+}
+
+// ResolveQuery indicates an expected call of ResolveQuery.
+func (mr *MockPersistedQueryContractMockRecorder) ResolveQuery(ctx, query, persistedQuery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveQuery", reflect.TypeOf((*MockPersistedQueryContract)(nil).ResolveQuery), ctx, query, persistedQuery)
+}
+
+// MockPersistedQueryStoreContract is a mock of PersistedQueryStoreContract interface.
+type MockPersistedQueryStoreContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersistedQueryStoreContractMockRecorder
+}
+
+// MockPersistedQueryStoreContractMockRecorder is the mock recorder for MockPersistedQueryStoreContract.
+type MockPersistedQueryStoreContractMockRecorder struct {
+	mock *MockPersistedQueryStoreContract
+}
+
+// NewMockPersistedQueryStoreContract creates a new mock instance.
+func NewMockPersistedQueryStoreContract(ctrl *gomock.Controller) *MockPersistedQueryStoreContract {
+	mock := &MockPersistedQueryStoreContract{ctrl: ctrl}
+	mock.recorder = &MockPersistedQueryStoreContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersistedQueryStoreContract) EXPECT() *MockPersistedQueryStoreContractMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockPersistedQueryStoreContract) Get(ctx context.Context, sha256Hash string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, sha256Hash)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPersistedQueryStoreContractMockRecorder) Get(ctx, sha256Hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPersistedQueryStoreContract)(nil).Get), ctx, sha256Hash)
+}
+
+// Put mocks base method.
+func (m *MockPersistedQueryStoreContract) Put(ctx context.Context, sha256Hash, query string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, sha256Hash, query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockPersistedQueryStoreContractMockRecorder) Put(ctx, sha256Hash, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockPersistedQueryStoreContract)(nil).Put), ctx, sha256Hash, query)
+}
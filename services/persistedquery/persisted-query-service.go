@@ -0,0 +1,168 @@
+// Package persistedquery implements the automatic persisted queries protocol and the allow-list of the GraphQL queries the api-gateway executes
+package persistedquery
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+// supportedVersion is the only version of the automatic persisted queries protocol defined so far
+const supportedVersion = 1
+
+type persistedQueryService struct {
+	logger    *zap.Logger
+	store     PersistedQueryStoreContract
+	allowList map[string]string
+}
+
+// NewPersistedQueryService creates new instance of the persistedQueryService, setting up all dependencies and returns the instance.
+// If the allow-list file is configured, the allow-listed queries are loaded and the registration of new queries is disabled.
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// store: Mandatory. Reference to the store that keeps the registered persisted queries
+// Returns the new service or error if something goes wrong
+func NewPersistedQueryService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	store PersistedQueryStoreContract) (PersistedQueryContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if store == nil {
+		return nil, commonErrors.NewArgumentNilError("store", "store is required")
+	}
+
+	allowListFile, err := configurationService.GetPersistedQueryAllowListFile()
+	if err != nil {
+		return nil, err
+	}
+
+	service := &persistedQueryService{
+		logger: logger,
+		store:  store,
+	}
+
+	if strings.Trim(allowListFile, " ") != "" {
+		if service.allowList, err = loadAllowList(allowListFile); err != nil {
+			return nil, err
+		}
+
+		logger.Info("Persisted query allow-list loaded", zap.String("file", allowListFile), zap.Int("queries", len(service.allowList)))
+	}
+
+	return service, nil
+}
+
+// ResolveQuery returns the query text to execute. The query text is looked up by its hash if the client
+// only sent the hash, and registered if the client sent both the query text and its hash. In allow-list
+// mode only the queries in the allow-list are returned.
+// ctx: Mandatory. Reference to the context
+// query: Optional. The query text sent by the client
+// persistedQuery: Optional. The persistedQuery extension sent by the client
+// Returns the query text to execute or the error explaining why the request must be rejected
+func (service *persistedQueryService) ResolveQuery(
+	ctx context.Context,
+	query string,
+	persistedQuery *PersistedQuery) (string, *gqlerrors.QueryError) {
+	if persistedQuery == nil {
+		if service.allowList != nil {
+			if _, ok := service.allowList[hashQuery(query)]; !ok {
+				return "", newQueryError(ErrorCodePersistedQueryNotAllowed, "PersistedQueryNotAllowed")
+			}
+		}
+
+		return query, nil
+	}
+
+	if persistedQuery.Version != supportedVersion {
+		return "", newQueryError(ErrorCodePersistedQueryNotSupported, fmt.Sprintf("Unsupported persisted query version %d", persistedQuery.Version))
+	}
+
+	sha256Hash := strings.ToLower(persistedQuery.Sha256Hash)
+
+	if query != "" && hashQuery(query) != sha256Hash {
+		return "", newQueryError(ErrorCodePersistedQueryHashMismatch, "Provided sha256Hash does not match query")
+	}
+
+	if service.allowList != nil {
+		allowListedQuery, ok := service.allowList[sha256Hash]
+		if !ok {
+			return "", newQueryError(ErrorCodePersistedQueryNotAllowed, "PersistedQueryNotAllowed")
+		}
+
+		return allowListedQuery, nil
+	}
+
+	if query != "" {
+		if err := service.store.Put(ctx, sha256Hash, query); err != nil {
+			// Failing to register the query must not fail the request as the query text is already provided
+			service.logger.Warn("Failed to register the persisted query", zap.String("sha256Hash", sha256Hash), zap.Error(err))
+		}
+
+		return query, nil
+	}
+
+	storedQuery, found, err := service.store.Get(ctx, sha256Hash)
+	if err != nil {
+		service.logger.Warn("Failed to look up the persisted query", zap.String("sha256Hash", sha256Hash), zap.Error(err))
+	}
+
+	if !found {
+		// The message is part of the protocol, clients look for it to send the query text again
+		return "", newQueryError(ErrorCodePersistedQueryNotFound, "PersistedQueryNotFound")
+	}
+
+	return storedQuery, nil
+}
+
+// loadAllowList reads the allow-list file that contains a JSON object mapping the SHA-256 hash of every allowed query to its text
+func loadAllowList(allowListFile string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(allowListFile)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to read the persisted query allow-list file %s", allowListFile), err)
+	}
+
+	var queries map[string]string
+	if err := json.Unmarshal(content, &queries); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to parse the persisted query allow-list file %s", allowListFile), err)
+	}
+
+	allowList := make(map[string]string, len(queries))
+	for sha256Hash, query := range queries {
+		if hashQuery(query) != strings.ToLower(sha256Hash) {
+			return nil, commonErrors.NewUnknownError(fmt.Sprintf("The persisted query allow-list contains a query that does not match its hash %s", sha256Hash))
+		}
+
+		allowList[strings.ToLower(sha256Hash)] = query
+	}
+
+	return allowList, nil
+}
+
+func hashQuery(query string) string {
+	sum := sha256.Sum256([]byte(query))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func newQueryError(code string, message string) *gqlerrors.QueryError {
+	return &gqlerrors.QueryError{
+		Message:    message,
+		Extensions: map[string]interface{}{"code": code},
+	}
+}
@@ -0,0 +1,90 @@
+package persistedquery_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/persistedquery"
+	"github.com/golang/mock/gomock"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"go.uber.org/zap"
+)
+
+const query = "{ user { id } }"
+
+func TestPersistedQueryService_AutomaticPersistedQueries(t *testing.T) {
+	service := newPersistedQueryService(t)
+	ctx := context.Background()
+
+	_, queryError := service.ResolveQuery(ctx, "", &persistedquery.PersistedQuery{Version: 1, Sha256Hash: hashQuery(query)})
+	assertErrorCode(t, queryError, persistedquery.ErrorCodePersistedQueryNotFound)
+
+	if queryError.Message != "PersistedQueryNotFound" {
+		t.Fatalf("expected the message defined by the protocol, got %s", queryError.Message)
+	}
+
+	resolvedQuery, queryError := service.ResolveQuery(ctx, query, &persistedquery.PersistedQuery{Version: 1, Sha256Hash: hashQuery(query)})
+	if queryError != nil || resolvedQuery != query {
+		t.Fatalf("expected the query to be registered, got %s %v", resolvedQuery, queryError)
+	}
+
+	resolvedQuery, queryError = service.ResolveQuery(ctx, "", &persistedquery.PersistedQuery{Version: 1, Sha256Hash: strings.ToUpper(hashQuery(query))})
+	if queryError != nil || resolvedQuery != query {
+		t.Fatalf("expected the registered query to be resolved by its hash, got %s %v", resolvedQuery, queryError)
+	}
+
+	_, queryError = service.ResolveQuery(ctx, "", &persistedquery.PersistedQuery{Version: 2, Sha256Hash: hashQuery(query)})
+	assertErrorCode(t, queryError, persistedquery.ErrorCodePersistedQueryNotSupported)
+}
+
+func TestPersistedQueryService_RejectsTheHashMismatch(t *testing.T) {
+	service := newPersistedQueryService(t)
+	ctx := context.Background()
+	otherQuery := "{ user { projects { edges { node { id } } } } }"
+
+	_, queryError := service.ResolveQuery(ctx, otherQuery, &persistedquery.PersistedQuery{Version: 1, Sha256Hash: hashQuery(query)})
+	assertErrorCode(t, queryError, persistedquery.ErrorCodePersistedQueryHashMismatch)
+
+	// The query sent with the hash of another query must not be registered under that hash
+	_, queryError = service.ResolveQuery(ctx, "", &persistedquery.PersistedQuery{Version: 1, Sha256Hash: hashQuery(query)})
+	assertErrorCode(t, queryError, persistedquery.ErrorCodePersistedQueryNotFound)
+}
+
+func newPersistedQueryService(t *testing.T) persistedquery.PersistedQueryContract {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetPersistedQueryCacheSize().Return(10, nil)
+	configurationService.EXPECT().GetPersistedQueryAllowListFile().Return("", nil)
+
+	store, err := persistedquery.NewLruPersistedQueryStore(configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service, err := persistedquery.NewPersistedQueryService(zap.NewNop(), configurationService, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return service
+}
+
+func hashQuery(query string) string {
+	sum := sha256.Sum256([]byte(query))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func assertErrorCode(t *testing.T, queryError *gqlerrors.QueryError, code string) {
+	t.Helper()
+
+	if queryError == nil || queryError.Extensions["code"] != code {
+		t.Fatalf("expected the %s error code, got %v", code, queryError)
+	}
+}
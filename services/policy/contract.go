@@ -0,0 +1,89 @@
+// Package policy implements the policy engines that decide whether the callers are allowed to perform actions on the projects
+package policy
+
+import "context"
+
+// Action is the operation the caller performs on a project
+type Action string
+
+const (
+	// ActionCreateProject creates a new project. The action does not target an existing project.
+	ActionCreateProject Action = "project:create"
+
+	// ActionUpdateProject updates an existing project
+	ActionUpdateProject Action = "project:update"
+
+	// ActionDeleteProject deletes an existing project
+	ActionDeleteProject Action = "project:delete"
+
+	// ActionCreateEdgeCluster creates a new edge cluster in the project
+	ActionCreateEdgeCluster Action = "edgecluster:create"
+
+	// ActionUpdateEdgeCluster updates an existing edge cluster of the project
+	ActionUpdateEdgeCluster Action = "edgecluster:update"
+
+	// ActionDeleteEdgeCluster deletes an existing edge cluster of the project
+	ActionDeleteEdgeCluster Action = "edgecluster:delete"
+)
+
+// Role is the role a user is granted on a project
+type Role string
+
+const (
+	// RoleViewer can read the project and its edge clusters
+	RoleViewer Role = "viewer"
+
+	// RoleEditor can additionally update the project and manage its edge clusters
+	RoleEditor Role = "editor"
+
+	// RoleOwner can additionally delete the project
+	RoleOwner Role = "owner"
+)
+
+// PolicyEngineContract declares the engine that decides whether a subject is allowed to perform an action on a project
+type PolicyEngineContract interface {
+	// IsAllowed decides whether the subject is allowed to perform the action on the project
+	// ctx: Mandatory. Reference to the context
+	// subject: Mandatory. The unique identifier of the authenticated user
+	// projectID: Optional. The project unique identifier, empty if the action does not target an existing project
+	// action: Mandatory. The action the subject performs
+	// Returns true if the action is allowed, otherwise false, or error if something goes wrong
+	IsAllowed(ctx context.Context, subject string, projectID string, action Action) (bool, error)
+
+	// GrantProjectOwner grants the owner role on the newly created project to the subject that created it
+	// ctx: Mandatory. Reference to the context
+	// subject: Mandatory. The unique identifier of the authenticated user that created the project
+	// projectID: Mandatory. The project unique identifier
+	// Returns error if something goes wrong
+	GrantProjectOwner(ctx context.Context, subject string, projectID string) error
+
+	// RemoveProject removes the roles granted on the deleted project
+	// ctx: Mandatory. Reference to the context
+	// projectID: Mandatory. The project unique identifier
+	// Returns error if something goes wrong
+	RemoveProject(ctx context.Context, projectID string) error
+}
+
+// RoleBindingStoreContract declares the store that keeps the roles the users are granted on the projects
+type RoleBindingStoreContract interface {
+	// GetRole returns the role the subject is granted on the project
+	// ctx: Mandatory. Reference to the context
+	// subject: Mandatory. The unique identifier of the user
+	// projectID: Mandatory. The project unique identifier
+	// Returns the granted role, true if the subject is granted a role on the project, otherwise false, or error if something goes wrong
+	GetRole(ctx context.Context, subject string, projectID string) (Role, bool, error)
+
+	// Bind grants the role on the project to the subject, replacing the role previously granted to the subject on the project
+	// ctx: Mandatory. Reference to the context
+	// subject: Mandatory. The unique identifier of the user
+	// projectID: Mandatory. The project unique identifier
+	// role: Mandatory. The role granted to the subject
+	// Returns error if something goes wrong
+	Bind(ctx context.Context, subject string, projectID string, role Role) error
+
+	// UnbindProject removes the roles granted to all the subjects on the project
+	// ctx: Mandatory. Reference to the context
+	// projectID: Mandatory. The project unique identifier
+	// Returns error if something goes wrong
+	UnbindProject(ctx context.Context, projectID string) error
+}
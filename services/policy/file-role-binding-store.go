@@ -0,0 +1,257 @@
+// Package policy implements the policy engines that decide whether the callers are allowed to perform actions on the projects
+package policy
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+)
+
+type fileRoleBindingStore struct {
+	logger       *zap.Logger
+	lock         sync.Mutex
+	path         string
+	modTime      time.Time
+	roleBindings map[string]map[string]Role
+}
+
+// NewFileRoleBindingStore creates new instance of the fileRoleBindingStore, setting up all dependencies and returns the instance.
+// The role bindings are kept in the configured YAML or JSON file that maps every subject to the projects and the role
+// granted on each project. The file is reloaded when it changes, so the role bindings edited by the operators are picked
+// up without a restart, and the roles granted at runtime are written back to the file. The role bindings are only kept
+// in memory if the file is not configured.
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new store or error if something goes wrong
+func NewFileRoleBindingStore(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract) (RoleBindingStoreContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	roleBindingsFile, err := configurationService.GetProjectRoleBindingsFile()
+	if err != nil {
+		return nil, err
+	}
+
+	store := &fileRoleBindingStore{
+		logger:       logger,
+		path:         strings.Trim(roleBindingsFile, " "),
+		roleBindings: map[string]map[string]Role{},
+	}
+
+	if store.path == "" {
+		logger.Warn("Project role bindings file is not configured, the roles granted on the projects are lost on restart")
+
+		return store, nil
+	}
+
+	if err := store.reloadIfChanged(); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Project role bindings loaded", zap.String("file", store.path), zap.Int("subjects", len(store.roleBindings)))
+
+	return store, nil
+}
+
+// GetRole returns the role the subject is granted on the project
+// ctx: Mandatory. Reference to the context
+// subject: Mandatory. The unique identifier of the user
+// projectID: Mandatory. The project unique identifier
+// Returns the granted role, true if the subject is granted a role on the project, otherwise false, or error if something goes wrong
+func (store *fileRoleBindingStore) GetRole(ctx context.Context, subject string, projectID string) (Role, bool, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if err := store.reloadIfChanged(); err != nil {
+		return "", false, err
+	}
+
+	role, ok := store.roleBindings[subject][projectID]
+
+	return role, ok, nil
+}
+
+// Bind grants the role on the project to the subject, replacing the role previously granted to the subject on the project
+// ctx: Mandatory. Reference to the context
+// subject: Mandatory. The unique identifier of the user
+// projectID: Mandatory. The project unique identifier
+// role: Mandatory. The role granted to the subject
+// Returns error if something goes wrong
+func (store *fileRoleBindingStore) Bind(ctx context.Context, subject string, projectID string, role Role) error {
+	if strings.Trim(subject, " ") == "" {
+		return commonErrors.NewArgumentError("subject", "subject is required")
+	}
+
+	if strings.Trim(projectID, " ") == "" {
+		return commonErrors.NewArgumentError("projectID", "projectID is required")
+	}
+
+	if _, ok := roleRanks[role]; !ok {
+		return commonErrors.NewArgumentError("role", fmt.Sprintf("Unknown role %s", role))
+	}
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if err := store.reloadIfChanged(); err != nil {
+		return err
+	}
+
+	if _, ok := store.roleBindings[subject]; !ok {
+		store.roleBindings[subject] = map[string]Role{}
+	}
+
+	store.roleBindings[subject][projectID] = role
+
+	return store.save()
+}
+
+// UnbindProject removes the roles granted to all the subjects on the project
+// ctx: Mandatory. Reference to the context
+// projectID: Mandatory. The project unique identifier
+// Returns error if something goes wrong
+func (store *fileRoleBindingStore) UnbindProject(ctx context.Context, projectID string) error {
+	if strings.Trim(projectID, " ") == "" {
+		return commonErrors.NewArgumentError("projectID", "projectID is required")
+	}
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if err := store.reloadIfChanged(); err != nil {
+		return err
+	}
+
+	for subject, projectRoles := range store.roleBindings {
+		delete(projectRoles, projectID)
+
+		if len(projectRoles) == 0 {
+			delete(store.roleBindings, subject)
+		}
+	}
+
+	return store.save()
+}
+
+// reloadIfChanged reloads the role bindings if the file changed since it was last read or written. A missing file is
+// treated as a file without any role bindings as the file is created once the first role is granted.
+func (store *fileRoleBindingStore) reloadIfChanged() error {
+	if store.path == "" {
+		return nil
+	}
+
+	fileInfo, err := os.Stat(store.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to read the project role bindings file %s", store.path), err)
+	}
+
+	if fileInfo.ModTime().Equal(store.modTime) {
+		return nil
+	}
+
+	roleBindings, err := loadRoleBindings(store.path)
+	if err != nil {
+		return err
+	}
+
+	store.roleBindings = roleBindings
+	store.modTime = fileInfo.ModTime()
+
+	store.logger.Info("Project role bindings reloaded", zap.String("file", store.path), zap.Int("subjects", len(roleBindings)))
+
+	return nil
+}
+
+// save writes the role bindings to a temporary file that then replaces the role bindings file, so the file is never
+// read while it is partially written
+func (store *fileRoleBindingStore) save() error {
+	if store.path == "" {
+		return nil
+	}
+
+	content, err := yaml.Marshal(store.roleBindings)
+	if err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(store.path), filepath.Base(store.path)+".*")
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to write the project role bindings file %s", store.path), err)
+	}
+
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(file.Name(), store.path)
+	}
+
+	if err != nil {
+		_ = os.Remove(file.Name())
+
+		return commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to write the project role bindings file %s", store.path), err)
+	}
+
+	fileInfo, err := os.Stat(store.path)
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to read the project role bindings file %s", store.path), err)
+	}
+
+	store.modTime = fileInfo.ModTime()
+
+	return nil
+}
+
+// loadRoleBindings reads the role bindings file. JSON is accepted as well as it is a subset of YAML.
+func loadRoleBindings(roleBindingsFile string) (map[string]map[string]Role, error) {
+	content, err := ioutil.ReadFile(roleBindingsFile)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to read the project role bindings file %s", roleBindingsFile), err)
+	}
+
+	var roleBindings map[string]map[string]Role
+	if err := yaml.Unmarshal(content, &roleBindings); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("Failed to parse the project role bindings file %s", roleBindingsFile), err)
+	}
+
+	for subject, projectRoles := range roleBindings {
+		for projectID, role := range projectRoles {
+			if _, ok := roleRanks[role]; !ok {
+				return nil, commonErrors.NewUnknownError(fmt.Sprintf("Unknown role %s granted to %s on project %s", role, subject, projectID))
+			}
+		}
+
+		if projectRoles == nil {
+			roleBindings[subject] = map[string]Role{}
+		}
+	}
+
+	if roleBindings == nil {
+		roleBindings = map[string]map[string]Role{}
+	}
+
+	return roleBindings, nil
+}
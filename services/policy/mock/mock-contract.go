@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/policy/contract.go
+
+// Package mock_policy is a generated GoMock package.
+package mock_policy
+
+import (
+	context "context"
+	reflect "reflect"
+
+	policy "github.com/decentralized-cloud/api-gateway/services/policy"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPolicyEngineContract is a mock of PolicyEngineContract interface.
+type MockPolicyEngineContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyEngineContractMockRecorder
+}
+
+// MockPolicyEngineContractMockRecorder is the mock recorder for MockPolicyEngineContract.
+type MockPolicyEngineContractMockRecorder struct {
+	mock *MockPolicyEngineContract
+}
+
+// NewMockPolicyEngineContract creates a new mock instance.
+func NewMockPolicyEngineContract(ctrl *gomock.Controller) *MockPolicyEngineContract {
+	mock := &MockPolicyEngineContract{ctrl: ctrl}
+	mock.recorder = &MockPolicyEngineContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyEngineContract) EXPECT() *MockPolicyEngineContractMockRecorder {
+	return m.recorder
+}
+
+// GrantProjectOwner mocks base method.
+func (m *MockPolicyEngineContract) GrantProjectOwner(ctx context.Context, subject, projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantProjectOwner", ctx, subject, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantProjectOwner indicates an expected call of GrantProjectOwner.
+func (mr *MockPolicyEngineContractMockRecorder) GrantProjectOwner(ctx, subject, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantProjectOwner", reflect.TypeOf((*MockPolicyEngineContract)(nil).GrantProjectOwner), ctx, subject, projectID)
+}
+
+// IsAllowed mocks base method.
+func (m *MockPolicyEngineContract) IsAllowed(ctx context.Context, subject, projectID string, action policy.Action) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAllowed", ctx, subject, projectID, action)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAllowed indicates an expected call of IsAllowed.
+func (mr *MockPolicyEngineContractMockRecorder) IsAllowed(ctx, subject, projectID, action interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAllowed", reflect.TypeOf((*MockPolicyEngineContract)(nil).IsAllowed), ctx, subject, projectID, action)
+}
+
+// RemoveProject mocks base method.
+func (m *MockPolicyEngineContract) RemoveProject(ctx context.Context, projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveProject", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveProject indicates an expected call of RemoveProject.
+func (mr *MockPolicyEngineContractMockRecorder) RemoveProject(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProject", reflect.TypeOf((*MockPolicyEngineContract)(nil).RemoveProject), ctx, projectID)
+}
+
+// MockRoleBindingStoreContract is a mock of RoleBindingStoreContract interface.
+type MockRoleBindingStoreContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleBindingStoreContractMockRecorder
+}
+
+// MockRoleBindingStoreContractMockRecorder is the mock recorder for MockRoleBindingStoreContract.
+type MockRoleBindingStoreContractMockRecorder struct {
+	mock *MockRoleBindingStoreContract
+}
+
+// NewMockRoleBindingStoreContract creates a new mock instance.
+func NewMockRoleBindingStoreContract(ctrl *gomock.Controller) *MockRoleBindingStoreContract {
+	mock := &MockRoleBindingStoreContract{ctrl: ctrl}
+	mock.recorder = &MockRoleBindingStoreContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleBindingStoreContract) EXPECT() *MockRoleBindingStoreContractMockRecorder {
+	return m.recorder
+}
+
+// Bind mocks base method.
+func (m *MockRoleBindingStoreContract) Bind(ctx context.Context, subject, projectID string, role policy.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bind", ctx, subject, projectID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Bind indicates an expected call of Bind.
+func (mr *MockRoleBindingStoreContractMockRecorder) Bind(ctx, subject, projectID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bind", reflect.TypeOf((*MockRoleBindingStoreContract)(nil).Bind), ctx, subject, projectID, role)
+}
+
+// GetRole mocks base method.
+func (m *MockRoleBindingStoreContract) GetRole(ctx context.Context, subject, projectID string) (policy.Role, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, subject, projectID)
+	ret0, _ := ret[0].(policy.Role)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockRoleBindingStoreContractMockRecorder) GetRole(ctx, subject, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockRoleBindingStoreContract)(nil).GetRole), ctx, subject, projectID)
+}
+
+// UnbindProject mocks base method.
+func (m *MockRoleBindingStoreContract) UnbindProject(ctx context.Context, projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbindProject", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbindProject indicates an expected call of UnbindProject.
+func (mr *MockRoleBindingStoreContractMockRecorder) UnbindProject(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbindProject", reflect.TypeOf((*MockRoleBindingStoreContract)(nil).UnbindProject), ctx, projectID)
+}
@@ -0,0 +1,95 @@
+// Package policy implements the policy engines that decide whether the callers are allowed to perform actions on the projects
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// roleRanks orders the roles so every role is granted the rights of the roles ranked below it
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleOwner:  3,
+}
+
+// requiredRoles contains the minimum role a subject must be granted on the project to perform the action
+var requiredRoles = map[Action]Role{
+	ActionUpdateProject:     RoleEditor,
+	ActionDeleteProject:     RoleOwner,
+	ActionCreateEdgeCluster: RoleEditor,
+	ActionUpdateEdgeCluster: RoleEditor,
+	ActionDeleteEdgeCluster: RoleEditor,
+}
+
+type roleBindingPolicyEngine struct {
+	roleBindingStore RoleBindingStoreContract
+}
+
+// NewRoleBindingPolicyEngine creates new instance of the roleBindingPolicyEngine, setting up all dependencies and returns the instance.
+// The actions are allowed if the role the subject is granted on the project is at least the role the action requires.
+// Every action that targets a project is denied if the subject is not granted any role on the project.
+// roleBindingStore: Mandatory. Reference to the store that keeps the roles the users are granted on the projects
+// Returns the new service or error if something goes wrong
+func NewRoleBindingPolicyEngine(roleBindingStore RoleBindingStoreContract) (PolicyEngineContract, error) {
+	if roleBindingStore == nil {
+		return nil, commonErrors.NewArgumentNilError("roleBindingStore", "roleBindingStore is required")
+	}
+
+	return &roleBindingPolicyEngine{
+		roleBindingStore: roleBindingStore,
+	}, nil
+}
+
+// IsAllowed decides whether the subject is allowed to perform the action on the project
+// ctx: Mandatory. Reference to the context
+// subject: Mandatory. The unique identifier of the authenticated user
+// projectID: Optional. The project unique identifier, empty if the action does not target an existing project
+// action: Mandatory. The action the subject performs
+// Returns true if the action is allowed, otherwise false, or error if something goes wrong
+func (engine *roleBindingPolicyEngine) IsAllowed(
+	ctx context.Context,
+	subject string,
+	projectID string,
+	action Action) (bool, error) {
+	if strings.Trim(subject, " ") == "" {
+		return false, nil
+	}
+
+	// Any authenticated user can create a project as the action does not target an existing project
+	if action == ActionCreateProject {
+		return true, nil
+	}
+
+	requiredRole, ok := requiredRoles[action]
+	if !ok {
+		return false, commonErrors.NewUnknownError(fmt.Sprintf("Unknown action %s", action))
+	}
+
+	grantedRole, ok, err := engine.roleBindingStore.GetRole(ctx, subject, projectID)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return roleRanks[grantedRole] >= roleRanks[requiredRole], nil
+}
+
+// GrantProjectOwner grants the owner role on the newly created project to the subject that created it
+// ctx: Mandatory. Reference to the context
+// subject: Mandatory. The unique identifier of the authenticated user that created the project
+// projectID: Mandatory. The project unique identifier
+// Returns error if something goes wrong
+func (engine *roleBindingPolicyEngine) GrantProjectOwner(ctx context.Context, subject string, projectID string) error {
+	return engine.roleBindingStore.Bind(ctx, subject, projectID, RoleOwner)
+}
+
+// RemoveProject removes the roles granted on the deleted project
+// ctx: Mandatory. Reference to the context
+// projectID: Mandatory. The project unique identifier
+// Returns error if something goes wrong
+func (engine *roleBindingPolicyEngine) RemoveProject(ctx context.Context, projectID string) error {
+	return engine.roleBindingStore.UnbindProject(ctx, projectID)
+}
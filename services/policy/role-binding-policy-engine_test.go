@@ -0,0 +1,166 @@
+package policy_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/policy"
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func newPolicyEngine(t *testing.T, roleBindingsFile string) (policy.PolicyEngineContract, policy.RoleBindingStoreContract) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetProjectRoleBindingsFile().Return(roleBindingsFile, nil).AnyTimes()
+
+	store, err := policy.NewFileRoleBindingStore(zap.NewNop(), configurationService)
+	if err != nil {
+		t.Fatalf("failed to create the role binding store: %v", err)
+	}
+
+	engine, err := policy.NewRoleBindingPolicyEngine(store)
+	if err != nil {
+		t.Fatalf("failed to create the policy engine: %v", err)
+	}
+
+	return engine, store
+}
+
+func assertAllowed(t *testing.T, engine policy.PolicyEngineContract, subject, projectID string, action policy.Action, expected bool) {
+	t.Helper()
+
+	allowed, err := engine.IsAllowed(context.Background(), subject, projectID, action)
+	if err != nil {
+		t.Fatalf("IsAllowed(%s, %s, %s) failed: %v", subject, projectID, action, err)
+	}
+
+	if allowed != expected {
+		t.Fatalf("IsAllowed(%s, %s, %s) = %v, expected %v", subject, projectID, action, allowed, expected)
+	}
+}
+
+func TestRoleBindingPolicyEngine_DefaultDecisions(t *testing.T) {
+	engine, _ := newPolicyEngine(t, "")
+
+	assertAllowed(t, engine, "user1", "", policy.ActionCreateProject, true)
+	assertAllowed(t, engine, "", "", policy.ActionCreateProject, false)
+
+	for _, action := range []policy.Action{
+		policy.ActionUpdateProject,
+		policy.ActionDeleteProject,
+		policy.ActionCreateEdgeCluster,
+		policy.ActionUpdateEdgeCluster,
+		policy.ActionDeleteEdgeCluster,
+	} {
+		assertAllowed(t, engine, "user1", "p1", action, false)
+	}
+
+	if _, err := engine.IsAllowed(context.Background(), "user1", "p1", policy.Action("project:unknown")); err == nil {
+		t.Fatal("expected unknown action to fail")
+	}
+}
+
+func TestRoleBindingPolicyEngine_CreatorCanManageTheCreatedProject(t *testing.T) {
+	engine, _ := newPolicyEngine(t, "")
+
+	assertAllowed(t, engine, "creator", "", policy.ActionCreateProject, true)
+
+	if err := engine.GrantProjectOwner(context.Background(), "creator", "p-new"); err != nil {
+		t.Fatalf("failed to grant the project ownership: %v", err)
+	}
+
+	assertAllowed(t, engine, "creator", "p-new", policy.ActionUpdateProject, true)
+	assertAllowed(t, engine, "creator", "p-new", policy.ActionCreateEdgeCluster, true)
+	assertAllowed(t, engine, "creator", "p-new", policy.ActionDeleteProject, true)
+
+	assertAllowed(t, engine, "stranger", "p-new", policy.ActionUpdateProject, false)
+	assertAllowed(t, engine, "stranger", "p-new", policy.ActionCreateEdgeCluster, false)
+	assertAllowed(t, engine, "creator", "p-other", policy.ActionUpdateProject, false)
+
+	if err := engine.RemoveProject(context.Background(), "p-new"); err != nil {
+		t.Fatalf("failed to remove the project: %v", err)
+	}
+
+	assertAllowed(t, engine, "creator", "p-new", policy.ActionUpdateProject, false)
+}
+
+func TestRoleBindingPolicyEngine_RoleRanks(t *testing.T) {
+	roleBindingsFile := filepath.Join(t.TempDir(), "role-bindings.yaml")
+	writeFile(t, roleBindingsFile, "viewer:\n  p1: viewer\neditor:\n  p1: editor\nowner:\n  p1: owner\n")
+
+	engine, _ := newPolicyEngine(t, roleBindingsFile)
+
+	assertAllowed(t, engine, "viewer", "p1", policy.ActionUpdateProject, false)
+	assertAllowed(t, engine, "editor", "p1", policy.ActionUpdateProject, true)
+	assertAllowed(t, engine, "editor", "p1", policy.ActionDeleteEdgeCluster, true)
+	assertAllowed(t, engine, "editor", "p1", policy.ActionDeleteProject, false)
+	assertAllowed(t, engine, "owner", "p1", policy.ActionDeleteProject, true)
+}
+
+func TestFileRoleBindingStore_ReloadsTheChangedFile(t *testing.T) {
+	roleBindingsFile := filepath.Join(t.TempDir(), "role-bindings.yaml")
+	writeFile(t, roleBindingsFile, "user1:\n  p1: viewer\n")
+
+	engine, _ := newPolicyEngine(t, roleBindingsFile)
+	assertAllowed(t, engine, "user1", "p1", policy.ActionUpdateProject, false)
+
+	writeFile(t, roleBindingsFile, "user1:\n  p1: editor\n")
+	assertAllowed(t, engine, "user1", "p1", policy.ActionUpdateProject, true)
+}
+
+func TestFileRoleBindingStore_PersistsTheGrantedRoles(t *testing.T) {
+	roleBindingsFile := filepath.Join(t.TempDir(), "role-bindings.yaml")
+
+	engine, _ := newPolicyEngine(t, roleBindingsFile)
+	if err := engine.GrantProjectOwner(context.Background(), "creator", "p-new"); err != nil {
+		t.Fatalf("failed to grant the project ownership: %v", err)
+	}
+
+	restartedEngine, _ := newPolicyEngine(t, roleBindingsFile)
+	assertAllowed(t, restartedEngine, "creator", "p-new", policy.ActionUpdateProject, true)
+}
+
+func TestFileRoleBindingStore_RejectsUnknownRoles(t *testing.T) {
+	roleBindingsFile := filepath.Join(t.TempDir(), "role-bindings.yaml")
+	writeFile(t, roleBindingsFile, "user1:\n  p1: admin\n")
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetProjectRoleBindingsFile().Return(roleBindingsFile, nil)
+
+	if _, err := policy.NewFileRoleBindingStore(zap.NewNop(), configurationService); err == nil {
+		t.Fatal("expected the unknown role to be rejected")
+	}
+
+	_, store := newPolicyEngine(t, "")
+	if err := store.Bind(context.Background(), "user1", "p1", policy.Role("admin")); err == nil {
+		t.Fatal("expected the unknown role to be rejected")
+	}
+}
+
+// writeFile writes the file and moves its modification time forward, so the change is detected even on file systems
+// with a coarse modification time resolution
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	modTime := time.Now()
+	if fileInfo, err := os.Stat(path); err == nil {
+		modTime = fileInfo.ModTime().Add(time.Second)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("failed to change the modification time of %s: %v", path, err)
+	}
+}
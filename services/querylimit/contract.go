@@ -0,0 +1,42 @@
+// Package querylimit implements the services that reject the GraphQL operations exceeding the configured depth, list size and cost limits
+package querylimit
+
+import (
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	// ErrorCodeValidationFailed is reported when the GraphQL document can not be parsed or is not valid against the schema
+	ErrorCodeValidationFailed = "GRAPHQL_VALIDATION_FAILED"
+
+	// ErrorCodeMaxDepthExceeded is reported when the operation selects fields nested deeper than the configured maximum depth
+	ErrorCodeMaxDepthExceeded = "MAX_DEPTH_EXCEEDED"
+
+	// ErrorCodeMaxListSizeExceeded is reported when the first, last or ids argument of a list field exceeds the configured maximum list size
+	ErrorCodeMaxListSizeExceeded = "MAX_LIST_SIZE_EXCEEDED"
+
+	// ErrorCodeMaxCostExceeded is reported when the computed cost of the operation exceeds the configured maximum cost
+	ErrorCodeMaxCostExceeded = "MAX_COST_EXCEEDED"
+)
+
+// QueryAnalysis contains the type and the name of a GraphQL operation along with its computed depth and cost, and the
+// validated document the operation is defined in
+type QueryAnalysis struct {
+	OperationType string
+	OperationName string
+	Depth         int
+	Cost          int
+	Document      *ast.QueryDocument
+	Operation     *ast.OperationDefinition
+}
+
+// QueryLimitContract declares the service that analyses GraphQL operations before they are executed
+type QueryLimitContract interface {
+	// Analyze computes the depth and the cost of the requested operation and verifies them against the configured limits
+	// query: Mandatory. The GraphQL document
+	// operationName: Optional. The name of the operation to analyse if the document contains more than one operation
+	// variables: Optional. The variables provided for the operation
+	// Returns the analysis of the operation and the errors explaining why the operation must be rejected, if any
+	Analyze(query string, operationName string, variables map[string]interface{}) (*QueryAnalysis, []*gqlerrors.QueryError)
+}
@@ -0,0 +1,305 @@
+// Package querylimit implements the services that reject the GraphQL operations exceeding the configured depth, list size and cost limits
+package querylimit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// defaultFieldCosts contains the cost of the fields that are more expensive than a plain object field. The listed fields
+// either contact the backend services once per parent object or return lists read from the edge clusters.
+var defaultFieldCosts = map[string]int{
+	"User.projects":           5,
+	"User.edgeClusters":       5,
+	"Project.edgeClusters":    5,
+	"EdgeCluster.nodes":       20,
+	"EdgeCluster.pods":        20,
+	"EdgeCluster.services":    20,
+	"EdgeCluster.namespaces":  20,
+	"EdgeCluster.deployments": 20,
+	"EdgeCluster.events":      20,
+	"EdgeCluster.configMaps":  20,
+}
+
+type gqlparserQueryLimitService struct {
+	schema      *ast.Schema
+	maxDepth    int
+	maxListSize int
+	maxCost     int
+	fieldCosts  map[string]int
+}
+
+type operationAnalyzer struct {
+	service   *gqlparserQueryLimitService
+	variables map[string]interface{}
+	errors    []*gqlerrors.QueryError
+}
+
+// NewGqlparserQueryLimitService creates new instance of the gqlparserQueryLimitService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// schemaDocument: Mandatory. The GraphQL schema the operations are analysed against
+// Returns the new service or error if something goes wrong
+func NewGqlparserQueryLimitService(
+	configurationService configuration.ConfigurationContract,
+	schemaDocument string) (QueryLimitContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if strings.Trim(schemaDocument, " ") == "" {
+		return nil, commonErrors.NewArgumentError("schemaDocument", "schemaDocument is required")
+	}
+
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaDocument})
+	if gqlErr != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("Failed to load the GraphQL schema", gqlErr)
+	}
+
+	maxDepth, err := configurationService.GetQueryMaxDepth()
+	if err != nil {
+		return nil, err
+	}
+
+	maxListSize, err := configurationService.GetQueryMaxListSize()
+	if err != nil {
+		return nil, err
+	}
+
+	maxCost, err := configurationService.GetQueryMaxCost()
+	if err != nil {
+		return nil, err
+	}
+
+	configuredFieldCosts, err := configurationService.GetQueryFieldCosts()
+	if err != nil {
+		return nil, err
+	}
+
+	fieldCosts := map[string]int{}
+	for field, cost := range defaultFieldCosts {
+		fieldCosts[field] = cost
+	}
+
+	for field, cost := range configuredFieldCosts {
+		fieldCosts[field] = cost
+	}
+
+	return &gqlparserQueryLimitService{
+		schema:      schema,
+		maxDepth:    maxDepth,
+		maxListSize: maxListSize,
+		maxCost:     maxCost,
+		fieldCosts:  fieldCosts,
+	}, nil
+}
+
+// Analyze computes the depth and the cost of the requested operation and verifies them against the configured limits
+// query: Mandatory. The GraphQL document
+// operationName: Optional. The name of the operation to analyse if the document contains more than one operation
+// variables: Optional. The variables provided for the operation
+// Returns the analysis of the operation and the errors explaining why the operation must be rejected, if any
+func (service *gqlparserQueryLimitService) Analyze(
+	query string,
+	operationName string,
+	variables map[string]interface{}) (*QueryAnalysis, []*gqlerrors.QueryError) {
+	document, gqlErrs := gqlparser.LoadQuery(service.schema, query)
+	if len(gqlErrs) > 0 {
+		return nil, convertValidationErrors(gqlErrs)
+	}
+
+	operation := document.Operations.ForName(operationName)
+	if operation == nil {
+		if operationName == "" {
+			return nil, []*gqlerrors.QueryError{newQueryError(ErrorCodeValidationFailed, "Operation name is required when the document contains more than one operation", nil)}
+		}
+
+		return nil, []*gqlerrors.QueryError{newQueryError(ErrorCodeValidationFailed, fmt.Sprintf("Unknown operation named %q", operationName), nil)}
+	}
+
+	analyzer := &operationAnalyzer{
+		service:   service,
+		variables: variables,
+	}
+
+	cost, depth := analyzer.analyzeSelectionSet(operation.SelectionSet, 0)
+	analysis := &QueryAnalysis{
+		OperationType: string(operation.Operation),
+		OperationName: operation.Name,
+		Depth:         depth,
+		Cost:          cost,
+		Document:      document,
+		Operation:     operation,
+	}
+
+	if depth > service.maxDepth {
+		analyzer.errors = append(analyzer.errors, newQueryError(
+			ErrorCodeMaxDepthExceeded,
+			fmt.Sprintf("The query depth %d exceeds the maximum allowed depth %d", depth, service.maxDepth),
+			map[string]interface{}{"depth": depth, "maxDepth": service.maxDepth}))
+	}
+
+	if cost > service.maxCost {
+		analyzer.errors = append(analyzer.errors, newQueryError(
+			ErrorCodeMaxCostExceeded,
+			fmt.Sprintf("The query cost %d exceeds the maximum allowed cost %d", cost, service.maxCost),
+			map[string]interface{}{"cost": cost, "maxCost": service.maxCost}))
+	}
+
+	return analysis, analyzer.errors
+}
+
+// analyzeSelectionSet returns the cost of the selection set and the depth of its deepest field. Introspection fields are
+// neither counted towards the cost nor the depth.
+func (analyzer *operationAnalyzer) analyzeSelectionSet(selectionSet ast.SelectionSet, parentDepth int) (cost int, depth int) {
+	depth = parentDepth
+
+	for _, selection := range selectionSet {
+		var selectionCost, selectionDepth int
+
+		switch typedSelection := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(typedSelection.Name, "__") {
+				continue
+			}
+
+			childrenCost, childrenDepth := analyzer.analyzeSelectionSet(typedSelection.SelectionSet, parentDepth+1)
+			selectionCost = analyzer.fieldCost(typedSelection) + analyzer.listSize(typedSelection)*childrenCost
+			selectionDepth = childrenDepth
+
+		case *ast.InlineFragment:
+			selectionCost, selectionDepth = analyzer.analyzeSelectionSet(typedSelection.SelectionSet, parentDepth)
+
+		case *ast.FragmentSpread:
+			selectionCost, selectionDepth = analyzer.analyzeSelectionSet(typedSelection.Definition.SelectionSet, parentDepth)
+		}
+
+		cost += selectionCost
+		if selectionDepth > depth {
+			depth = selectionDepth
+		}
+	}
+
+	return
+}
+
+// fieldCost returns the configured cost of the field. Fields without configured cost cost 1 if they return an object
+// and nothing if they return a scalar or an enum.
+func (analyzer *operationAnalyzer) fieldCost(field *ast.Field) int {
+	if cost, ok := analyzer.service.fieldCosts[field.ObjectDefinition.Name+"."+field.Name]; ok {
+		return cost
+	}
+
+	if definition := analyzer.service.schema.Types[field.Definition.Type.Name()]; definition != nil && definition.IsLeafType() {
+		return 0
+	}
+
+	return 1
+}
+
+// listSize returns the number of items the field is expected to return. Fields that accept the first and last arguments
+// are assumed to return the maximum list size if neither argument is provided, and fields that accept the ids argument
+// return an item for every identifier.
+func (analyzer *operationAnalyzer) listSize(field *ast.Field) int {
+	if field.Definition.Arguments.ForName("ids") != nil {
+		return analyzer.idsListSize(field)
+	}
+
+	if field.Definition.Arguments.ForName("first") == nil && field.Definition.Arguments.ForName("last") == nil {
+		return 1
+	}
+
+	arguments := field.ArgumentMap(analyzer.variables)
+	size := -1
+
+	for _, argumentName := range []string{"first", "last"} {
+		value, ok := toInt(arguments[argumentName])
+		if !ok {
+			continue
+		}
+
+		if value > analyzer.service.maxListSize {
+			analyzer.errors = append(analyzer.errors, newQueryError(
+				ErrorCodeMaxListSizeExceeded,
+				fmt.Sprintf("The %s argument of the %s field exceeds the maximum allowed list size %d", argumentName, field.Name, analyzer.service.maxListSize),
+				map[string]interface{}{"field": field.Name, "argument": argumentName, "value": value, "maxListSize": analyzer.service.maxListSize}))
+		}
+
+		if value > size {
+			size = value
+		}
+	}
+
+	if size < 0 {
+		return analyzer.service.maxListSize
+	}
+
+	return size
+}
+
+// idsListSize returns the number of identifiers passed in the ids argument of the field
+func (analyzer *operationAnalyzer) idsListSize(field *ast.Field) int {
+	ids, _ := field.ArgumentMap(analyzer.variables)["ids"].([]interface{})
+
+	if len(ids) > analyzer.service.maxListSize {
+		analyzer.errors = append(analyzer.errors, newQueryError(
+			ErrorCodeMaxListSizeExceeded,
+			fmt.Sprintf("The ids argument of the %s field exceeds the maximum allowed list size %d", field.Name, analyzer.service.maxListSize),
+			map[string]interface{}{"field": field.Name, "argument": "ids", "value": len(ids), "maxListSize": analyzer.service.maxListSize}))
+	}
+
+	return len(ids)
+}
+
+func toInt(value interface{}) (int, bool) {
+	switch typedValue := value.(type) {
+	case int:
+		return typedValue, true
+	case int32:
+		return int(typedValue), true
+	case int64:
+		return int(typedValue), true
+	case float64:
+		return int(typedValue), true
+	default:
+		return 0, false
+	}
+}
+
+func newQueryError(code string, message string, extensions map[string]interface{}) *gqlerrors.QueryError {
+	if extensions == nil {
+		extensions = map[string]interface{}{}
+	}
+
+	extensions["code"] = code
+
+	return &gqlerrors.QueryError{
+		Message:    message,
+		Extensions: extensions,
+	}
+}
+
+func convertValidationErrors(gqlErrs gqlerror.List) []*gqlerrors.QueryError {
+	queryErrors := make([]*gqlerrors.QueryError, 0, len(gqlErrs))
+
+	for _, gqlErr := range gqlErrs {
+		queryError := newQueryError(ErrorCodeValidationFailed, gqlErr.Message, nil)
+
+		for _, location := range gqlErr.Locations {
+			queryError.Locations = append(queryError.Locations, gqlerrors.Location{
+				Line:   location.Line,
+				Column: location.Column,
+			})
+		}
+
+		queryErrors = append(queryErrors, queryError)
+	}
+
+	return queryErrors
+}
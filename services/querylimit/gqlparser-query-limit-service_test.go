@@ -0,0 +1,142 @@
+package querylimit_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/querylimit"
+	"github.com/golang/mock/gomock"
+)
+
+func newQueryLimitService(t *testing.T, maxDepth, maxListSize, maxCost int) querylimit.QueryLimitContract {
+	t.Helper()
+
+	schemaDocument, err := ioutil.ReadFile("../../contract/graphql/schema/schema.graphql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetQueryMaxDepth().Return(maxDepth, nil)
+	configurationService.EXPECT().GetQueryMaxListSize().Return(maxListSize, nil)
+	configurationService.EXPECT().GetQueryMaxCost().Return(maxCost, nil)
+	configurationService.EXPECT().GetQueryFieldCosts().Return(map[string]int{}, nil)
+
+	service, err := querylimit.NewGqlparserQueryLimitService(configurationService, string(schemaDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return service
+}
+
+// projectsQuery selects fields five levels deep and costs 1 for the user, 5 for the projects and 2 for every project edge
+const projectsQuery = `{ user { projects(first: 10) { edges { node { id } } } } }`
+
+func TestGqlparserQueryLimitService_RejectsOperationsDeeperThanTheMaxDepth(t *testing.T) {
+	analysis, queryErrors := newQueryLimitService(t, 5, 100, 100000).Analyze(projectsQuery, "", nil)
+	if len(queryErrors) > 0 {
+		t.Fatal(queryErrors)
+	}
+
+	if analysis.Depth != 5 {
+		t.Fatalf("expected the depth 5, got %d", analysis.Depth)
+	}
+
+	analysis, queryErrors = newQueryLimitService(t, 4, 100, 100000).Analyze(projectsQuery, "", nil)
+	if len(queryErrors) != 1 || queryErrors[0].Extensions["code"] != querylimit.ErrorCodeMaxDepthExceeded {
+		t.Fatalf("expected the operation to be rejected with the %s error code, got %v", querylimit.ErrorCodeMaxDepthExceeded, queryErrors)
+	}
+
+	if queryErrors[0].Extensions["depth"] != 5 || queryErrors[0].Extensions["maxDepth"] != 4 {
+		t.Fatalf("expected the depth and the maximum depth to be reported, got %v", queryErrors[0].Extensions)
+	}
+
+	if analysis == nil || analysis.OperationType != "query" {
+		t.Fatalf("expected the analysis of the rejected operation to be returned, got %v", analysis)
+	}
+
+	analysis, queryErrors = newQueryLimitService(t, 1, 100, 100000).Analyze(`{ __schema { types { fields { type { name } } } } }`, "", nil)
+	if len(queryErrors) > 0 || analysis.Depth != 0 || analysis.Cost != 0 {
+		t.Fatalf("expected the introspection fields not to be counted, got %v %v", analysis, queryErrors)
+	}
+}
+
+func TestGqlparserQueryLimitService_RejectsOperationsCostingMoreThanTheMaxCost(t *testing.T) {
+	analysis, queryErrors := newQueryLimitService(t, 100, 100, 26).Analyze(projectsQuery, "", nil)
+	if len(queryErrors) > 0 {
+		t.Fatal(queryErrors)
+	}
+
+	if analysis.Cost != 26 {
+		t.Fatalf("expected the cost 26, got %d", analysis.Cost)
+	}
+
+	_, queryErrors = newQueryLimitService(t, 100, 100, 25).Analyze(projectsQuery, "", nil)
+	if len(queryErrors) != 1 || queryErrors[0].Extensions["code"] != querylimit.ErrorCodeMaxCostExceeded {
+		t.Fatalf("expected the operation to be rejected with the %s error code, got %v", querylimit.ErrorCodeMaxCostExceeded, queryErrors)
+	}
+
+	if queryErrors[0].Extensions["cost"] != 26 || queryErrors[0].Extensions["maxCost"] != 25 {
+		t.Fatalf("expected the cost and the maximum cost to be reported, got %v", queryErrors[0].Extensions)
+	}
+
+	// Without the first and last arguments the projects are assumed to return the maximum list size
+	analysis, queryErrors = newQueryLimitService(t, 100, 100, 100000).Analyze(`{ user { projects { edges { node { id } } } } }`, "", nil)
+	if len(queryErrors) > 0 || analysis.Cost != 206 {
+		t.Fatalf("expected the cost 206, got %v %v", analysis, queryErrors)
+	}
+
+	// The first argument is read from the variables
+	analysis, queryErrors = newQueryLimitService(t, 100, 100, 100000).Analyze(
+		`query Projects($first: Int) { user { projects(first: $first) { edges { node { id } } } } }`,
+		"Projects",
+		map[string]interface{}{"first": float64(3)})
+	if len(queryErrors) > 0 || analysis.Cost != 12 {
+		t.Fatalf("expected the cost 12, got %v %v", analysis, queryErrors)
+	}
+}
+
+func TestGqlparserQueryLimitService_KubernetesFieldsCostAsMuchAsPods(t *testing.T) {
+	service := newQueryLimitService(t, 100, 100, 100000)
+
+	podsAnalysis, queryErrors := service.Analyze(`{ node(id: "e1") { ... on EdgeCluster { pods { metadata { name } } } } }`, "", nil)
+	if len(queryErrors) > 0 {
+		t.Fatal(queryErrors)
+	}
+
+	for _, field := range []string{"namespaces", "deployments", "events", "configMaps"} {
+		analysis, queryErrors := service.Analyze(fmt.Sprintf(`{ node(id: "e1") { ... on EdgeCluster { %s { metadata { name } } } } }`, field), "", nil)
+		if len(queryErrors) > 0 {
+			t.Fatal(queryErrors)
+		}
+
+		if analysis.Cost != podsAnalysis.Cost {
+			t.Fatalf("expected EdgeCluster.%s to cost %d as EdgeCluster.pods, got %d", field, podsAnalysis.Cost, analysis.Cost)
+		}
+	}
+}
+
+func TestGqlparserQueryLimitService_NodesIdsAreBoundedByTheMaxListSize(t *testing.T) {
+	service := newQueryLimitService(t, 100, 2, 100000)
+
+	analysis, queryErrors := service.Analyze(`{ nodes(ids: ["n1", "n2"]) { ... on EdgeCluster { project { id } } } }`, "", nil)
+	if len(queryErrors) > 0 {
+		t.Fatal(queryErrors)
+	}
+
+	if analysis.Cost != 3 {
+		t.Fatalf("expected the selection to be counted for every identifier, got %d", analysis.Cost)
+	}
+
+	_, queryErrors = service.Analyze(
+		`query Nodes($ids: [ID!]!) { nodes(ids: $ids) { id } }`,
+		"Nodes",
+		map[string]interface{}{"ids": []interface{}{"n1", "n2", "n3"}})
+	if len(queryErrors) != 1 || queryErrors[0].Extensions["code"] != querylimit.ErrorCodeMaxListSizeExceeded {
+		t.Fatalf("expected the identifiers exceeding the maximum list size to be rejected, got %v", queryErrors)
+	}
+}
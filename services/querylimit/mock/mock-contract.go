@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/querylimit/contract.go
+
+// Package mock_querylimit is a generated GoMock package.
+package mock_querylimit
+
+import (
+	reflect "reflect"
+
+	querylimit "github.com/decentralized-cloud/api-gateway/services/querylimit"
+	gomock "github.com/golang/mock/gomock"
+	errors "github.com/graph-gophers/graphql-go/errors"
+)
+
+// MockQueryLimitContract is a mock of QueryLimitContract interface.
+type MockQueryLimitContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueryLimitContractMockRecorder
+}
+
+// MockQueryLimitContractMockRecorder is the mock recorder for MockQueryLimitContract.
+type MockQueryLimitContractMockRecorder struct {
+	mock *MockQueryLimitContract
+}
+
+// NewMockQueryLimitContract creates a new mock instance.
+func NewMockQueryLimitContract(ctrl *gomock.Controller) *MockQueryLimitContract {
+	mock := &MockQueryLimitContract{ctrl: ctrl}
+	mock.recorder = &MockQueryLimitContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueryLimitContract) EXPECT() *MockQueryLimitContractMockRecorder {
+	return m.recorder
+}
+
+// Analyze mocks base method.
+func (m *MockQueryLimitContract) Analyze(query, operationName string, variables map[string]interface{}) (*querylimit.QueryAnalysis, []*errors.QueryError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Analyze", query, operationName, variables)
+	ret0, _ := ret[0].(*querylimit.QueryAnalysis)
+	ret1, _ := ret[1].([]*errors.QueryError)
+	return ret0, ret1
+}
+
+// Analyze indicates an expected call of Analyze.
+func (mr *MockQueryLimitContractMockRecorder) Analyze(query, operationName, variables interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Analyze", reflect.TypeOf((*MockQueryLimitContract)(nil).Analyze), query, operationName, variables)
+}
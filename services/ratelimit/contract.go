@@ -0,0 +1,60 @@
+// Package ratelimit implements the token bucket rate limiter that protects the backend services from the callers sending too many requests
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// ErrorCodeRateLimited is reported when the caller exceeded the request budget of the operation type
+	ErrorCodeRateLimited = "RATE_LIMITED"
+)
+
+// Budget is the token bucket that limits the rate of the requests
+type Budget struct {
+	// Rate is the number of tokens added to the bucket per second
+	Rate float64
+
+	// Burst is the maximum number of tokens the bucket holds
+	Burst int
+}
+
+// Decision is the result of taking tokens from a bucket
+type Decision struct {
+	// Allowed is true if the tokens are taken and the request can proceed
+	Allowed bool
+
+	// RetryAfter is the time the caller should wait before the request is allowed, set when the request is not allowed
+	RetryAfter time.Duration
+}
+
+// RateLimitContract declares the service that decides whether the caller is allowed to send the request. The queries
+// and the mutations of the authenticated callers have separate budgets, and the requests sent from every client IP
+// address share a budget checked before the requests are authenticated.
+type RateLimitContract interface {
+	// AllowAddress takes a token for the request from the budget of the client IP address
+	// ctx: Mandatory. Reference to the context
+	// address: Mandatory. The IP address of the client
+	// Returns the decision or error if something goes wrong
+	AllowAddress(ctx context.Context, address string) (Decision, error)
+
+	// Allow takes the tokens for the operations from the budget of the operation type of the caller
+	// ctx: Mandatory. Reference to the context
+	// key: Mandatory. The key that identifies the caller
+	// operationType: Mandatory. The type of the GraphQL operations, either query, mutation or subscription
+	// count: Mandatory. The number of the operations sent in the request
+	// Returns the decision or error if something goes wrong
+	Allow(ctx context.Context, key string, operationType string, count int) (Decision, error)
+}
+
+// RateLimitStoreContract declares the store that keeps the token buckets of the callers
+type RateLimitStoreContract interface {
+	// Take takes the tokens from the bucket of the given key. The bucket is created full if it does not exist.
+	// ctx: Mandatory. Reference to the context
+	// key: Mandatory. The key of the bucket
+	// budget: Mandatory. The rate the bucket is refilled at and the bucket capacity
+	// tokens: Mandatory. The number of tokens to take
+	// Returns the decision or error if something goes wrong
+	Take(ctx context.Context, key string, budget Budget, tokens int) (Decision, error)
+}
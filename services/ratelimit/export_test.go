@@ -0,0 +1,18 @@
+package ratelimit
+
+import "time"
+
+// NewInMemoryRateLimitStoreWithClock creates the in-memory store that reads the current time from the given clock
+func NewInMemoryRateLimitStoreWithClock(now func() time.Time) RateLimitStoreContract {
+	return newInMemoryRateLimitStore(now)
+}
+
+// BucketCount returns the number of the buckets kept by the in-memory store
+func BucketCount(store RateLimitStoreContract) int {
+	inMemoryStore := store.(*inMemoryRateLimitStore)
+
+	inMemoryStore.lock.Lock()
+	defer inMemoryStore.lock.Unlock()
+
+	return len(inMemoryStore.buckets)
+}
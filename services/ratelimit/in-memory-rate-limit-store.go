@@ -0,0 +1,102 @@
+// Package ratelimit implements the token bucket rate limiter that protects the backend services from the callers sending too many requests
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is the interval the buckets that are full again are removed at, so the store does not grow with
+// every caller ever seen
+const sweepInterval = time.Minute
+
+type bucket struct {
+	budget  Budget
+	tokens  float64
+	updated time.Time
+}
+
+type inMemoryRateLimitStore struct {
+	lock      sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewInMemoryRateLimitStore creates new instance of the inMemoryRateLimitStore, setting up all dependencies and returns the instance.
+// The buckets are kept in the memory of the process, so every instance of the api-gateway limits the requests it receives.
+// Returns the new store or error if something goes wrong
+func NewInMemoryRateLimitStore() (RateLimitStoreContract, error) {
+	return newInMemoryRateLimitStore(time.Now), nil
+}
+
+func newInMemoryRateLimitStore(now func() time.Time) *inMemoryRateLimitStore {
+	return &inMemoryRateLimitStore{
+		buckets:   map[string]*bucket{},
+		lastSweep: now(),
+		now:       now,
+	}
+}
+
+// Take takes the tokens from the bucket of the given key. The bucket is created full if it does not exist.
+// ctx: Mandatory. Reference to the context
+// key: Mandatory. The key of the bucket
+// budget: Mandatory. The rate the bucket is refilled at and the bucket capacity
+// tokens: Mandatory. The number of tokens to take
+// Returns the decision or error if something goes wrong
+func (store *inMemoryRateLimitStore) Take(
+	ctx context.Context,
+	key string,
+	budget Budget,
+	tokens int) (Decision, error) {
+	now := store.now()
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if now.Sub(store.lastSweep) >= sweepInterval {
+		store.sweep(now)
+	}
+
+	keyBucket, ok := store.buckets[key]
+	if !ok {
+		keyBucket = &bucket{tokens: float64(budget.Burst)}
+		store.buckets[key] = keyBucket
+	} else {
+		keyBucket.tokens = refill(keyBucket, now)
+	}
+
+	keyBucket.budget = budget
+	keyBucket.updated = now
+
+	// A request can never take more tokens than the bucket holds
+	requested := math.Min(float64(tokens), float64(budget.Burst))
+	if keyBucket.tokens >= requested {
+		keyBucket.tokens -= requested
+
+		return Decision{Allowed: true}, nil
+	}
+
+	return Decision{
+		RetryAfter: time.Duration((requested - keyBucket.tokens) / budget.Rate * float64(time.Second)),
+	}, nil
+}
+
+// sweep removes the buckets that are full again, they are recreated full the next time they are needed
+func (store *inMemoryRateLimitStore) sweep(now time.Time) {
+	for key, keyBucket := range store.buckets {
+		if refill(keyBucket, now) >= float64(keyBucket.budget.Burst) {
+			delete(store.buckets, key)
+		}
+	}
+
+	store.lastSweep = now
+}
+
+func refill(keyBucket *bucket, now time.Time) float64 {
+	tokens := keyBucket.tokens + now.Sub(keyBucket.updated).Seconds()*keyBucket.budget.Rate
+
+	return math.Min(tokens, float64(keyBucket.budget.Burst))
+}
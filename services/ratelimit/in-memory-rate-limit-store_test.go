@@ -0,0 +1,173 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/ratelimit"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func (clock *fakeClock) advance(duration time.Duration) {
+	clock.now = clock.now.Add(duration)
+}
+
+func newStore() (ratelimit.RateLimitStoreContract, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	return ratelimit.NewInMemoryRateLimitStoreWithClock(clock.Now), clock
+}
+
+func take(t *testing.T, store ratelimit.RateLimitStoreContract, key string, budget ratelimit.Budget, tokens int) ratelimit.Decision {
+	decision, err := store.Take(context.Background(), key, budget, tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return decision
+}
+
+func TestInMemoryRateLimitStore_RefillsTheBucketAtTheRate(t *testing.T) {
+	store, clock := newStore()
+	budget := ratelimit.Budget{Rate: 1, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		if decision := take(t, store, "user:1", budget, 1); !decision.Allowed {
+			t.Fatalf("expected the request %d to be allowed by the full bucket", i+1)
+		}
+	}
+
+	if decision := take(t, store, "user:1", budget, 1); decision.Allowed {
+		t.Fatal("expected the request to be rejected by the empty bucket")
+	}
+
+	clock.advance(500 * time.Millisecond)
+
+	if decision := take(t, store, "user:1", budget, 1); decision.Allowed {
+		t.Fatal("expected the request to be rejected before a whole token is added")
+	}
+
+	clock.advance(500 * time.Millisecond)
+
+	if decision := take(t, store, "user:1", budget, 1); !decision.Allowed {
+		t.Fatal("expected the request to be allowed after a token is added")
+	}
+
+	// The bucket never holds more tokens than the burst, however long it is not used
+	clock.advance(time.Hour)
+
+	for i := 0; i < 2; i++ {
+		if decision := take(t, store, "user:1", budget, 1); !decision.Allowed {
+			t.Fatalf("expected the request %d to be allowed by the refilled bucket", i+1)
+		}
+	}
+
+	if decision := take(t, store, "user:1", budget, 1); decision.Allowed {
+		t.Fatal("expected the refilled bucket to hold no more tokens than the burst")
+	}
+}
+
+func TestInMemoryRateLimitStore_KeepsSeparateBucketsPerKey(t *testing.T) {
+	store, _ := newStore()
+	budget := ratelimit.Budget{Rate: 1, Burst: 1}
+
+	if decision := take(t, store, "user:1", budget, 1); !decision.Allowed {
+		t.Fatal("expected the request of the first caller to be allowed")
+	}
+
+	if decision := take(t, store, "user:2", budget, 1); !decision.Allowed {
+		t.Fatal("expected the request of the second caller to be allowed by its own bucket")
+	}
+}
+
+func TestInMemoryRateLimitStore_RetryAfter(t *testing.T) {
+	tests := []struct {
+		name               string
+		budget             ratelimit.Budget
+		elapsed            time.Duration
+		tokens             int
+		expectedRetryAfter time.Duration
+	}{
+		{name: "one token missing", budget: ratelimit.Budget{Rate: 1, Burst: 3}, tokens: 1, expectedRetryAfter: time.Second},
+		{name: "several tokens missing", budget: ratelimit.Budget{Rate: 2, Burst: 3}, tokens: 2, expectedRetryAfter: time.Second},
+		{name: "partially refilled bucket", budget: ratelimit.Budget{Rate: 4, Burst: 3}, elapsed: 100 * time.Millisecond, tokens: 1, expectedRetryAfter: 150 * time.Millisecond},
+		{name: "more tokens than the burst", budget: ratelimit.Budget{Rate: 1, Burst: 3}, tokens: 10, expectedRetryAfter: 3 * time.Second},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store, clock := newStore()
+
+			if decision := take(t, store, "user:1", test.budget, test.budget.Burst); !decision.Allowed {
+				t.Fatal("expected the full bucket to allow the burst")
+			}
+
+			clock.advance(test.elapsed)
+
+			decision := take(t, store, "user:1", test.budget, test.tokens)
+			if decision.Allowed {
+				t.Fatal("expected the request to be rejected")
+			}
+
+			if difference := decision.RetryAfter - test.expectedRetryAfter; difference < -time.Millisecond || difference > time.Millisecond {
+				t.Fatalf("expected retry after %v, got %v", test.expectedRetryAfter, decision.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestInMemoryRateLimitStore_CapsTheRequestedTokensAtTheBurst(t *testing.T) {
+	store, _ := newStore()
+	budget := ratelimit.Budget{Rate: 1, Burst: 5}
+
+	// A batch larger than the burst would never be allowed otherwise
+	if decision := take(t, store, "user:1", budget, 20); !decision.Allowed {
+		t.Fatal("expected the full bucket to allow the request larger than the burst")
+	}
+
+	if decision := take(t, store, "user:1", budget, 1); decision.Allowed {
+		t.Fatal("expected the request larger than the burst to empty the bucket")
+	}
+}
+
+func TestInMemoryRateLimitStore_SweepsTheBucketsThatAreFullAgain(t *testing.T) {
+	store, clock := newStore()
+
+	take(t, store, "user:refilled", ratelimit.Budget{Rate: 1, Burst: 5}, 5)
+	take(t, store, "user:draining", ratelimit.Budget{Rate: 0.01, Burst: 5}, 5)
+
+	if count := ratelimit.BucketCount(store); count != 2 {
+		t.Fatalf("expected 2 buckets, got %d", count)
+	}
+
+	clock.advance(30 * time.Second)
+	take(t, store, "user:other", ratelimit.Budget{Rate: 1, Burst: 5}, 1)
+
+	if count := ratelimit.BucketCount(store); count != 3 {
+		t.Fatalf("expected the buckets not to be swept before the sweep interval, got %d buckets", count)
+	}
+
+	clock.advance(30 * time.Second)
+	take(t, store, "user:new", ratelimit.Budget{Rate: 1, Burst: 5}, 1)
+
+	// The refilled bucket and the bucket of the other caller are full again, the draining bucket is kept
+	if count := ratelimit.BucketCount(store); count != 2 {
+		t.Fatalf("expected the full buckets to be swept, got %d buckets", count)
+	}
+
+	if decision := take(t, store, "user:draining", ratelimit.Budget{Rate: 0.01, Burst: 5}, 1); decision.Allowed {
+		t.Fatal("expected the bucket that is not full again to keep its tokens")
+	}
+
+	if decision := take(t, store, "user:refilled", ratelimit.Budget{Rate: 1, Burst: 5}, 5); !decision.Allowed {
+		t.Fatal("expected the swept bucket to be recreated full")
+	}
+}
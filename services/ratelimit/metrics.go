@@ -0,0 +1,14 @@
+// Package ratelimit implements the token bucket rate limiter that protects the backend services from the callers sending too many requests
+package ratelimit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var rejectedCounter = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "api_gateway_rate_limited_requests_total",
+		Help: "The number of GraphQL requests rejected because the caller or the client IP address exceeded the request budget",
+	},
+	[]string{"budget"})
@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/ratelimit/contract.go
+
+// Package mock_ratelimit is a generated GoMock package.
+package mock_ratelimit
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ratelimit "github.com/decentralized-cloud/api-gateway/services/ratelimit"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRateLimitContract is a mock of RateLimitContract interface.
+type MockRateLimitContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitContractMockRecorder
+}
+
+// MockRateLimitContractMockRecorder is the mock recorder for MockRateLimitContract.
+type MockRateLimitContractMockRecorder struct {
+	mock *MockRateLimitContract
+}
+
+// NewMockRateLimitContract creates a new mock instance.
+func NewMockRateLimitContract(ctrl *gomock.Controller) *MockRateLimitContract {
+	mock := &MockRateLimitContract{ctrl: ctrl}
+	mock.recorder = &MockRateLimitContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitContract) EXPECT() *MockRateLimitContractMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockRateLimitContract) Allow(ctx context.Context, key, operationType string, count int) (ratelimit.Decision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, key, operationType, count)
+	ret0, _ := ret[0].(ratelimit.Decision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimitContractMockRecorder) Allow(ctx, key, operationType, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimitContract)(nil).Allow), ctx, key, operationType, count)
+}
+
+// AllowAddress mocks base method.
+func (m *MockRateLimitContract) AllowAddress(ctx context.Context, address string) (ratelimit.Decision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowAddress", ctx, address)
+	ret0, _ := ret[0].(ratelimit.Decision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowAddress indicates an expected call of AllowAddress.
+func (mr *MockRateLimitContractMockRecorder) AllowAddress(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowAddress", reflect.TypeOf((*MockRateLimitContract)(nil).AllowAddress), ctx, address)
+}
+
+// MockRateLimitStoreContract is a mock of RateLimitStoreContract interface.
+type MockRateLimitStoreContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitStoreContractMockRecorder
+}
+
+// MockRateLimitStoreContractMockRecorder is the mock recorder for MockRateLimitStoreContract.
+type MockRateLimitStoreContractMockRecorder struct {
+	mock *MockRateLimitStoreContract
+}
+
+// NewMockRateLimitStoreContract creates a new mock instance.
+func NewMockRateLimitStoreContract(ctrl *gomock.Controller) *MockRateLimitStoreContract {
+	mock := &MockRateLimitStoreContract{ctrl: ctrl}
+	mock.recorder = &MockRateLimitStoreContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitStoreContract) EXPECT() *MockRateLimitStoreContractMockRecorder {
+	return m.recorder
+}
+
+// Take mocks base method.
+func (m *MockRateLimitStoreContract) Take(ctx context.Context, key string, budget ratelimit.Budget, tokens int) (ratelimit.Decision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, key, budget, tokens)
+	ret0, _ := ret[0].(ratelimit.Decision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockRateLimitStoreContractMockRecorder) Take(ctx, key, budget, tokens interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockRateLimitStoreContract)(nil).Take), ctx, key, budget, tokens)
+}
@@ -0,0 +1,133 @@
+// Package ratelimit implements the token bucket rate limiter that protects the backend services from the callers sending too many requests
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+const (
+	operationTypeQuery    = "query"
+	operationTypeMutation = "mutation"
+	budgetNameAddress     = "address"
+)
+
+type tokenBucketRateLimitService struct {
+	store          RateLimitStoreContract
+	queryBudget    Budget
+	mutationBudget Budget
+	addressBudget  Budget
+}
+
+// NewTokenBucketRateLimitService creates new instance of the tokenBucketRateLimitService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// store: Mandatory. Reference to the store that keeps the token buckets of the callers
+// Returns the new service or error if something goes wrong
+func NewTokenBucketRateLimitService(
+	configurationService configuration.ConfigurationContract,
+	store RateLimitStoreContract) (RateLimitContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if store == nil {
+		return nil, commonErrors.NewArgumentNilError("store", "store is required")
+	}
+
+	queryRate, err := configurationService.GetRateLimitQueryRate()
+	if err != nil {
+		return nil, err
+	}
+
+	queryBurst, err := configurationService.GetRateLimitQueryBurst()
+	if err != nil {
+		return nil, err
+	}
+
+	mutationRate, err := configurationService.GetRateLimitMutationRate()
+	if err != nil {
+		return nil, err
+	}
+
+	mutationBurst, err := configurationService.GetRateLimitMutationBurst()
+	if err != nil {
+		return nil, err
+	}
+
+	addressRate, err := configurationService.GetRateLimitAddressRate()
+	if err != nil {
+		return nil, err
+	}
+
+	addressBurst, err := configurationService.GetRateLimitAddressBurst()
+	if err != nil {
+		return nil, err
+	}
+
+	if (queryRate > 0 && queryBurst < 1) || (mutationRate > 0 && mutationBurst < 1) || (addressRate > 0 && addressBurst < 1) {
+		return nil, commonErrors.NewArgumentError("burst", "rate limit burst must be at least 1")
+	}
+
+	return &tokenBucketRateLimitService{
+		store:          store,
+		queryBudget:    Budget{Rate: queryRate, Burst: queryBurst},
+		mutationBudget: Budget{Rate: mutationRate, Burst: mutationBurst},
+		addressBudget:  Budget{Rate: addressRate, Burst: addressBurst},
+	}, nil
+}
+
+// AllowAddress takes a token for the request from the budget of the client IP address. A budget with a rate of zero
+// does not limit the requests.
+// ctx: Mandatory. Reference to the context
+// address: Mandatory. The IP address of the client
+// Returns the decision or error if something goes wrong
+func (service *tokenBucketRateLimitService) AllowAddress(ctx context.Context, address string) (Decision, error) {
+	return service.take(ctx, budgetNameAddress, address, service.addressBudget, 1)
+}
+
+// Allow takes the tokens for the operations from the budget of the operation type of the caller. The subscriptions
+// share the budget of the queries. A budget with a rate of zero does not limit the requests.
+// ctx: Mandatory. Reference to the context
+// key: Mandatory. The key that identifies the caller
+// operationType: Mandatory. The type of the GraphQL operations, either query, mutation or subscription
+// count: Mandatory. The number of the operations sent in the request
+// Returns the decision or error if something goes wrong
+func (service *tokenBucketRateLimitService) Allow(
+	ctx context.Context,
+	key string,
+	operationType string,
+	count int) (Decision, error) {
+	budget := service.queryBudget
+	budgetName := operationTypeQuery
+
+	if operationType == operationTypeMutation {
+		budget = service.mutationBudget
+		budgetName = operationTypeMutation
+	}
+
+	return service.take(ctx, budgetName, key, budget, count)
+}
+
+func (service *tokenBucketRateLimitService) take(
+	ctx context.Context,
+	budgetName string,
+	key string,
+	budget Budget,
+	count int) (Decision, error) {
+	if budget.Rate <= 0 {
+		return Decision{Allowed: true}, nil
+	}
+
+	decision, err := service.store.Take(ctx, budgetName+":"+key, budget, count)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	if !decision.Allowed {
+		rejectedCounter.WithLabelValues(budgetName).Inc()
+	}
+
+	return decision, nil
+}
@@ -0,0 +1,103 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/ratelimit"
+	"github.com/golang/mock/gomock"
+)
+
+func newRateLimitService(
+	t *testing.T,
+	queryBudget ratelimit.Budget,
+	mutationBudget ratelimit.Budget,
+	addressBudget ratelimit.Budget) (ratelimit.RateLimitContract, error) {
+	mockCtrl := gomock.NewController(t)
+
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetRateLimitQueryRate().Return(queryBudget.Rate, nil).AnyTimes()
+	configurationService.EXPECT().GetRateLimitQueryBurst().Return(queryBudget.Burst, nil).AnyTimes()
+	configurationService.EXPECT().GetRateLimitMutationRate().Return(mutationBudget.Rate, nil).AnyTimes()
+	configurationService.EXPECT().GetRateLimitMutationBurst().Return(mutationBudget.Burst, nil).AnyTimes()
+	configurationService.EXPECT().GetRateLimitAddressRate().Return(addressBudget.Rate, nil).AnyTimes()
+	configurationService.EXPECT().GetRateLimitAddressBurst().Return(addressBudget.Burst, nil).AnyTimes()
+
+	store, err := ratelimit.NewInMemoryRateLimitStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ratelimit.NewTokenBucketRateLimitService(configurationService, store)
+}
+
+func TestTokenBucketRateLimitService_KeepsSeparateBudgetsPerOperationType(t *testing.T) {
+	service, err := newRateLimitService(
+		t,
+		ratelimit.Budget{Rate: 0.01, Burst: 2},
+		ratelimit.Budget{Rate: 0.01, Burst: 1},
+		ratelimit.Budget{Rate: 0.01, Burst: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if decision, _ := service.Allow(ctx, "user:1", "mutation", 1); !decision.Allowed {
+		t.Fatal("expected the mutation to be allowed")
+	}
+
+	if decision, _ := service.Allow(ctx, "user:1", "mutation", 1); decision.Allowed {
+		t.Fatal("expected the mutation to be rejected once the mutation budget is spent")
+	}
+
+	if decision, _ := service.Allow(ctx, "user:1", "query", 1); !decision.Allowed {
+		t.Fatal("expected the query not to be limited by the mutation budget")
+	}
+
+	// The subscriptions share the budget of the queries
+	if decision, _ := service.Allow(ctx, "user:1", "subscription", 1); !decision.Allowed {
+		t.Fatal("expected the subscription to be allowed")
+	}
+
+	if decision, _ := service.Allow(ctx, "user:1", "query", 1); decision.Allowed {
+		t.Fatal("expected the query to be rejected once the subscription spent the query budget")
+	}
+
+	if decision, _ := service.AllowAddress(ctx, "user:1"); !decision.Allowed {
+		t.Fatal("expected the IP address budget not to share the buckets of the callers")
+	}
+}
+
+func TestTokenBucketRateLimitService_ZeroRateDoesNotLimitTheRequests(t *testing.T) {
+	service, err := newRateLimitService(
+		t,
+		ratelimit.Budget{Rate: 0, Burst: 0},
+		ratelimit.Budget{Rate: 0, Burst: 0},
+		ratelimit.Budget{Rate: 0, Burst: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if decision, _ := service.Allow(context.Background(), "user:1", "mutation", 1); !decision.Allowed {
+			t.Fatalf("expected the mutation %d to be allowed", i+1)
+		}
+
+		if decision, _ := service.AllowAddress(context.Background(), "10.0.0.1"); !decision.Allowed {
+			t.Fatalf("expected the request %d to be allowed", i+1)
+		}
+	}
+}
+
+func TestNewTokenBucketRateLimitService_RejectsEmptyBurst(t *testing.T) {
+	_, err := newRateLimitService(
+		t,
+		ratelimit.Budget{Rate: 10, Burst: 50},
+		ratelimit.Budget{Rate: 2, Burst: 0},
+		ratelimit.Budget{Rate: 50, Burst: 200})
+	if err == nil {
+		t.Fatal("expected the budget that can never allow a request to be rejected")
+	}
+}
@@ -0,0 +1,74 @@
+// Package responsecache implements the cache of the responses of the read-only GraphQL operations
+package responsecache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Operation contains the validated GraphQL operation the response is cached for
+type Operation struct {
+	Document   *ast.QueryDocument
+	Definition *ast.OperationDefinition
+	Variables  map[string]interface{}
+}
+
+// Entry contains the cached response along with the caller the response is cached for and the tags the response is
+// invalidated by
+type Entry struct {
+	Owner     string
+	Tags      []string
+	Data      json.RawMessage
+	ExpiresAt time.Time
+}
+
+// ResponseCacheContract declares the service that caches the responses of the query operations per caller. The cached
+// responses are invalidated by the mutations the same caller sends.
+type ResponseCacheContract interface {
+	// Get returns the cached response of the query operation
+	// ctx: Mandatory. Reference to the context
+	// operation: Mandatory. The query operation
+	// Returns the cached response and true if the response is found and not expired, otherwise false
+	Get(ctx context.Context, operation *Operation) (*graphql.Response, bool)
+
+	// Put caches the response of the query operation for the smallest max-age of the selected fields. The responses
+	// with errors are not cached, neither are the responses with extensions as only the data of the responses is cached.
+	// ctx: Mandatory. Reference to the context
+	// operation: Mandatory. The query operation
+	// response: Mandatory. The response of the query operation
+	Put(ctx context.Context, operation *Operation, response *graphql.Response)
+
+	// Invalidate removes the cached responses of the caller that refer to the projects and the edge clusters the
+	// mutation touched, along with the cached responses that list projects or edge clusters
+	// ctx: Mandatory. Reference to the context
+	// operation: Mandatory. The mutation operation
+	// response: Mandatory. The response of the mutation operation
+	Invalidate(ctx context.Context, operation *Operation, response *graphql.Response)
+}
+
+// ResponseCacheStoreContract declares the store that keeps the cached responses
+type ResponseCacheStoreContract interface {
+	// Get looks up the cached response of the given key
+	// ctx: Mandatory. Reference to the context
+	// key: Mandatory. The key of the cached response
+	// Returns the cached entry, true if the entry is found, otherwise false, or error if something goes wrong
+	Get(ctx context.Context, key string) (*Entry, bool, error)
+
+	// Put caches the response with the given key
+	// ctx: Mandatory. Reference to the context
+	// key: Mandatory. The key of the cached response
+	// entry: Mandatory. The cached entry
+	// Returns error if something goes wrong
+	Put(ctx context.Context, key string, entry *Entry) error
+
+	// Invalidate removes the cached responses of the owner that have any of the given tags
+	// ctx: Mandatory. Reference to the context
+	// owner: Mandatory. The caller the responses are cached for
+	// tags: Mandatory. The tags of the responses to remove
+	// Returns error if something goes wrong
+	Invalidate(ctx context.Context, owner string, tags []string) error
+}
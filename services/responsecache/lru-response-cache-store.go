@@ -0,0 +1,168 @@
+// Package responsecache implements the cache of the responses of the read-only GraphQL operations
+package responsecache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+type lruEntry struct {
+	key   string
+	entry *Entry
+	size  int
+}
+
+type lruResponseCacheStore struct {
+	maxBytes int
+	size     int
+	lock     sync.Mutex
+	entries  *list.List
+	index    map[string]*list.Element
+	owners   map[string]map[string]*list.Element
+}
+
+// NewLruResponseCacheStore creates new instance of the lruResponseCacheStore, setting up all dependencies and returns the instance.
+// The store keeps the responses in memory and evicts the least recently used responses once the size of the cached
+// responses exceeds the configured maximum size.
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new service or error if something goes wrong
+func NewLruResponseCacheStore(configurationService configuration.ConfigurationContract) (ResponseCacheStoreContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	maxBytes, err := configurationService.GetResponseCacheMaxBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if maxBytes <= 0 {
+		return nil, commonErrors.NewUnknownError("RESPONSE_CACHE_MAX_BYTES must be greater than zero")
+	}
+
+	return &lruResponseCacheStore{
+		maxBytes: maxBytes,
+		entries:  list.New(),
+		index:    map[string]*list.Element{},
+		owners:   map[string]map[string]*list.Element{},
+	}, nil
+}
+
+// Get looks up the cached response of the given key. The expired responses are removed and reported as not found.
+// ctx: Mandatory. Reference to the context
+// key: Mandatory. The key of the cached response
+// Returns the cached entry, true if the entry is found, otherwise false, or error if something goes wrong
+func (store *lruResponseCacheStore) Get(ctx context.Context, key string) (*Entry, bool, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	element, ok := store.index[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry := element.Value.(*lruEntry).entry
+	if !time.Now().Before(entry.ExpiresAt) {
+		store.remove(element)
+
+		return nil, false, nil
+	}
+
+	store.entries.MoveToFront(element)
+
+	return entry, true, nil
+}
+
+// Put caches the response with the given key. The responses larger than the maximum size are not cached.
+// ctx: Mandatory. Reference to the context
+// key: Mandatory. The key of the cached response
+// entry: Mandatory. The cached entry
+// Returns error if something goes wrong
+func (store *lruResponseCacheStore) Put(ctx context.Context, key string, entry *Entry) error {
+	size := len(key) + len(entry.Owner) + len(entry.Data)
+	for _, tag := range entry.Tags {
+		size += len(tag)
+	}
+
+	if size > store.maxBytes {
+		return nil
+	}
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if element, ok := store.index[key]; ok {
+		store.remove(element)
+	}
+
+	element := store.entries.PushFront(&lruEntry{
+		key:   key,
+		entry: entry,
+		size:  size,
+	})
+
+	store.index[key] = element
+	store.size += size
+
+	ownerEntries, ok := store.owners[entry.Owner]
+	if !ok {
+		ownerEntries = map[string]*list.Element{}
+		store.owners[entry.Owner] = ownerEntries
+	}
+
+	ownerEntries[key] = element
+
+	for store.size > store.maxBytes {
+		store.remove(store.entries.Back())
+	}
+
+	return nil
+}
+
+// Invalidate removes the cached responses of the owner that have any of the given tags
+// ctx: Mandatory. Reference to the context
+// owner: Mandatory. The caller the responses are cached for
+// tags: Mandatory. The tags of the responses to remove
+// Returns error if something goes wrong
+func (store *lruResponseCacheStore) Invalidate(ctx context.Context, owner string, tags []string) error {
+	invalidatedTags := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		invalidatedTags[tag] = true
+	}
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	for _, element := range store.owners[owner] {
+		for _, tag := range element.Value.(*lruEntry).entry.Tags {
+			if invalidatedTags[tag] {
+				store.remove(element)
+
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func (store *lruResponseCacheStore) remove(element *list.Element) {
+	cached := element.Value.(*lruEntry)
+
+	store.entries.Remove(element)
+	delete(store.index, cached.key)
+	store.size -= cached.size
+
+	if ownerEntries, ok := store.owners[cached.entry.Owner]; ok {
+		delete(ownerEntries, cached.key)
+
+		if len(ownerEntries) == 0 {
+			delete(store.owners, cached.entry.Owner)
+		}
+	}
+}
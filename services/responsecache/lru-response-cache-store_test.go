@@ -0,0 +1,114 @@
+package responsecache_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/responsecache"
+	"github.com/golang/mock/gomock"
+)
+
+func TestLruResponseCacheStore_EvictsTheLeastRecentlyUsedEntriesBeyondTheMaxBytes(t *testing.T) {
+	// Every entry takes 10 bytes, 2 for the key, 4 for the owner and 4 for the data
+	store := newLruResponseCacheStore(t, 30)
+	ctx := context.Background()
+
+	for _, key := range []string{"k1", "k2", "k3"} {
+		putEntry(t, store, key, newEntry("user", "data", time.Minute))
+	}
+
+	// Reading the first entry makes the second entry the least recently used one
+	assertCached(t, store, "k1", true)
+
+	putEntry(t, store, "k4", newEntry("user", "data", time.Minute))
+
+	assertCached(t, store, "k1", true)
+	assertCached(t, store, "k2", false)
+	assertCached(t, store, "k3", true)
+	assertCached(t, store, "k4", true)
+
+	// The entry larger than the maximum size is never cached and does not evict the cached entries
+	putEntry(t, store, "k5", newEntry("user", "data that does not fit in the cache", time.Minute))
+
+	assertCached(t, store, "k5", false)
+	assertCached(t, store, "k4", true)
+
+	// Replacing an entry releases the size of the replaced entry
+	putEntry(t, store, "k4", newEntry("user", "more", time.Minute))
+
+	for _, key := range []string{"k1", "k3", "k4"} {
+		assertCached(t, store, key, true)
+	}
+
+	putEntry(t, store, "k6", newEntry("user", "data", -time.Second))
+
+	if _, found, err := store.Get(ctx, "k6"); err != nil || found {
+		t.Fatalf("expected the expired entry not to be returned, got %v %v", found, err)
+	}
+}
+
+func TestLruResponseCacheStore_InvalidatesTheTaggedEntriesOfTheOwner(t *testing.T) {
+	store := newLruResponseCacheStore(t, 1000)
+
+	putEntry(t, store, "alice-p1", newEntry("alice", "data", time.Minute, "p1"))
+	putEntry(t, store, "alice-p1-p2", newEntry("alice", "data", time.Minute, "p2", "p1"))
+	putEntry(t, store, "alice-p2", newEntry("alice", "data", time.Minute, "p2"))
+	putEntry(t, store, "bob-p1", newEntry("bob", "data", time.Minute, "p1"))
+
+	if err := store.Invalidate(context.Background(), "alice", []string{"p1", "p3"}); err != nil {
+		t.Fatal(err)
+	}
+
+	assertCached(t, store, "alice-p1", false)
+	assertCached(t, store, "alice-p1-p2", false)
+	assertCached(t, store, "alice-p2", true)
+	assertCached(t, store, "bob-p1", true)
+}
+
+func newLruResponseCacheStore(t *testing.T, maxBytes int) responsecache.ResponseCacheStoreContract {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetResponseCacheMaxBytes().Return(maxBytes, nil)
+
+	store, err := responsecache.NewLruResponseCacheStore(configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return store
+}
+
+func newEntry(owner string, data string, maxAge time.Duration, tags ...string) *responsecache.Entry {
+	return &responsecache.Entry{
+		Owner:     owner,
+		Tags:      tags,
+		Data:      json.RawMessage(data),
+		ExpiresAt: time.Now().Add(maxAge),
+	}
+}
+
+func putEntry(t *testing.T, store responsecache.ResponseCacheStoreContract, key string, entry *responsecache.Entry) {
+	t.Helper()
+
+	if err := store.Put(context.Background(), key, entry); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertCached(t *testing.T, store responsecache.ResponseCacheStoreContract, key string, expected bool) {
+	t.Helper()
+
+	_, found, err := store.Get(context.Background(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if found != expected {
+		t.Fatalf("expected the %s entry cached to be %v, got %v", key, expected, found)
+	}
+}
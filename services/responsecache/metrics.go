@@ -0,0 +1,14 @@
+// Package responsecache implements the cache of the responses of the read-only GraphQL operations
+package responsecache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var lookupsCounter = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "api_gateway_response_cache_lookups_total",
+		Help: "The number of query operations looked up in the response cache by result, either hit or miss",
+	},
+	[]string{"result"})
@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/responsecache/contract.go
+
+// Package mock_responsecache is a generated GoMock package.
+package mock_responsecache
+
+import (
+	context "context"
+	reflect "reflect"
+
+	responsecache "github.com/decentralized-cloud/api-gateway/services/responsecache"
+	gomock "github.com/golang/mock/gomock"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+// MockResponseCacheContract is a mock of ResponseCacheContract interface.
+type MockResponseCacheContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockResponseCacheContractMockRecorder
+}
+
+// MockResponseCacheContractMockRecorder is the mock recorder for MockResponseCacheContract.
+type MockResponseCacheContractMockRecorder struct {
+	mock *MockResponseCacheContract
+}
+
+// NewMockResponseCacheContract creates a new mock instance.
+func NewMockResponseCacheContract(ctrl *gomock.Controller) *MockResponseCacheContract {
+	mock := &MockResponseCacheContract{ctrl: ctrl}
+	mock.recorder = &MockResponseCacheContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResponseCacheContract) EXPECT() *MockResponseCacheContractMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockResponseCacheContract) Get(ctx context.Context, operation *responsecache.Operation) (*graphql.Response, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, operation)
+	ret0, _ := ret[0].(*graphql.Response)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockResponseCacheContractMockRecorder) Get(ctx, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockResponseCacheContract)(nil).Get), ctx, operation)
+}
+
+// Invalidate mocks base method.
+func (m *MockResponseCacheContract) Invalidate(ctx context.Context, operation *responsecache.Operation, response *graphql.Response) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Invalidate", ctx, operation, response)
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockResponseCacheContractMockRecorder) Invalidate(ctx, operation, response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockResponseCacheContract)(nil).Invalidate), ctx, operation, response)
+}
+
+// Put mocks base method.
+func (m *MockResponseCacheContract) Put(ctx context.Context, operation *responsecache.Operation, response *graphql.Response) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Put", ctx, operation, response)
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockResponseCacheContractMockRecorder) Put(ctx, operation, response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockResponseCacheContract)(nil).Put), ctx, operation, response)
+}
+
+// MockResponseCacheStoreContract is a mock of ResponseCacheStoreContract interface.
+type MockResponseCacheStoreContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockResponseCacheStoreContractMockRecorder
+}
+
+// MockResponseCacheStoreContractMockRecorder is the mock recorder for MockResponseCacheStoreContract.
+type MockResponseCacheStoreContractMockRecorder struct {
+	mock *MockResponseCacheStoreContract
+}
+
+// NewMockResponseCacheStoreContract creates a new mock instance.
+func NewMockResponseCacheStoreContract(ctrl *gomock.Controller) *MockResponseCacheStoreContract {
+	mock := &MockResponseCacheStoreContract{ctrl: ctrl}
+	mock.recorder = &MockResponseCacheStoreContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResponseCacheStoreContract) EXPECT() *MockResponseCacheStoreContractMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockResponseCacheStoreContract) Get(ctx context.Context, key string) (*responsecache.Entry, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(*responsecache.Entry)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockResponseCacheStoreContractMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockResponseCacheStoreContract)(nil).Get), ctx, key)
+}
+
+// Invalidate mocks base method.
+func (m *MockResponseCacheStoreContract) Invalidate(ctx context.Context, owner string, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invalidate", ctx, owner, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockResponseCacheStoreContractMockRecorder) Invalidate(ctx, owner, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockResponseCacheStoreContract)(nil).Invalidate), ctx, owner, tags)
+}
+
+// Put mocks base method.
+func (m *MockResponseCacheStoreContract) Put(ctx context.Context, key string, entry *responsecache.Entry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockResponseCacheStoreContractMockRecorder) Put(ctx, key, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockResponseCacheStoreContract)(nil).Put), ctx, key, entry)
+}
@@ -0,0 +1,350 @@
+// Package responsecache implements the cache of the responses of the read-only GraphQL operations
+package responsecache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/graph-gophers/graphql-go"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"go.uber.org/zap"
+)
+
+const (
+	// cacheControlDirective is the name of the directive that sets the max-age of the field
+	cacheControlDirective = "cacheControl"
+
+	// connectionTypeSuffix is the suffix of the names of the types that list projects or edge clusters
+	connectionTypeSuffix = "Connection"
+
+	// connectionTag is the tag of the responses that list projects or edge clusters. Any mutation can add an object to
+	// or remove an object from these lists, so they are invalidated by all mutations.
+	connectionTag = "*connection"
+)
+
+type responseCacheService struct {
+	logger          *zap.Logger
+	globalIDService globalid.GlobalIDContract
+	store           ResponseCacheStoreContract
+	enabled         bool
+	defaultMaxAge   time.Duration
+}
+
+// NewResponseCacheService creates new instance of the responseCacheService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// globalIDService: Mandatory. Reference to the service that decodes the global identifiers the responses refer to
+// store: Mandatory. Reference to the store that keeps the cached responses
+// Returns the new service or error if something goes wrong
+func NewResponseCacheService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	globalIDService globalid.GlobalIDContract,
+	store ResponseCacheStoreContract) (ResponseCacheContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if globalIDService == nil {
+		return nil, commonErrors.NewArgumentNilError("globalIDService", "globalIDService is required")
+	}
+
+	if store == nil {
+		return nil, commonErrors.NewArgumentNilError("store", "store is required")
+	}
+
+	enabled, err := configurationService.GetResponseCacheEnabled()
+	if err != nil {
+		return nil, err
+	}
+
+	defaultMaxAge, err := configurationService.GetResponseCacheDefaultMaxAge()
+	if err != nil {
+		return nil, err
+	}
+
+	return &responseCacheService{
+		logger:          logger,
+		globalIDService: globalIDService,
+		store:           store,
+		enabled:         enabled,
+		defaultMaxAge:   defaultMaxAge,
+	}, nil
+}
+
+// Get returns the cached response of the query operation
+// ctx: Mandatory. Reference to the context
+// operation: Mandatory. The query operation
+// Returns the cached response and true if the response is found and not expired, otherwise false
+func (service *responseCacheService) Get(ctx context.Context, operation *Operation) (*graphql.Response, bool) {
+	owner, ok := service.owner(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	entry, found, err := service.store.Get(ctx, cacheKey(owner, operation))
+	if err != nil {
+		service.logger.Warn("Failed to read the cached response", zap.Error(err))
+
+		return nil, false
+	}
+
+	if !found {
+		lookupsCounter.WithLabelValues("miss").Inc()
+
+		return nil, false
+	}
+
+	lookupsCounter.WithLabelValues("hit").Inc()
+
+	return &graphql.Response{Data: entry.Data}, true
+}
+
+// Put caches the response of the query operation for the smallest max-age of the selected fields. The responses
+// with errors are not cached, neither are the responses with extensions as only the data of the responses is cached.
+// ctx: Mandatory. Reference to the context
+// operation: Mandatory. The query operation
+// response: Mandatory. The response of the query operation
+func (service *responseCacheService) Put(ctx context.Context, operation *Operation, response *graphql.Response) {
+	owner, ok := service.owner(ctx)
+	if !ok || len(response.Errors) > 0 || len(response.Extensions) > 0 {
+		return
+	}
+
+	analyzer := service.analyze(operation, response)
+	if analyzer.maxAge <= 0 {
+		return
+	}
+
+	entry := &Entry{
+		Owner:     owner,
+		Tags:      analyzer.tagList(),
+		Data:      response.Data,
+		ExpiresAt: time.Now().Add(analyzer.maxAge),
+	}
+
+	if err := service.store.Put(ctx, cacheKey(owner, operation), entry); err != nil {
+		service.logger.Warn("Failed to cache the response", zap.Error(err))
+	}
+}
+
+// Invalidate removes the cached responses of the caller that refer to the projects and the edge clusters the
+// mutation touched, along with the cached responses that list projects or edge clusters
+// ctx: Mandatory. Reference to the context
+// operation: Mandatory. The mutation operation
+// response: Mandatory. The response of the mutation operation
+func (service *responseCacheService) Invalidate(ctx context.Context, operation *Operation, response *graphql.Response) {
+	owner, ok := service.owner(ctx)
+	if !ok {
+		return
+	}
+
+	analyzer := service.analyze(operation, response)
+	analyzer.tags[connectionTag] = true
+
+	if err := service.store.Invalidate(ctx, owner, analyzer.tagList()); err != nil {
+		service.logger.Warn("Failed to invalidate the cached responses", zap.Error(err))
+	}
+}
+
+// owner returns the subject of the authenticated caller the responses are cached for
+func (service *responseCacheService) owner(ctx context.Context) (string, bool) {
+	if !service.enabled {
+		return "", false
+	}
+
+	principal, ok := identity.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	return principal.Subject, true
+}
+
+// analyze computes the max-age of the operation and collects the tags of the objects the operation refers to
+func (service *responseCacheService) analyze(operation *Operation, response *graphql.Response) *operationAnalyzer {
+	analyzer := &operationAnalyzer{
+		service:   service,
+		variables: operation.Variables,
+		tags:      map[string]bool{},
+		maxAge:    -1,
+	}
+
+	analyzer.analyzeSelectionSet(operation.Definition.SelectionSet, 0, true)
+
+	if len(response.Data) > 0 {
+		var data interface{}
+		if err := json.Unmarshal(response.Data, &data); err == nil {
+			analyzer.collectResponseIDs(data)
+		}
+	}
+
+	return analyzer
+}
+
+// tag returns the tag of the object the identifier refers to. The global identifiers are decoded so the responses
+// are invalidated regardless of the type the object is reported as, for example a project reported as the project
+// of an edge cluster.
+func (service *responseCacheService) tag(id string) string {
+	if _, backendID, err := service.globalIDService.ParseGlobalID(graphql.ID(id)); err == nil {
+		return backendID
+	}
+
+	return id
+}
+
+type operationAnalyzer struct {
+	service   *responseCacheService
+	variables map[string]interface{}
+	tags      map[string]bool
+	maxAge    time.Duration
+}
+
+// analyzeSelectionSet computes the smallest max-age of the selected fields. The root fields without the cacheControl
+// directive have the default max-age, all other fields without the directive inherit the max-age of their parent.
+func (analyzer *operationAnalyzer) analyzeSelectionSet(selectionSet ast.SelectionSet, parentMaxAge time.Duration, root bool) {
+	for _, selection := range selectionSet {
+		switch castedSelection := selection.(type) {
+		case *ast.Field:
+			analyzer.analyzeField(castedSelection, parentMaxAge, root)
+		case *ast.InlineFragment:
+			analyzer.analyzeSelectionSet(castedSelection.SelectionSet, parentMaxAge, root)
+		case *ast.FragmentSpread:
+			if castedSelection.Definition != nil {
+				analyzer.analyzeSelectionSet(castedSelection.Definition.SelectionSet, parentMaxAge, root)
+			}
+		}
+	}
+}
+
+func (analyzer *operationAnalyzer) analyzeField(field *ast.Field, parentMaxAge time.Duration, root bool) {
+	maxAge := parentMaxAge
+	if root {
+		maxAge = analyzer.service.defaultMaxAge
+	}
+
+	if field.Definition != nil {
+		if hintedMaxAge, ok := cacheControlMaxAge(field.Definition); ok {
+			maxAge = hintedMaxAge
+		}
+
+		if field.Definition.Type != nil && strings.HasSuffix(field.Definition.Type.Name(), connectionTypeSuffix) {
+			analyzer.tags[connectionTag] = true
+		}
+	}
+
+	if analyzer.maxAge < 0 || maxAge < analyzer.maxAge {
+		analyzer.maxAge = maxAge
+	}
+
+	for _, argument := range field.Arguments {
+		if value, err := argument.Value.Value(analyzer.variables); err == nil {
+			analyzer.collectArgumentIDs(value)
+		}
+	}
+
+	analyzer.analyzeSelectionSet(field.SelectionSet, maxAge, false)
+}
+
+// collectArgumentIDs tags the operation with every string argument value, the arguments that are not identifiers at
+// worst invalidate more responses than needed
+func (analyzer *operationAnalyzer) collectArgumentIDs(value interface{}) {
+	switch castedValue := value.(type) {
+	case string:
+		analyzer.tags[analyzer.service.tag(castedValue)] = true
+	case []interface{}:
+		for _, item := range castedValue {
+			analyzer.collectArgumentIDs(item)
+		}
+	case map[string]interface{}:
+		for _, item := range castedValue {
+			analyzer.collectArgumentIDs(item)
+		}
+	}
+}
+
+// collectResponseIDs tags the operation with the identifiers of the objects reported in the response
+func (analyzer *operationAnalyzer) collectResponseIDs(value interface{}) {
+	switch castedValue := value.(type) {
+	case []interface{}:
+		for _, item := range castedValue {
+			analyzer.collectResponseIDs(item)
+		}
+	case map[string]interface{}:
+		for key, item := range castedValue {
+			if id, ok := item.(string); ok && key == "id" {
+				analyzer.tags[analyzer.service.tag(id)] = true
+
+				continue
+			}
+
+			analyzer.collectResponseIDs(item)
+		}
+	}
+}
+
+func (analyzer *operationAnalyzer) tagList() []string {
+	tags := make([]string, 0, len(analyzer.tags))
+	for tag := range analyzer.tags {
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// cacheControlMaxAge returns the max-age set by the cacheControl directive of the field
+func cacheControlMaxAge(definition *ast.FieldDefinition) (time.Duration, bool) {
+	directive := definition.Directives.ForName(cacheControlDirective)
+	if directive == nil {
+		return 0, false
+	}
+
+	argument := directive.Arguments.ForName("maxAge")
+	if argument == nil {
+		return 0, false
+	}
+
+	value, err := argument.Value.Value(nil)
+	if err != nil {
+		return 0, false
+	}
+
+	seconds, ok := value.(int64)
+	if !ok {
+		return 0, false
+	}
+
+	return time.Duration(seconds) * time.Second, true
+}
+
+// cacheKey returns the key of the response of the operation cached for the owner. The document is formatted so the
+// requests that differ only in whitespace and comments share the cached response.
+func cacheKey(owner string, operation *Operation) string {
+	var document bytes.Buffer
+	formatter.NewFormatter(&document).FormatQueryDocument(operation.Document)
+
+	// The keys of the variables are sorted by the JSON encoder so the same variables always have the same encoding
+	variables, _ := json.Marshal(operation.Variables)
+
+	hash := sha256.New()
+	for _, part := range [][]byte{[]byte(owner), []byte(operation.Definition.Name), document.Bytes(), variables} {
+		_, _ = hash.Write(part)
+		_, _ = hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
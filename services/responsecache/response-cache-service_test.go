@@ -0,0 +1,140 @@
+package responsecache_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	"github.com/decentralized-cloud/api-gateway/services/globalid"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/decentralized-cloud/api-gateway/services/responsecache"
+	"github.com/golang/mock/gomock"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.uber.org/zap"
+)
+
+func TestResponseCacheService_MutationsInvalidateTheResponsesOfTheirCaller(t *testing.T) {
+	service, globalIDService, schema := newResponseCacheService(t)
+	firstProjectID := globalIDService.ToGlobalID(globalid.TypeProject, "p1")
+	secondProjectID := globalIDService.ToGlobalID(globalid.TypeProject, "p2")
+
+	alice := identity.NewContext(context.Background(), &identity.Principal{Subject: "alice"})
+	bob := identity.NewContext(context.Background(), &identity.Principal{Subject: "bob"})
+
+	firstProject := newOperation(t, schema, fmt.Sprintf(`{ node(id: "%s") { id } }`, firstProjectID))
+	secondProject := newOperation(t, schema, fmt.Sprintf(`{ node(id: "%s") { id } }`, secondProjectID))
+
+	for _, ctx := range []context.Context{alice, bob} {
+		service.Put(ctx, firstProject, &graphql.Response{Data: json.RawMessage(fmt.Sprintf(`{"node":{"id":"%s"}}`, firstProjectID))})
+		service.Put(ctx, secondProject, &graphql.Response{Data: json.RawMessage(fmt.Sprintf(`{"node":{"id":"%s"}}`, secondProjectID))})
+	}
+
+	response, ok := service.Get(alice, firstProject)
+	if !ok || string(response.Data) != fmt.Sprintf(`{"node":{"id":"%s"}}`, firstProjectID) {
+		t.Fatalf("expected the cached response, got %v %v", response, ok)
+	}
+
+	service.Invalidate(
+		alice,
+		newOperation(t, schema, fmt.Sprintf(`mutation { deleteProject(input: { projectID: "%s" }) { deletedProjectID } }`, firstProjectID)),
+		&graphql.Response{Data: json.RawMessage(fmt.Sprintf(`{"deleteProject":{"deletedProjectID":"%s"}}`, firstProjectID))})
+
+	if _, ok := service.Get(alice, firstProject); ok {
+		t.Fatal("expected the response that refers to the deleted project to be invalidated")
+	}
+
+	if _, ok := service.Get(alice, secondProject); !ok {
+		t.Fatal("expected the response that does not refer to the deleted project to stay cached")
+	}
+
+	if _, ok := service.Get(bob, firstProject); !ok {
+		t.Fatal("expected the responses cached for the other callers to stay cached")
+	}
+}
+
+func TestResponseCacheService_OnlyCachesTheSuccessfulResponsesOfTheAuthenticatedCallers(t *testing.T) {
+	service, _, schema := newResponseCacheService(t)
+	alice := identity.NewContext(context.Background(), &identity.Principal{Subject: "alice"})
+	operation := newOperation(t, schema, `{ user { id } }`)
+
+	service.Put(context.Background(), operation, &graphql.Response{Data: json.RawMessage(`{"user":{"id":"u1"}}`)})
+	if _, ok := service.Get(context.Background(), operation); ok {
+		t.Fatal("expected the response of the anonymous caller not to be cached")
+	}
+
+	service.Put(alice, operation, &graphql.Response{
+		Data:   json.RawMessage(`{"user":null}`),
+		Errors: []*gqlerrors.QueryError{{Message: "failure"}},
+	})
+	if _, ok := service.Get(alice, operation); ok {
+		t.Fatal("expected the response with errors not to be cached")
+	}
+
+	service.Put(alice, operation, &graphql.Response{
+		Data:       json.RawMessage(`{"user":{"id":"u1"}}`),
+		Extensions: map[string]interface{}{"warnings": []interface{}{map[string]interface{}{"message": "truncated"}}},
+	})
+	if _, ok := service.Get(alice, operation); ok {
+		t.Fatal("expected the response with extensions not to be cached")
+	}
+
+	service.Put(alice, newOperation(t, schema, `{ node(id: "e1") { ... on EdgeCluster { clusterSecret } } }`), &graphql.Response{
+		Data: json.RawMessage(`{"node":{"clusterSecret":"secret"}}`),
+	})
+	if _, ok := service.Get(alice, newOperation(t, schema, `{ node(id: "e1") { ... on EdgeCluster { clusterSecret } } }`)); ok {
+		t.Fatal("expected the response of the field with zero max-age not to be cached")
+	}
+}
+
+func newResponseCacheService(t *testing.T) (responsecache.ResponseCacheContract, globalid.GlobalIDContract, *ast.Schema) {
+	t.Helper()
+
+	schemaDocument, err := ioutil.ReadFile("../../contract/graphql/schema/schema.graphql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: string(schemaDocument)})
+	if gqlErr != nil {
+		t.Fatal(gqlErr)
+	}
+
+	mockCtrl := gomock.NewController(t)
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetGraphQLRawIDs().Return(false, nil)
+	configurationService.EXPECT().GetResponseCacheEnabled().Return(true, nil)
+	configurationService.EXPECT().GetResponseCacheDefaultMaxAge().Return(time.Minute, nil)
+
+	globalIDService, err := globalid.NewBase64GlobalIDService(configurationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service, err := responsecache.NewResponseCacheService(zap.NewNop(), configurationService, globalIDService, newLruResponseCacheStore(t, 100000))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return service, globalIDService, schema
+}
+
+func newOperation(t *testing.T, schema *ast.Schema, query string) *responsecache.Operation {
+	t.Helper()
+
+	document, gqlErrs := gqlparser.LoadQuery(schema, query)
+	if len(gqlErrs) > 0 {
+		t.Fatal(gqlErrs)
+	}
+
+	return &responsecache.Operation{
+		Document:   document,
+		Definition: document.Operations[0],
+	}
+}
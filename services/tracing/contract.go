@@ -0,0 +1,19 @@
+// Package tracing implements the services that trace the GraphQL requests across the HTTP transport, the GraphQL
+// resolvers and the backend gRPC calls
+package tracing
+
+import (
+	"context"
+)
+
+// InstrumentationName is the name of the tracer that creates the api-gateway spans
+const InstrumentationName = "github.com/decentralized-cloud/api-gateway"
+
+// TracingContract declares the service that sets up the tracer provider and the context propagation used by all the
+// instrumented layers
+type TracingContract interface {
+	// Shutdown exports the spans not exported yet and stops the tracing
+	// ctx: Mandatory. Reference to the context
+	// Returns error if something goes wrong
+	Shutdown(ctx context.Context) error
+}
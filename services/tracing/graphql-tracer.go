@@ -0,0 +1,135 @@
+// Package tracing implements the services that trace the GraphQL requests across the HTTP transport, the GraphQL
+// resolvers and the backend gRPC calls
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/introspection"
+	gqltrace "github.com/graph-gophers/graphql-go/trace"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type graphQLTracer struct {
+	tracer trace.Tracer
+}
+
+// NewGraphQLTracer creates the GraphQL tracer that creates a span for the GraphQL request, its validation and every
+// non-trivial resolved field using the globally registered tracer provider
+// Returns the new GraphQL tracer
+func NewGraphQLTracer() gqltrace.Tracer {
+	return &graphQLTracer{
+		tracer: otel.Tracer(InstrumentationName),
+	}
+}
+
+// TraceQuery starts the span that covers the whole GraphQL request
+func (graphQLTracer *graphQLTracer) TraceQuery(
+	ctx context.Context,
+	queryString string,
+	operationName string,
+	variables map[string]interface{},
+	varTypes map[string]*introspection.Type) (context.Context, gqltrace.TraceQueryFinishFunc) {
+	spanName := "GraphQL request"
+	if operationName != "" {
+		spanName = fmt.Sprintf("GraphQL request: %s", operationName)
+	}
+
+	// Neither the document nor the variables are recorded as they may contain sensitive values. The document hash still
+	// lets the spans of the same document be grouped and matched with the persisted queries.
+	documentHash := sha256.Sum256([]byte(queryString))
+
+	ctx, span := graphQLTracer.tracer.Start(
+		ctx,
+		spanName,
+		trace.WithAttributes(
+			attribute.String("graphql.operation.name", operationName),
+			attribute.String("graphql.operation.type", getOperationType(queryString, operationName)),
+			attribute.String("graphql.document.sha256", hex.EncodeToString(documentHash[:]))))
+
+	return ctx, func(queryErrors []*gqlerrors.QueryError) {
+		recordQueryErrors(span, queryErrors)
+		span.End()
+	}
+}
+
+// TraceField starts the span that covers resolving a single field. No span is created for the trivial fields that are
+// read from the already resolved values.
+func (graphQLTracer *graphQLTracer) TraceField(
+	ctx context.Context,
+	label string,
+	typeName string,
+	fieldName string,
+	trivial bool,
+	args map[string]interface{}) (context.Context, gqltrace.TraceFieldFinishFunc) {
+	if trivial {
+		return ctx, func(*gqlerrors.QueryError) {}
+	}
+
+	ctx, span := graphQLTracer.tracer.Start(
+		ctx,
+		label,
+		trace.WithAttributes(
+			attribute.String("graphql.type", typeName),
+			attribute.String("graphql.field", fieldName)))
+
+	return ctx, func(queryError *gqlerrors.QueryError) {
+		if queryError != nil {
+			recordQueryErrors(span, []*gqlerrors.QueryError{queryError})
+		}
+
+		span.End()
+	}
+}
+
+// TraceValidation starts the span that covers validating the GraphQL request against the schema
+func (graphQLTracer *graphQLTracer) TraceValidation(ctx context.Context) gqltrace.TraceValidationFinishFunc {
+	_, span := graphQLTracer.tracer.Start(ctx, "GraphQL validation")
+
+	return func(queryErrors []*gqlerrors.QueryError) {
+		recordQueryErrors(span, queryErrors)
+		span.End()
+	}
+}
+
+// getOperationType returns the type of the requested operation, or unknown if the document can not be parsed or does not
+// contain the requested operation
+func getOperationType(queryString string, operationName string) string {
+	document, err := parser.ParseQuery(&ast.Source{Input: queryString})
+	if err != nil {
+		return "unknown"
+	}
+
+	operation := document.Operations.ForName(operationName)
+	if operation == nil {
+		return "unknown"
+	}
+
+	return string(operation.Operation)
+}
+
+func recordQueryErrors(span trace.Span, queryErrors []*gqlerrors.QueryError) {
+	if len(queryErrors) == 0 {
+		return
+	}
+
+	for _, queryError := range queryErrors {
+		span.RecordError(queryError)
+	}
+
+	message := queryErrors[0].Error()
+	if len(queryErrors) > 1 {
+		message = fmt.Sprintf("%s (and %d more errors)", message, len(queryErrors)-1)
+	}
+
+	span.SetStatus(codes.Error, message)
+}
@@ -0,0 +1,120 @@
+package tracing_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/decentralized-cloud/api-gateway/services/tracing"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestGraphQLTracer_DoesNotRecordTheDocument(t *testing.T) {
+	tests := []struct {
+		name          string
+		document      string
+		operationName string
+		operationType string
+	}{
+		{
+			name:          "named mutation",
+			document:      `mutation CreateProject { createProject(input: { name: "secret project name" }) { clientMutationId } }`,
+			operationName: "CreateProject",
+			operationType: "mutation",
+		},
+		{
+			name:          "anonymous query",
+			document:      `{ node(id: "secret project id") { id } }`,
+			operationType: "query",
+		},
+		{
+			name:          "operation picked from the document",
+			document:      `query First { user { id } } subscription Second { edgeClusterChanged(edgeClusterID: "secret") { clientMutationId } }`,
+			operationName: "Second",
+			operationType: "subscription",
+		},
+		{
+			name:          "document that can not be parsed",
+			document:      `query { user(token: "secret"`,
+			operationType: "unknown",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exporter := newInMemoryExporter(t)
+
+			_, finish := tracing.NewGraphQLTracer().TraceQuery(context.Background(), test.document, test.operationName, nil, nil)
+			finish(nil)
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("expected a single span, got %d", len(spans))
+			}
+
+			attributes := map[string]string{}
+			for _, attribute := range spans[0].Attributes {
+				attributes[string(attribute.Key)] = attribute.Value.Emit()
+
+				if strings.Contains(attribute.Value.Emit(), "secret") {
+					t.Fatalf("expected the document not to be recorded, got %s=%s", attribute.Key, attribute.Value.Emit())
+				}
+			}
+
+			documentHash := sha256.Sum256([]byte(test.document))
+
+			if attributes["graphql.document.sha256"] != hex.EncodeToString(documentHash[:]) {
+				t.Fatalf("expected the document hash to be recorded, got %v", attributes)
+			}
+
+			if attributes["graphql.operation.type"] != test.operationType {
+				t.Fatalf("expected the %s operation type, got %v", test.operationType, attributes)
+			}
+
+			if attributes["graphql.operation.name"] != test.operationName {
+				t.Fatalf("expected the %s operation name, got %v", test.operationName, attributes)
+			}
+		})
+	}
+}
+
+func TestGraphQLTracer_RecordsTheErrors(t *testing.T) {
+	exporter := newInMemoryExporter(t)
+
+	_, finish := tracing.NewGraphQLTracer().TraceQuery(context.Background(), "{ user { id } }", "", nil, nil)
+	finish([]*gqlerrors.QueryError{{Message: "first failure"}, {Message: "second failure"}})
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected a single span, got %d", len(spans))
+	}
+
+	if spans[0].Status.Code != codes.Error || spans[0].Status.Description != "graphql: first failure (and 1 more errors)" {
+		t.Fatalf("expected the span to fail with the first error, got %v", spans[0].Status)
+	}
+
+	if len(spans[0].Events) != 2 {
+		t.Fatalf("expected both errors to be recorded, got %d events", len(spans[0].Events))
+	}
+}
+
+func newInMemoryExporter(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+
+	tracingService, err := tracing.NewInMemoryTracingService(exporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = tracingService.Shutdown(context.Background())
+	})
+
+	return exporter
+}
@@ -0,0 +1,41 @@
+// Package tracing implements the services that trace the GraphQL requests across the HTTP transport, the GraphQL
+// resolvers and the backend gRPC calls
+package tracing
+
+import (
+	"context"
+
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	sdkTrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type inMemoryTracingService struct {
+	tracerProvider *sdkTrace.TracerProvider
+}
+
+// NewInMemoryTracingService creates new instance of the inMemoryTracingService, setting up all dependencies and returns the instance.
+// All the spans are sampled and stored in the given exporter as soon as they end so the tests can inspect them.
+// exporter: Mandatory. Reference to the in-memory exporter that stores the ended spans
+// Returns the new service or error if something goes wrong
+func NewInMemoryTracingService(exporter *tracetest.InMemoryExporter) (TracingContract, error) {
+	if exporter == nil {
+		return nil, commonErrors.NewArgumentNilError("exporter", "exporter is required")
+	}
+
+	tracerProvider := sdkTrace.NewTracerProvider(
+		sdkTrace.WithSampler(sdkTrace.AlwaysSample()),
+		sdkTrace.WithSyncer(exporter))
+	registerGlobally(tracerProvider)
+
+	return &inMemoryTracingService{
+		tracerProvider: tracerProvider,
+	}, nil
+}
+
+// Shutdown exports the spans not exported yet and stops the tracing
+// ctx: Mandatory. Reference to the context
+// Returns error if something goes wrong
+func (service *inMemoryTracingService) Shutdown(ctx context.Context) error {
+	return service.tracerProvider.Shutdown(ctx)
+}
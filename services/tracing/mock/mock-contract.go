@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/tracing/contract.go
+
+// Package mock_tracing is a generated GoMock package.
+package mock_tracing
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTracingContract is a mock of TracingContract interface.
+type MockTracingContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockTracingContractMockRecorder
+}
+
+// MockTracingContractMockRecorder is the mock recorder for MockTracingContract.
+type MockTracingContractMockRecorder struct {
+	mock *MockTracingContract
+}
+
+// NewMockTracingContract creates a new mock instance.
+func NewMockTracingContract(ctrl *gomock.Controller) *MockTracingContract {
+	mock := &MockTracingContract{ctrl: ctrl}
+	mock.recorder = &MockTracingContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTracingContract) EXPECT() *MockTracingContractMockRecorder {
+	return m.recorder
+}
+
+// Shutdown mocks base method.
+func (m *MockTracingContract) Shutdown(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shutdown", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Shutdown indicates an expected call of Shutdown.
+func (mr *MockTracingContractMockRecorder) Shutdown(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockTracingContract)(nil).Shutdown), ctx)
+}
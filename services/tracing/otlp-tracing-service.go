@@ -0,0 +1,129 @@
+// Package tracing implements the services that trace the GraphQL requests across the HTTP transport, the GraphQL
+// resolvers and the backend gRPC calls
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdkTrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.uber.org/zap"
+)
+
+// serviceName is the name the api-gateway spans are reported under
+const serviceName = "api-gateway"
+
+type otlpTracingService struct {
+	tracerProvider *sdkTrace.TracerProvider
+}
+
+// NewOtlpTracingService creates new instance of the otlpTracingService, setting up all dependencies and returns the instance.
+// The service registers the tracer provider and the W3C trace context propagator globally and exports the sampled spans
+// to the configured OTLP endpoint. The spans are still created and propagated if the endpoint is not configured.
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new service or error if something goes wrong
+func NewOtlpTracingService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract) (TracingContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	sampler, err := newSampler(configurationService)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := configurationService.GetTracingOtlpEndpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	options := []sdkTrace.TracerProviderOption{
+		sdkTrace.WithSampler(sampler),
+		sdkTrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	}
+
+	if strings.Trim(endpoint, " ") == "" {
+		logger.Info("Tracing OTLP endpoint is not configured, the spans are not exported")
+	} else {
+		insecure, err := configurationService.GetTracingOtlpInsecure()
+		if err != nil {
+			return nil, err
+		}
+
+		exporterOptions := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+		if insecure {
+			exporterOptions = append(exporterOptions, otlptracegrpc.WithInsecure())
+		}
+
+		// The exporter connects in background so an unavailable collector does not prevent the service from starting
+		exporter := otlptracegrpc.NewUnstarted(exporterOptions...)
+		if err := exporter.Start(context.Background()); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("Failed to start the OTLP trace exporter", err)
+		}
+
+		options = append(options, sdkTrace.WithBatcher(exporter))
+	}
+
+	tracerProvider := sdkTrace.NewTracerProvider(options...)
+	registerGlobally(tracerProvider)
+
+	return &otlpTracingService{
+		tracerProvider: tracerProvider,
+	}, nil
+}
+
+// Shutdown exports the spans not exported yet and stops the tracing
+// ctx: Mandatory. Reference to the context
+// Returns error if something goes wrong
+func (service *otlpTracingService) Shutdown(ctx context.Context) error {
+	return service.tracerProvider.Shutdown(ctx)
+}
+
+func newSampler(configurationService configuration.ConfigurationContract) (sdkTrace.Sampler, error) {
+	samplerName, err := configurationService.GetTracingSampler()
+	if err != nil {
+		return nil, err
+	}
+
+	ratio, err := configurationService.GetTracingSamplerRatio()
+	if err != nil {
+		return nil, err
+	}
+
+	switch samplerName {
+	case "always_on":
+		return sdkTrace.AlwaysSample(), nil
+	case "always_off":
+		return sdkTrace.NeverSample(), nil
+	case "traceidratio":
+		return sdkTrace.TraceIDRatioBased(ratio), nil
+	case "parentbased_always_on":
+		return sdkTrace.ParentBased(sdkTrace.AlwaysSample()), nil
+	case "parentbased_always_off":
+		return sdkTrace.ParentBased(sdkTrace.NeverSample()), nil
+	case "parentbased_traceidratio":
+		return sdkTrace.ParentBased(sdkTrace.TraceIDRatioBased(ratio)), nil
+	default:
+		return nil, commonErrors.NewUnknownError(fmt.Sprintf("Unknown tracing sampler %s", samplerName))
+	}
+}
+
+func registerGlobally(tracerProvider *sdkTrace.TracerProvider) {
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
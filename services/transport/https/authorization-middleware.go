@@ -4,8 +4,10 @@ package https
 import (
 	"context"
 
+	"github.com/decentralized-cloud/api-gateway/services/identity"
 	"github.com/go-kit/kit/endpoint"
-	gocorefasthttp "github.com/micro-business/go-core/jwt/fasthttp"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	gocorejwt "github.com/micro-business/go-core/jwt"
 	"github.com/valyala/fasthttp"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -15,22 +17,39 @@ import (
 func (service *transportService) createAuthMiddleware(endpointName string) endpoint.Middleware {
 	return func(next endpoint.Endpoint) endpoint.Endpoint {
 		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-
-			_, err = gocorefasthttp.ParseAndVerifyToken(ctx, service.jwksURL, true)
-			if err != nil {
-				return nil, err
-			}
-
-			convertedCtx, ok := ctx.(*fasthttp.RequestCtx)
+			// The authorization header is populated in the context by the HTTP transport before the endpoint is called
+			bearerToken, ok := ctx.Value(httpTransport.ContextKeyRequestAuthorization).(string)
 			if !ok {
-				return nil, status.Errorf(codes.Unauthenticated, "Failed to cast ctx to fasthttp.RequestCtx")
+				return nil, status.Errorf(codes.Unauthenticated, "Failed to read the authorization header from ctx")
 			}
 
-			if bearerToken := string(convertedCtx.Request.Header.Peek(fasthttp.HeaderAuthorization)); len(bearerToken) != 0 {
-				ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(fasthttp.HeaderAuthorization, bearerToken))
+			ctx, err = service.authenticate(ctx, bearerToken)
+			if err != nil {
+				return nil, err
 			}
 
 			return next(ctx, request)
 		}
 	}
 }
+
+// authenticate verifies the bearer token and returns a copy of the context that carries the authenticated principal
+// and forwards the bearer token to the backend services
+// ctx: Mandatory. Reference to the context
+// bearerToken: Mandatory. The value of the authorization header in the format of "Bearer <token>"
+// Returns the new context or error if the token is not valid
+func (service *transportService) authenticate(ctx context.Context, bearerToken string) (context.Context, error) {
+	token, err := gocorejwt.ParseAndVerifyToken(ctx, bearerToken, service.jwksURL, true)
+	if err != nil {
+		return nil, err
+	}
+
+	principal, err := service.identityService.NewPrincipal(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to extract the principal from the access token. Error: %v", err)
+	}
+
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(fasthttp.HeaderAuthorization, bearerToken))
+
+	return identity.NewContext(ctx, principal), nil
+}
@@ -0,0 +1,162 @@
+// Package https implements functions to expose api-gateway service endpoint using HTTPS/GraphQL protocol.
+package https
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/decentralized-cloud/api-gateway/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/savsgio/atreugo/v11"
+	"github.com/valyala/fasthttp"
+)
+
+// corsAllowedMethods contains the methods the GraphQL routes accept from the cross origin requests
+const corsAllowedMethods = "GET, POST, OPTIONS"
+
+// originPattern matches the origins that use a wildcard for the subdomains, e.g. https://*.example.com
+type originPattern struct {
+	prefix string
+	suffix string
+}
+
+// corsPolicy decides which origins are allowed to send cross origin requests to the GraphQL routes and the headers
+// returned to them
+type corsPolicy struct {
+	allowAllOrigins  bool
+	allowedOrigins   map[string]struct{}
+	originPatterns   []originPattern
+	allowedHeaders   string
+	exposedHeaders   string
+	allowCredentials bool
+	maxAge           string
+}
+
+// newCorsPolicy creates the CORS policy using the configured allowed origins, headers, credentials support and max age.
+// Allowing all the origins together with the credentials is rejected as it would let any site send credentialed requests.
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new policy or error if something goes wrong
+func newCorsPolicy(configurationService configuration.ConfigurationContract) (*corsPolicy, error) {
+	allowedOrigins, err := configurationService.GetCorsAllowedOrigins()
+	if err != nil {
+		return nil, err
+	}
+
+	allowedHeaders, err := configurationService.GetCorsAllowedHeaders()
+	if err != nil {
+		return nil, err
+	}
+
+	exposedHeaders, err := configurationService.GetCorsExposedHeaders()
+	if err != nil {
+		return nil, err
+	}
+
+	allowCredentials, err := configurationService.GetCorsAllowCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	maxAge, err := configurationService.GetCorsMaxAge()
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &corsPolicy{
+		allowedOrigins:   map[string]struct{}{},
+		allowedHeaders:   strings.Join(allowedHeaders, ", "),
+		exposedHeaders:   strings.Join(exposedHeaders, ", "),
+		allowCredentials: allowCredentials,
+		maxAge:           strconv.Itoa(int(maxAge.Seconds())),
+	}
+
+	for _, origin := range allowedOrigins {
+		origin = strings.ToLower(origin)
+
+		if origin == "*" {
+			policy.allowAllOrigins = true
+		} else if index := strings.Index(origin, "*"); index >= 0 {
+			policy.originPatterns = append(policy.originPatterns, originPattern{prefix: origin[:index], suffix: origin[index+1:]})
+		} else {
+			policy.allowedOrigins[origin] = struct{}{}
+		}
+	}
+
+	if policy.allowAllOrigins && policy.allowCredentials {
+		return nil, commonErrors.NewUnknownError(
+			"CORS_ALLOWED_ORIGINS can not contain * when CORS_ALLOW_CREDENTIALS is true, list the allowed origins instead")
+	}
+
+	return policy, nil
+}
+
+// isOriginAllowed returns true if the origin is allowed to send cross origin requests
+func (policy *corsPolicy) isOriginAllowed(origin string) bool {
+	if policy.allowAllOrigins {
+		return true
+	}
+
+	origin = strings.ToLower(origin)
+	if _, ok := policy.allowedOrigins[origin]; ok {
+		return true
+	}
+
+	for _, pattern := range policy.originPatterns {
+		if len(origin) > len(pattern.prefix)+len(pattern.suffix) &&
+			strings.HasPrefix(origin, pattern.prefix) &&
+			strings.HasSuffix(origin, pattern.suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setAllowOriginHeaders sets the headers that allow the origin to read the response. The wildcard is returned when all
+// the origins are allowed, otherwise the origin is returned and the response varies by origin.
+// Returns false if the origin of the request is not allowed
+func (policy *corsPolicy) setAllowOriginHeaders(ctx *atreugo.RequestCtx) bool {
+	origin := string(ctx.Request.Header.Peek(fasthttp.HeaderOrigin))
+	if origin == "" || !policy.isOriginAllowed(origin) {
+		return false
+	}
+
+	if policy.allowAllOrigins {
+		ctx.Response.Header.Set(fasthttp.HeaderAccessControlAllowOrigin, "*")
+	} else {
+		ctx.Response.Header.Set(fasthttp.HeaderAccessControlAllowOrigin, origin)
+		ctx.Response.Header.Add(fasthttp.HeaderVary, fasthttp.HeaderOrigin)
+	}
+
+	if policy.allowCredentials {
+		ctx.Response.Header.Set(fasthttp.HeaderAccessControlAllowCredentials, "true")
+	}
+
+	return true
+}
+
+// corsMiddleware adds the CORS headers to the responses of the cross origin requests sent from the allowed origins
+func (service *transportService) corsMiddleware(ctx *atreugo.RequestCtx) error {
+	if service.corsPolicy.setAllowOriginHeaders(ctx) && service.corsPolicy.exposedHeaders != "" {
+		ctx.Response.Header.Set(fasthttp.HeaderAccessControlExposeHeaders, service.corsPolicy.exposedHeaders)
+	}
+
+	return ctx.Next()
+}
+
+// corsPreflightHandler answers the preflight requests the browsers send before the cross origin requests. The CORS
+// headers are left out if the origin is not allowed so the browser blocks the request.
+func (service *transportService) corsPreflightHandler(ctx *atreugo.RequestCtx) error {
+	ctx.Response.Header.Set("Allow", corsAllowedMethods)
+
+	if len(ctx.Request.Header.Peek(fasthttp.HeaderAccessControlRequestMethod)) > 0 && service.corsPolicy.setAllowOriginHeaders(ctx) {
+		ctx.Response.Header.Set(fasthttp.HeaderAccessControlAllowMethods, corsAllowedMethods)
+		ctx.Response.Header.Set(fasthttp.HeaderAccessControlAllowHeaders, service.corsPolicy.allowedHeaders)
+		ctx.Response.Header.Set(fasthttp.HeaderAccessControlMaxAge, service.corsPolicy.maxAge)
+	}
+
+	ctx.Response.SetStatusCode(http.StatusNoContent)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package https_test
+
+import (
+	"testing"
+	"time"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	mock_endpoint "github.com/decentralized-cloud/api-gateway/services/endpoint/mock"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	mock_health "github.com/decentralized-cloud/api-gateway/services/health/mock"
+	mock_identity "github.com/decentralized-cloud/api-gateway/services/identity/mock"
+	mock_persistedquery "github.com/decentralized-cloud/api-gateway/services/persistedquery/mock"
+	mock_ratelimit "github.com/decentralized-cloud/api-gateway/services/ratelimit/mock"
+	"github.com/decentralized-cloud/api-gateway/services/transport/https"
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/go-core/gokit/middleware"
+	"go.uber.org/zap"
+)
+
+type fakeMiddlewareProvider struct {
+	middleware.MiddlewareProviderContract
+}
+
+type fakeProjectClientService struct {
+	project.ProjectClientContract
+}
+
+type fakeEdgeClusterClientService struct {
+	edgecluster.EdgeClusterClientContract
+}
+
+func TestNewTransportService_CorsPolicy(t *testing.T) {
+	tests := []struct {
+		name             string
+		allowedOrigins   []string
+		allowCredentials bool
+		expectError      bool
+	}{
+		{name: "all origins without credentials", allowedOrigins: []string{"*"}, allowCredentials: false},
+		{name: "listed origins with credentials", allowedOrigins: []string{"https://app.example.com", "https://*.example.com"}, allowCredentials: true},
+		{name: "all origins with credentials", allowedOrigins: []string{"*"}, allowCredentials: true, expectError: true},
+		{name: "all origins among listed origins with credentials", allowedOrigins: []string{"https://app.example.com", "*"}, allowCredentials: true, expectError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+
+			configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+			configurationService.EXPECT().GetJwksURL().Return("https://identity.example.com/jwks", nil).AnyTimes()
+			configurationService.EXPECT().GetRequestTimeout().Return(30*time.Second, nil).AnyTimes()
+			configurationService.EXPECT().GetCorsAllowedOrigins().Return(test.allowedOrigins, nil).AnyTimes()
+			configurationService.EXPECT().GetCorsAllowedHeaders().Return([]string{"Content-Type", "Authorization"}, nil).AnyTimes()
+			configurationService.EXPECT().GetCorsExposedHeaders().Return([]string{}, nil).AnyTimes()
+			configurationService.EXPECT().GetCorsAllowCredentials().Return(test.allowCredentials, nil).AnyTimes()
+			configurationService.EXPECT().GetCorsMaxAge().Return(10*time.Minute, nil).AnyTimes()
+			configurationService.EXPECT().GetRateLimitTrustForwardedFor().Return(false, nil).AnyTimes()
+			configurationService.EXPECT().GetSubscriptionMaxPerConnection().Return(10, nil).AnyTimes()
+
+			_, err := https.NewTransportService(
+				zap.NewNop(),
+				configurationService,
+				mock_endpoint.NewMockEndpointCreatorContract(mockCtrl),
+				&fakeMiddlewareProvider{},
+				mock_identity.NewMockIdentityContract(mockCtrl),
+				&fakeProjectClientService{},
+				&fakeEdgeClusterClientService{},
+				mock_health.NewMockHealthCheckContract(mockCtrl),
+				mock_persistedquery.NewMockPersistedQueryContract(mockCtrl),
+				mock_ratelimit.NewMockRateLimitContract(mockCtrl))
+
+			if test.expectError && err == nil {
+				t.Fatal("expected the CORS policy to be rejected")
+			}
+
+			if !test.expectError && err != nil {
+				t.Fatalf("expected the CORS policy to be accepted, got %v", err)
+			}
+		})
+	}
+}
@@ -2,36 +2,168 @@
 package https
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"mime"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/decentralized-cloud/api-gateway/services/endpoint"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	"github.com/graph-gophers/graphql-go"
 )
 
-// decodeGraphQLRequest decodes GraphQL request message from GRPC object to business object
+const (
+	// contentTypeJSON is the content type of the GraphQL requests that send the query, the operation name and the variables in a JSON object
+	contentTypeJSON = "application/json"
+
+	// contentTypeGraphQL is the content type of the GraphQL requests that send the GraphQL document as the request body
+	contentTypeGraphQL = "application/graphql"
+)
+
+// requestError is returned when the HTTP request can not be decoded to a GraphQL request
+type requestError struct {
+	statusCode int
+	message    string
+}
+
+// Error returns the error message
+func (e *requestError) Error() string {
+	return e.message
+}
+
+// StatusCode returns the HTTP status code the error is reported with
+func (e *requestError) StatusCode() int {
+	return e.statusCode
+}
+
+// decodeGraphQLRequest decodes GraphQL request message from GRPC object to business object. The GET requests send the
+// request in the query string and only allow queries. The POST requests send either a JSON object, a JSON array of
+// batched requests or the GraphQL document using the application/graphql content type.
 // context: Mandatory The reference to the context
 // request: Mandatory. The reference to the GRPC request
 // Returns either the decoded request or error if something goes wrong
 func decodeGraphQLRequest(
 	ctx context.Context,
 	request *http.Request) (interface{}, error) {
-	var graphqlRequest endpoint.GraphQLRequest
+	if request.Method == http.MethodGet {
+		graphqlRequest, err := decodeGraphQLQueryString(request.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+
+		graphqlRequest.QueriesOnly = true
+
+		return graphqlRequest, nil
+	}
 
-	query, err := ioutil.ReadAll(request.Body)
+	mediaType := contentTypeJSON
+	if contentType := request.Header.Get("Content-Type"); contentType != "" {
+		var err error
+		if mediaType, _, err = mime.ParseMediaType(contentType); err != nil {
+			return nil, &requestError{statusCode: http.StatusBadRequest, message: fmt.Sprintf("Invalid Content-Type header: %v", err)}
+		}
+	}
+
+	body, err := ioutil.ReadAll(request.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := json.Unmarshal(query, &graphqlRequest); err != nil {
-		return nil, err
+	switch mediaType {
+	case contentTypeJSON:
+		if isJSONArray(body) {
+			return decodeGraphQLBatchRequest(body)
+		}
+
+		var graphqlRequest endpoint.GraphQLRequest
+		if err := json.Unmarshal(body, &graphqlRequest); err != nil {
+			return nil, &requestError{statusCode: http.StatusBadRequest, message: fmt.Sprintf("Invalid JSON request body: %v", err)}
+		}
+
+		return validateGraphQLRequest(&graphqlRequest)
+	case contentTypeGraphQL:
+		// The operation name and the variables can still be sent in the query string
+		graphqlRequest, err := decodeGraphQLQueryString(request.URL.Query(), string(body))
+		if err != nil {
+			return nil, err
+		}
+
+		return graphqlRequest, nil
+	default:
+		return nil, &requestError{
+			statusCode: http.StatusUnsupportedMediaType,
+			message:    fmt.Sprintf("Unsupported Content-Type %s, expected %s or %s", mediaType, contentTypeJSON, contentTypeGraphQL),
+		}
+	}
+}
+
+// decodeGraphQLBatchRequest decodes the JSON array of the GraphQL requests sent together in a batched request
+func decodeGraphQLBatchRequest(body []byte) (*endpoint.GraphQLBatchRequest, error) {
+	var graphqlRequests []*endpoint.GraphQLRequest
+	if err := json.Unmarshal(body, &graphqlRequests); err != nil {
+		return nil, &requestError{statusCode: http.StatusBadRequest, message: fmt.Sprintf("Invalid JSON request body: %v", err)}
+	}
+
+	if len(graphqlRequests) == 0 {
+		return nil, &requestError{statusCode: http.StatusBadRequest, message: "The batch must contain at least one request"}
+	}
+
+	for _, graphqlRequest := range graphqlRequests {
+		if graphqlRequest == nil {
+			return nil, &requestError{statusCode: http.StatusBadRequest, message: "The batch must not contain null requests"}
+		}
+
+		if _, err := validateGraphQLRequest(graphqlRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	return &endpoint.GraphQLBatchRequest{Requests: graphqlRequests}, nil
+}
+
+// decodeGraphQLQueryString decodes the GraphQL request sent in the query string. The variables and the extensions are
+// JSON encoded.
+func decodeGraphQLQueryString(values url.Values, query ...string) (*endpoint.GraphQLRequest, error) {
+	graphqlRequest := &endpoint.GraphQLRequest{
+		Query:         values.Get("query"),
+		OperationName: values.Get("operationName"),
+	}
+
+	if len(query) > 0 {
+		graphqlRequest.Query = query[0]
+	}
+
+	if variables := values.Get("variables"); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &graphqlRequest.Variables); err != nil {
+			return nil, &requestError{statusCode: http.StatusBadRequest, message: fmt.Sprintf("Invalid variables parameter: %v", err)}
+		}
+	}
+
+	if extensions := values.Get("extensions"); extensions != "" {
+		if err := json.Unmarshal([]byte(extensions), &graphqlRequest.Extensions); err != nil {
+			return nil, &requestError{statusCode: http.StatusBadRequest, message: fmt.Sprintf("Invalid extensions parameter: %v", err)}
+		}
+	}
+
+	return validateGraphQLRequest(graphqlRequest)
+}
+
+func validateGraphQLRequest(graphqlRequest *endpoint.GraphQLRequest) (*endpoint.GraphQLRequest, error) {
+	if strings.Trim(graphqlRequest.Query, " \t\r\n") == "" &&
+		(graphqlRequest.Extensions == nil || graphqlRequest.Extensions.PersistedQuery == nil) {
+		return nil, &requestError{statusCode: http.StatusBadRequest, message: "The query is required"}
 	}
 
-	return &graphqlRequest, nil
+	return graphqlRequest, nil
 }
 
-// encodeGraphQLResponse encodes GraphQL response from business object to GRPC object
+// encodeGraphQLResponse encodes GraphQL response from business object to GRPC object. Mutations and subscriptions
+// requested over HTTP GET are reported with the method not allowed status code.
 // context: Optional The reference to the context
 // request: Mandatory. The reference to the business response
 // Returns either the decoded response or error if something goes wrong
@@ -39,9 +171,65 @@ func encodeGraphQLResponse(
 	ctx context.Context,
 	writer http.ResponseWriter, response interface{}) error {
 	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
-	writer.Header().Set("Access-Control-Allow-Origin", "*")
-	writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-	writer.Header().Set("Access-Control-Allow-Headers", "Origin,DNT,User-Agent,X-Requested-With,If-Modified-Since,Cache-Control,Content-Type,Range,Authorization")
+
+	if graphqlResponse, ok := response.(*graphql.Response); ok && isOperationNotAllowed(graphqlResponse) {
+		writer.Header().Set("Allow", http.MethodPost)
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+	}
 
 	return json.NewEncoder(writer).Encode(response)
 }
+
+// encodeGraphQLError encodes the errors that carry a HTTP status code, such as the errors decoding the request, as a
+// GraphQL response along with the HTTP headers and the GraphQL error extensions the error carries. All other errors
+// are encoded by the default error encoder.
+// context: Optional The reference to the context
+// err: Mandatory. The error to encode
+// writer: Mandatory. The response writer
+func encodeGraphQLError(
+	ctx context.Context,
+	err error,
+	writer http.ResponseWriter) {
+	statusCoder, ok := err.(httpTransport.StatusCoder)
+	if !ok {
+		httpTransport.DefaultErrorEncoder(ctx, err, writer)
+
+		return
+	}
+
+	if headerer, ok := err.(httpTransport.Headerer); ok {
+		for name, values := range headerer.Headers() {
+			for _, value := range values {
+				writer.Header().Add(name, value)
+			}
+		}
+	}
+
+	graphqlError := map[string]interface{}{"message": err.Error()}
+	if extensionsError, ok := err.(interface{ Extensions() map[string]interface{} }); ok {
+		graphqlError["extensions"] = extensionsError.Extensions()
+	}
+
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.WriteHeader(statusCoder.StatusCode())
+
+	_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+		"errors": []map[string]interface{}{graphqlError},
+	})
+}
+
+func isJSONArray(body []byte) bool {
+	trimmedBody := bytes.TrimLeft(body, " \t\r\n")
+
+	return len(trimmedBody) > 0 && trimmedBody[0] == '['
+}
+
+func isOperationNotAllowed(response *graphql.Response) bool {
+	for _, queryError := range response.Errors {
+		if queryError.Extensions["code"] == endpoint.ErrorCodeOperationNotAllowed {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,14 @@
+package https
+
+import (
+	"github.com/decentralized-cloud/api-gateway/services/transport"
+	"github.com/valyala/fasthttp"
+)
+
+// GraphQLRequestHandler sets up the handlers of the transport service and returns the handler of the GraphQL requests
+func GraphQLRequestHandler(service transport.TransportContract) fasthttp.RequestHandler {
+	transportService := service.(*transportService)
+	transportService.setupHandlers()
+
+	return transportService.graphQLRequestHandler
+}
@@ -0,0 +1,194 @@
+// Package https implements functions to expose api-gateway service endpoint using HTTPS/GraphQL protocol.
+package https
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/endpoint"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/decentralized-cloud/api-gateway/services/ratelimit"
+	gokitEndpoint "github.com/go-kit/kit/endpoint"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"go.uber.org/zap"
+)
+
+// rateLimitError is returned when the caller exceeded the request budget of the operation type or the client IP
+// address exceeded its request budget
+type rateLimitError struct {
+	budget     string
+	retryAfter time.Duration
+}
+
+// Error returns the error message
+func (e *rateLimitError) Error() string {
+	return fmt.Sprintf("Too many %s requests, retry after %d seconds", e.budget, e.retryAfterSeconds())
+}
+
+// StatusCode returns the HTTP status code the error is reported with
+func (e *rateLimitError) StatusCode() int {
+	return http.StatusTooManyRequests
+}
+
+// Headers returns the HTTP headers the error is reported with
+func (e *rateLimitError) Headers() http.Header {
+	return http.Header{"Retry-After": []string{strconv.Itoa(e.retryAfterSeconds())}}
+}
+
+// Extensions returns the GraphQL error extensions the error is reported with
+func (e *rateLimitError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":       ratelimit.ErrorCodeRateLimited,
+		"retryAfter": e.retryAfterSeconds(),
+	}
+}
+
+func (e *rateLimitError) retryAfterSeconds() int {
+	return int(math.Max(1, math.Ceil(e.retryAfter.Seconds())))
+}
+
+// createAddressRateLimitMiddleware creates the middleware that rejects the requests sent from the client IP addresses
+// that exceeded their request budget. The middleware runs before the requests are authenticated, so the floods of
+// requests with missing or invalid access tokens are rejected before the tokens are verified.
+func (service *transportService) createAddressRateLimitMiddleware() gokitEndpoint.Middleware {
+	return func(next gokitEndpoint.Endpoint) gokitEndpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			address := service.clientAddress(ctx)
+
+			decision, err := service.rateLimitService.AllowAddress(ctx, address)
+			if err != nil {
+				// The requests are not rejected because the rate limit store is not available
+				service.logger.Warn("Failed to apply the IP address rate limit", zap.String("address", address), zap.Error(err))
+
+				return next(ctx, request)
+			}
+
+			if !decision.Allowed {
+				return nil, &rateLimitError{budget: "IP address", retryAfter: decision.RetryAfter}
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// createRateLimitMiddleware creates the middleware that rejects the GraphQL requests of the authenticated callers that
+// exceeded their request budget. The callers are identified by the subject of their access token. Every operation of
+// a batched request takes a token from the budget of its type.
+func (service *transportService) createRateLimitMiddleware() gokitEndpoint.Middleware {
+	return func(next gokitEndpoint.Endpoint) gokitEndpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			principal, ok := identity.FromContext(ctx)
+			if !ok {
+				return next(ctx, request)
+			}
+
+			key := "user:" + principal.Subject
+
+			for _, operationType := range []string{string(ast.Query), string(ast.Mutation), string(ast.Subscription)} {
+				count := service.countOperations(ctx, request, operationType)
+				if count == 0 {
+					continue
+				}
+
+				decision, err := service.rateLimitService.Allow(ctx, key, operationType, count)
+				if err != nil {
+					// The requests are not rejected because the rate limit store is not available
+					service.logger.Warn("Failed to apply the rate limit", zap.String("key", key), zap.Error(err))
+
+					continue
+				}
+
+				if !decision.Allowed {
+					return nil, &rateLimitError{budget: operationType, retryAfter: decision.RetryAfter}
+				}
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// countOperations returns the number of the operations of the given type in the GraphQL request
+func (service *transportService) countOperations(ctx context.Context, request interface{}, operationType string) int {
+	switch castedRequest := request.(type) {
+	case *endpoint.GraphQLRequest:
+		if service.operationType(ctx, castedRequest) == operationType {
+			return 1
+		}
+	case *endpoint.GraphQLBatchRequest:
+		count := 0
+
+		for _, graphqlRequest := range castedRequest.Requests {
+			if service.operationType(ctx, graphqlRequest) == operationType {
+				count++
+			}
+		}
+
+		return count
+	}
+
+	return 0
+}
+
+// operationType returns the type of the requested operation. The requests that can not be parsed are counted as
+// queries, they are rejected when they are executed.
+func (service *transportService) operationType(ctx context.Context, request *endpoint.GraphQLRequest) string {
+	query := request.Query
+	if query == "" && request.Extensions != nil && request.Extensions.PersistedQuery != nil {
+		resolvedQuery, queryErr := service.persistedQueryService.ResolveQuery(ctx, "", request.Extensions.PersistedQuery)
+		if queryErr != nil {
+			return string(ast.Query)
+		}
+
+		query = resolvedQuery
+	}
+
+	document, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return string(ast.Query)
+	}
+
+	operation := document.Operations.ForName(request.OperationName)
+	if operation == nil {
+		return string(ast.Query)
+	}
+
+	return string(operation.Operation)
+}
+
+// clientAddress returns the IP address of the client. The last address of the X-Forwarded-For header is used if the
+// header is trusted as it is the address the reverse proxy in front of the service received the request from, the
+// addresses before it are set by the client and can not be trusted.
+func (service *transportService) clientAddress(ctx context.Context) string {
+	forwardedFor, _ := ctx.Value(httpTransport.ContextKeyRequestXForwardedFor).(string)
+	remoteAddr, _ := ctx.Value(httpTransport.ContextKeyRequestRemoteAddr).(string)
+
+	return service.resolveClientAddress(forwardedFor, remoteAddr)
+}
+
+// resolveClientAddress returns the IP address of the client from the X-Forwarded-For header and the address of the
+// connection the request is received from
+func (service *transportService) resolveClientAddress(forwardedFor string, remoteAddr string) string {
+	if service.trustForwardedFor {
+		addresses := strings.Split(forwardedFor, ",")
+
+		if address := strings.Trim(addresses[len(addresses)-1], " "); address != "" {
+			return address
+		}
+	}
+
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+
+	return remoteAddr
+}
@@ -0,0 +1,201 @@
+package https_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	mock_configuration "github.com/decentralized-cloud/api-gateway/services/configuration/mock"
+	mock_endpoint "github.com/decentralized-cloud/api-gateway/services/endpoint/mock"
+	mock_health "github.com/decentralized-cloud/api-gateway/services/health/mock"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	mock_identity "github.com/decentralized-cloud/api-gateway/services/identity/mock"
+	mock_persistedquery "github.com/decentralized-cloud/api-gateway/services/persistedquery/mock"
+	"github.com/decentralized-cloud/api-gateway/services/ratelimit"
+	mock_ratelimit "github.com/decentralized-cloud/api-gateway/services/ratelimit/mock"
+	"github.com/decentralized-cloud/api-gateway/services/transport/https"
+	gokitEndpoint "github.com/go-kit/kit/endpoint"
+	"github.com/golang/mock/gomock"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jwt"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+)
+
+func (provider *fakeMiddlewareProvider) CreateLoggingMiddleware(endpointName string) gokitEndpoint.Middleware {
+	return func(next gokitEndpoint.Endpoint) gokitEndpoint.Endpoint {
+		return next
+	}
+}
+
+// newSignedToken starts the server that publishes the key set the returned bearer token is signed with
+func newSignedToken(t *testing.T, subject string) (jwksURL string, bearerToken string) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signingKey, err := jwk.New(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = signingKey.Set(jwk.KeyIDKey, "test-key")
+	_ = signingKey.Set(jwk.AlgorithmKey, jwa.RS256)
+
+	publicKey, err := jwk.PublicKeyOf(signingKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keySet := jwk.NewSet()
+	keySet.Add(publicKey)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_ = json.NewEncoder(writer).Encode(keySet)
+	}))
+	t.Cleanup(server.Close)
+
+	token := jwt.New()
+	_ = token.Set(jwt.SubjectKey, subject)
+
+	signedToken, err := jwt.Sign(token, jwa.RS256, signingKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return server.URL, "Bearer " + string(signedToken)
+}
+
+func newGraphQLRequestHandler(
+	t *testing.T,
+	mockCtrl *gomock.Controller,
+	jwksURL string,
+	rateLimitService ratelimit.RateLimitContract) fasthttp.RequestHandler {
+	configurationService := mock_configuration.NewMockConfigurationContract(mockCtrl)
+	configurationService.EXPECT().GetJwksURL().Return(jwksURL, nil).AnyTimes()
+	configurationService.EXPECT().GetRequestTimeout().Return(30*time.Second, nil).AnyTimes()
+	configurationService.EXPECT().GetCorsAllowedOrigins().Return([]string{"*"}, nil).AnyTimes()
+	configurationService.EXPECT().GetCorsAllowedHeaders().Return([]string{"Content-Type", "Authorization"}, nil).AnyTimes()
+	configurationService.EXPECT().GetCorsExposedHeaders().Return([]string{}, nil).AnyTimes()
+	configurationService.EXPECT().GetCorsAllowCredentials().Return(false, nil).AnyTimes()
+	configurationService.EXPECT().GetCorsMaxAge().Return(10*time.Minute, nil).AnyTimes()
+	configurationService.EXPECT().GetRateLimitTrustForwardedFor().Return(false, nil).AnyTimes()
+	configurationService.EXPECT().GetSubscriptionMaxPerConnection().Return(10, nil).AnyTimes()
+
+	endpointCreatorService := mock_endpoint.NewMockEndpointCreatorContract(mockCtrl)
+	endpointCreatorService.EXPECT().GraphQLEndpoint().Return(func(ctx context.Context, request interface{}) (interface{}, error) {
+		t.Error("expected the rate limited request not to be executed")
+
+		return nil, nil
+	})
+	endpointCreatorService.EXPECT().GraphQLSubscriptionEndpoint().Return(nil)
+
+	identityService := mock_identity.NewMockIdentityContract(mockCtrl)
+	identityService.EXPECT().NewPrincipal(gomock.Any()).Return(&identity.Principal{Subject: "user-1"}, nil).AnyTimes()
+
+	transportService, err := https.NewTransportService(
+		zap.NewNop(),
+		configurationService,
+		endpointCreatorService,
+		&fakeMiddlewareProvider{},
+		identityService,
+		&fakeProjectClientService{},
+		&fakeEdgeClusterClientService{},
+		mock_health.NewMockHealthCheckContract(mockCtrl),
+		mock_persistedquery.NewMockPersistedQueryContract(mockCtrl),
+		rateLimitService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return https.GraphQLRequestHandler(transportService)
+}
+
+func serveGraphQLRequest(handler fasthttp.RequestHandler, authorization string, query string) *fasthttp.Response {
+	var request fasthttp.Request
+	request.Header.SetMethod(fasthttp.MethodPost)
+	request.Header.SetContentType("application/json")
+	request.Header.Set(fasthttp.HeaderAuthorization, authorization)
+	request.SetRequestURI("/graphql")
+	body, _ := json.Marshal(map[string]string{"query": query})
+	request.SetBody(body)
+
+	var ctx fasthttp.RequestCtx
+	ctx.Init(&request, &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 40000}, nil)
+	handler(&ctx)
+
+	response := &fasthttp.Response{}
+	ctx.Response.CopyTo(response)
+
+	return response
+}
+
+func assertRateLimited(t *testing.T, response *fasthttp.Response, expectedRetryAfter int) {
+	if response.StatusCode() != fasthttp.StatusTooManyRequests {
+		t.Fatalf("expected status code %d, got %d", fasthttp.StatusTooManyRequests, response.StatusCode())
+	}
+
+	if retryAfter := string(response.Header.Peek("Retry-After")); retryAfter != strconv.Itoa(expectedRetryAfter) {
+		t.Fatalf("expected the Retry-After header %d, got %q", expectedRetryAfter, retryAfter)
+	}
+
+	var body struct {
+		Errors []struct {
+			Message    string                 `json:"message"`
+			Extensions map[string]interface{} `json:"extensions"`
+		} `json:"errors"`
+	}
+
+	if err := json.Unmarshal(response.Body(), &body); err != nil {
+		t.Fatalf("expected a GraphQL error response, got %s", response.Body())
+	}
+
+	if len(body.Errors) != 1 {
+		t.Fatalf("expected one error, got %s", response.Body())
+	}
+
+	if code := body.Errors[0].Extensions["code"]; code != ratelimit.ErrorCodeRateLimited {
+		t.Fatalf("expected the %s error code, got %v", ratelimit.ErrorCodeRateLimited, code)
+	}
+
+	if retryAfter := body.Errors[0].Extensions["retryAfter"]; retryAfter != float64(expectedRetryAfter) {
+		t.Fatalf("expected the retryAfter extension %d, got %v", expectedRetryAfter, retryAfter)
+	}
+}
+
+func TestRateLimitMiddleware_RejectsTheRequestsOfTheAddressesOverBudget(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	rateLimitService := mock_ratelimit.NewMockRateLimitContract(mockCtrl)
+	rateLimitService.EXPECT().AllowAddress(gomock.Any(), "10.0.0.1").Return(ratelimit.Decision{RetryAfter: 1500 * time.Millisecond}, nil)
+
+	// The request is rejected before its access token is verified
+	handler := newGraphQLRequestHandler(t, mockCtrl, "http://127.0.0.1:0/jwks", rateLimitService)
+	response := serveGraphQLRequest(handler, "Bearer invalid", "{ user { id } }")
+
+	assertRateLimited(t, response, 2)
+}
+
+func TestRateLimitMiddleware_RejectsTheOperationsOfTheCallersOverBudget(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	jwksURL, bearerToken := newSignedToken(t, "user-1")
+
+	rateLimitService := mock_ratelimit.NewMockRateLimitContract(mockCtrl)
+	rateLimitService.EXPECT().AllowAddress(gomock.Any(), "10.0.0.1").Return(ratelimit.Decision{Allowed: true}, nil)
+	rateLimitService.EXPECT().Allow(gomock.Any(), "user:user-1", "mutation", 1).Return(ratelimit.Decision{RetryAfter: 300 * time.Millisecond}, nil)
+
+	handler := newGraphQLRequestHandler(t, mockCtrl, jwksURL, rateLimitService)
+	response := serveGraphQLRequest(handler, bearerToken, "mutation { deleteProject(input: {projectID: \"p-1\"}) { deletedProjectID } }")
+
+	// The retry after is rounded up to whole seconds
+	assertRateLimited(t, response, 1)
+}
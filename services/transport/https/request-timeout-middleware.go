@@ -0,0 +1,26 @@
+// Package https implements functions to expose api-gateway service endpoint using HTTPS/GraphQL protocol.
+package https
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+)
+
+// createRequestTimeoutMiddleware creates the middleware that sets the deadline of the GraphQL request. The deadline is
+// passed down to all the backend gRPC calls made while resolving the request so a hung backend service can not stall
+// the request forever.
+func (service *transportService) createRequestTimeoutMiddleware() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			if service.requestTimeout <= 0 {
+				return next(ctx, request)
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, service.requestTimeout)
+			defer cancel()
+
+			return next(ctx, request)
+		}
+	}
+}
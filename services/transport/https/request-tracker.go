@@ -0,0 +1,104 @@
+// Package https implements functions to expose api-gateway service endpoint using HTTPS/GraphQL protocol.
+package https
+
+import (
+	"context"
+	"sync"
+)
+
+// requestTracker keeps track of the in-flight requests so the service can wait for them to finish before shutting down
+type requestTracker struct {
+	lock     sync.Mutex
+	inFlight int
+	draining bool
+	drained  chan struct{}
+	stopping chan struct{}
+}
+
+func newRequestTracker() *requestTracker {
+	return &requestTracker{
+		drained:  make(chan struct{}),
+		stopping: make(chan struct{}),
+	}
+}
+
+// begin registers a new in-flight request
+// Returns true if the service is draining, otherwise returns false
+func (tracker *requestTracker) begin() bool {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	tracker.inFlight++
+
+	return tracker.draining
+}
+
+// end marks an in-flight request as finished
+func (tracker *requestTracker) end() {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	tracker.inFlight--
+	if tracker.draining && tracker.inFlight == 0 {
+		tracker.closeDrained()
+	}
+}
+
+// isDraining returns true if the service started draining, otherwise returns false
+func (tracker *requestTracker) isDraining() bool {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	return tracker.draining
+}
+
+// startDraining marks the service as draining
+func (tracker *requestTracker) startDraining() {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	if tracker.draining {
+		return
+	}
+
+	tracker.draining = true
+	close(tracker.stopping)
+
+	if tracker.inFlight == 0 {
+		tracker.closeDrained()
+	}
+}
+
+// drainingStarted returns the channel that is closed when the service starts draining, so long-lived
+// requests such as subscriptions can finish early
+func (tracker *requestTracker) drainingStarted() <-chan struct{} {
+	return tracker.stopping
+}
+
+// wait blocks until all in-flight requests finish or the context is done
+// ctx: Mandatory. Reference to the context that carries the drain deadline
+// Returns error if the context is done before all in-flight requests finish
+func (tracker *requestTracker) wait(ctx context.Context) error {
+	select {
+	case <-tracker.drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// inFlightCount returns the number of in-flight requests
+func (tracker *requestTracker) inFlightCount() int {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	return tracker.inFlight
+}
+
+func (tracker *requestTracker) closeDrained() {
+	select {
+	case <-tracker.drained:
+	default:
+		close(tracker.drained)
+	}
+}
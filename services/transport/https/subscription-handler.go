@@ -0,0 +1,410 @@
+// Package https implements functions to expose api-gateway service endpoint using HTTPS/GraphQL protocol.
+package https
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/api-gateway/services/endpoint"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/fasthttp/websocket"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/savsgio/atreugo/v11"
+	"github.com/valyala/fasthttp"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.uber.org/zap"
+)
+
+// The graphql-transport-ws protocol as defined by https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
+const (
+	graphQLTransportWSProtocol = "graphql-transport-ws"
+
+	messageTypeConnectionInit = "connection_init"
+	messageTypeConnectionAck  = "connection_ack"
+	messageTypePing           = "ping"
+	messageTypePong           = "pong"
+	messageTypeSubscribe      = "subscribe"
+	messageTypeNext           = "next"
+	messageTypeError          = "error"
+	messageTypeComplete       = "complete"
+
+	closeCodeInvalidMessage           = 4400
+	closeCodeUnauthorized             = 4401
+	closeCodeForbidden                = 4403
+	closeCodeSubprotocolNotAcceptable = 4406
+	closeCodeConnectionInitTimeout    = 4408
+	closeCodeSubscriberAlreadyExists  = 4409
+	closeCodeTooManyInitRequests      = 4429
+
+	errorCodeTooManySubscriptions = "TOO_MANY_SUBSCRIPTIONS"
+
+	connectionInitTimeout = 3 * time.Second
+	writeTimeout          = 10 * time.Second
+)
+
+type subscriptionMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// tooManySubscriptionsError is returned when the connection already runs the maximum number of subscriptions
+type tooManySubscriptionsError struct {
+	maxSubscriptions int
+}
+
+// Error returns the error message
+func (e *tooManySubscriptionsError) Error() string {
+	return fmt.Sprintf("Too many subscriptions, at most %d subscriptions can run on a connection", e.maxSubscriptions)
+}
+
+// Extensions returns the GraphQL error extensions the error is reported with
+func (e *tooManySubscriptionsError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":             errorCodeTooManySubscriptions,
+		"maxSubscriptions": e.maxSubscriptions,
+	}
+}
+
+type subscriptionConnection struct {
+	service              *transportService
+	connection           *websocket.Conn
+	upgradeAuthorization string
+	writeLock            sync.Mutex
+	lock                 sync.Mutex
+	initReceived         bool
+	authenticatedCtx     context.Context
+	subscriptions        map[string]context.CancelFunc
+}
+
+func (service *transportService) subscriptionHandler(ctx *atreugo.RequestCtx) error {
+	address := service.resolveClientAddress(string(ctx.Request.Header.Peek(fasthttp.HeaderXForwardedFor)), ctx.RemoteAddr().String())
+
+	decision, err := service.rateLimitService.AllowAddress(ctx, address)
+	if err != nil {
+		// The connections are not rejected because the rate limit store is not available
+		service.logger.Warn("Failed to apply the IP address rate limit", zap.String("address", address), zap.Error(err))
+	} else if !decision.Allowed {
+		return rejectUpgrade(ctx, &rateLimitError{budget: "IP address", retryAfter: decision.RetryAfter})
+	}
+
+	upgradeAuthorization := string(ctx.Request.Header.Peek(fasthttp.HeaderAuthorization))
+	upgrader := websocket.FastHTTPUpgrader{
+		Subprotocols: []string{graphQLTransportWSProtocol},
+		// The connection is authenticated using the bearer token rather than cookies, so accepting
+		// cross origin connections does not expose the caller to cross site request forgery
+		CheckOrigin: func(*fasthttp.RequestCtx) bool { return true },
+	}
+
+	return upgrader.Upgrade(ctx.RequestCtx, func(connection *websocket.Conn) {
+		service.requestTracker.begin()
+		defer service.requestTracker.end()
+
+		subscriptionConnection := &subscriptionConnection{
+			service:              service,
+			connection:           connection,
+			upgradeAuthorization: upgradeAuthorization,
+			subscriptions:        map[string]context.CancelFunc{},
+		}
+
+		subscriptionConnection.serve()
+	})
+}
+
+// rejectUpgrade rejects the WebSocket upgrade request the same way the rate limited GraphQL requests are rejected
+func rejectUpgrade(ctx *atreugo.RequestCtx, err *rateLimitError) error {
+	for name, values := range err.Headers() {
+		for _, value := range values {
+			ctx.Response.Header.Add(name, value)
+		}
+	}
+
+	return ctx.JSONResponse(
+		map[string]interface{}{
+			"errors": []map[string]interface{}{{"message": err.Error(), "extensions": err.Extensions()}},
+		},
+		err.StatusCode())
+}
+
+func (c *subscriptionConnection) serve() {
+	defer func() {
+		_ = c.connection.Close()
+	}()
+
+	if c.connection.Subprotocol() != graphQLTransportWSProtocol {
+		c.close(closeCodeSubprotocolNotAcceptable, "Subprotocol not acceptable")
+
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	initTimer := time.AfterFunc(connectionInitTimeout, func() {
+		if !c.isAcknowledged() {
+			c.close(closeCodeConnectionInitTimeout, "Connection initialisation timeout")
+		}
+	})
+	defer initTimer.Stop()
+
+	go func() {
+		select {
+		case <-c.service.requestTracker.drainingStarted():
+			c.close(websocket.CloseGoingAway, "Server is shutting down")
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		_, content, err := c.connection.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var message subscriptionMessage
+		if err := json.Unmarshal(content, &message); err != nil {
+			c.close(closeCodeInvalidMessage, "Invalid message received")
+
+			return
+		}
+
+		if !c.handleMessage(ctx, message) {
+			return
+		}
+	}
+}
+
+func (c *subscriptionConnection) handleMessage(ctx context.Context, message subscriptionMessage) bool {
+	switch message.Type {
+	case messageTypeConnectionInit:
+		return c.handleConnectionInit(ctx, message)
+
+	case messageTypePing:
+		return c.write(subscriptionMessage{Type: messageTypePong})
+
+	case messageTypePong:
+		return true
+
+	case messageTypeSubscribe:
+		return c.handleSubscribe(message)
+
+	case messageTypeComplete:
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if cancel, ok := c.subscriptions[message.ID]; ok {
+			cancel()
+			delete(c.subscriptions, message.ID)
+		}
+
+		return true
+
+	default:
+		c.close(closeCodeInvalidMessage, "Invalid message received")
+
+		return false
+	}
+}
+
+func (c *subscriptionConnection) handleConnectionInit(ctx context.Context, message subscriptionMessage) bool {
+	c.lock.Lock()
+	initReceived := c.initReceived
+	c.initReceived = true
+	c.lock.Unlock()
+
+	if initReceived {
+		c.close(closeCodeTooManyInitRequests, "Too many initialisation requests")
+
+		return false
+	}
+
+	authorization := c.upgradeAuthorization
+
+	var payload map[string]interface{}
+	if len(message.Payload) > 0 {
+		if err := json.Unmarshal(message.Payload, &payload); err != nil {
+			c.close(closeCodeInvalidMessage, "Invalid message received")
+
+			return false
+		}
+	}
+
+	for _, key := range []string{fasthttp.HeaderAuthorization, "authorization"} {
+		if value, ok := payload[key].(string); ok && value != "" {
+			authorization = value
+
+			break
+		}
+	}
+
+	authenticatedCtx, err := c.service.authenticate(ctx, authorization)
+	if err != nil {
+		c.close(closeCodeForbidden, "Forbidden")
+
+		return false
+	}
+
+	c.lock.Lock()
+	c.authenticatedCtx = authenticatedCtx
+	c.lock.Unlock()
+
+	return c.write(subscriptionMessage{Type: messageTypeConnectionAck})
+}
+
+func (c *subscriptionConnection) handleSubscribe(message subscriptionMessage) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.authenticatedCtx == nil {
+		c.close(closeCodeUnauthorized, "Unauthorized")
+
+		return false
+	}
+
+	var request endpoint.GraphQLRequest
+	if message.ID == "" || json.Unmarshal(message.Payload, &request) != nil {
+		c.close(closeCodeInvalidMessage, "Invalid message received")
+
+		return false
+	}
+
+	if _, ok := c.subscriptions[message.ID]; ok {
+		c.close(closeCodeSubscriberAlreadyExists, fmt.Sprintf("Subscriber for %s already exists", message.ID))
+
+		return false
+	}
+
+	// The subscriptions that are not started are rejected with an error message, the connection is kept open
+	if len(c.subscriptions) >= c.service.maxSubscriptions {
+		return c.writeError(message.ID, &tooManySubscriptionsError{maxSubscriptions: c.service.maxSubscriptions})
+	}
+
+	if err := c.allowSubscription(); err != nil {
+		return c.writeError(message.ID, err)
+	}
+
+	subscriptionCtx, cancel := context.WithCancel(c.authenticatedCtx)
+	c.subscriptions[message.ID] = cancel
+
+	go c.runSubscription(subscriptionCtx, message.ID, &request)
+
+	return true
+}
+
+// allowSubscription takes a token for the subscription from the subscription budget of the authenticated caller
+func (c *subscriptionConnection) allowSubscription() error {
+	principal, ok := identity.FromContext(c.authenticatedCtx)
+	if !ok {
+		return nil
+	}
+
+	key := "user:" + principal.Subject
+	operationType := string(ast.Subscription)
+
+	decision, err := c.service.rateLimitService.Allow(c.authenticatedCtx, key, operationType, 1)
+	if err != nil {
+		// The subscriptions are not rejected because the rate limit store is not available
+		c.service.logger.Warn("Failed to apply the rate limit", zap.String("key", key), zap.Error(err))
+
+		return nil
+	}
+
+	if !decision.Allowed {
+		return &rateLimitError{budget: operationType, retryAfter: decision.RetryAfter}
+	}
+
+	return nil
+}
+
+func (c *subscriptionConnection) runSubscription(ctx context.Context, id string, request *endpoint.GraphQLRequest) {
+	defer func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if cancel, ok := c.subscriptions[id]; ok {
+			cancel()
+			delete(c.subscriptions, id)
+		}
+	}()
+
+	response, err := c.service.graphQLSubscriptionEndpoint(ctx, request)
+	if err != nil {
+		c.writePayload(id, messageTypeError, []map[string]string{{"message": err.Error()}})
+
+		return
+	}
+
+	isFirstResponse := true
+
+	for result := range response.(<-chan interface{}) {
+		graphQLResponse, ok := result.(*graphql.Response)
+		if !ok {
+			continue
+		}
+
+		// Errors reported before any data is produced, such as validation errors, terminate the operation
+		if isFirstResponse && len(graphQLResponse.Data) == 0 && len(graphQLResponse.Errors) > 0 {
+			c.writePayload(id, messageTypeError, graphQLResponse.Errors)
+
+			return
+		}
+
+		isFirstResponse = false
+
+		if !c.writePayload(id, messageTypeNext, graphQLResponse) {
+			return
+		}
+	}
+
+	// The client does not expect the complete message if it completed the subscription itself
+	if ctx.Err() == nil {
+		c.write(subscriptionMessage{ID: id, Type: messageTypeComplete})
+	}
+}
+
+func (c *subscriptionConnection) isAcknowledged() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.authenticatedCtx != nil
+}
+
+func (c *subscriptionConnection) writeError(id string, err error) bool {
+	graphqlError := map[string]interface{}{"message": err.Error()}
+	if extensionsError, ok := err.(interface{ Extensions() map[string]interface{} }); ok {
+		graphqlError["extensions"] = extensionsError.Extensions()
+	}
+
+	return c.writePayload(id, messageTypeError, []map[string]interface{}{graphqlError})
+}
+
+func (c *subscriptionConnection) writePayload(id string, messageType string, payload interface{}) bool {
+	content, err := json.Marshal(payload)
+	if err != nil {
+		c.service.logger.Error("Failed to marshal the subscription payload", zap.Error(err))
+
+		return false
+	}
+
+	return c.write(subscriptionMessage{ID: id, Type: messageType, Payload: content})
+}
+
+func (c *subscriptionConnection) write(message subscriptionMessage) bool {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	_ = c.connection.SetWriteDeadline(time.Now().Add(writeTimeout))
+
+	return c.connection.WriteJSON(message) == nil
+}
+
+func (c *subscriptionConnection) close(code int, reason string) {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	_ = c.connection.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(writeTimeout))
+	_ = c.connection.Close()
+}
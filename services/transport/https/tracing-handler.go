@@ -0,0 +1,50 @@
+// Package https implements functions to expose api-gateway service endpoint using HTTPS/GraphQL protocol.
+package https
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/decentralized-cloud/api-gateway/services/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// statusRecorder records the status code written by the wrapped handler
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+// WriteHeader records the status code and writes it to the wrapped response writer
+func (recorder *statusRecorder) WriteHeader(statusCode int) {
+	recorder.statusCode = statusCode
+	recorder.ResponseWriter.WriteHeader(statusCode)
+}
+
+// traceRequests starts the server span of the incoming request. The W3C trace context sent by the caller is extracted
+// from the request headers so the span joins the caller trace. The span is named after the route.
+func (service *transportService) traceRequests(handler http.Handler, route string) http.Handler {
+	tracer := otel.Tracer(tracing.InstrumentationName)
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(request.Context(), propagation.HeaderCarrier(request.Header))
+		ctx, span := tracer.Start(
+			ctx,
+			route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest("api-gateway", route, request)...))
+		defer span.End()
+
+		recorder := &statusRecorder{ResponseWriter: writer, statusCode: http.StatusOK}
+		handler.ServeHTTP(recorder, request.WithContext(ctx))
+
+		span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(recorder.statusCode)...)
+		if recorder.statusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, fmt.Sprintf("HTTP status code %d", recorder.statusCode))
+		}
+	})
+}
@@ -2,40 +2,86 @@
 package https
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/decentralized-cloud/api-gateway/services/certificate"
 	"github.com/decentralized-cloud/api-gateway/services/configuration"
 	"github.com/decentralized-cloud/api-gateway/services/endpoint"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/edgecluster"
+	"github.com/decentralized-cloud/api-gateway/services/graphql/types/project"
+	"github.com/decentralized-cloud/api-gateway/services/health"
+	"github.com/decentralized-cloud/api-gateway/services/identity"
+	"github.com/decentralized-cloud/api-gateway/services/persistedquery"
+	"github.com/decentralized-cloud/api-gateway/services/ratelimit"
 	"github.com/decentralized-cloud/api-gateway/services/transport"
+	"github.com/fasthttp/websocket"
 	"github.com/friendsofgo/graphiql"
+	gokitEndpoint "github.com/go-kit/kit/endpoint"
 	httpTransport "github.com/go-kit/kit/transport/http"
 	"github.com/micro-business/go-core/gokit/middleware"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/savsgio/atreugo/v11"
+	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
 	"go.uber.org/zap"
 )
 
+const listenerNetwork = "tcp4"
+
 type transportService struct {
-	logger                    *zap.Logger
-	configurationService      configuration.ConfigurationContract
-	endpointCreatorService    endpoint.EndpointCreatorContract
-	middlewareProviderService middleware.MiddlewareProviderContract
-	jwksURL                   string
-	graphQLHandler            *httpTransport.Server
+	logger                      *zap.Logger
+	configurationService        configuration.ConfigurationContract
+	endpointCreatorService      endpoint.EndpointCreatorContract
+	middlewareProviderService   middleware.MiddlewareProviderContract
+	identityService             identity.IdentityContract
+	projectClientService        project.ProjectClientContract
+	edgeClusterClientService    edgecluster.EdgeClusterClientContract
+	healthCheckService          health.HealthCheckContract
+	persistedQueryService       persistedquery.PersistedQueryContract
+	rateLimitService            ratelimit.RateLimitContract
+	trustForwardedFor           bool
+	maxSubscriptions            int
+	jwksURL                     string
+	requestTimeout              time.Duration
+	corsPolicy                  *corsPolicy
+	graphQLHandler              *httpTransport.Server
+	graphQLRequestHandler       fasthttp.RequestHandler
+	graphQLSubscriptionEndpoint gokitEndpoint.Endpoint
+	requestTracker              *requestTracker
+	listenersLock               sync.Mutex
+	listeners                   []net.Listener
 }
 
 // NewTransportService creates new instance of the transportService, setting up all dependencies and returns the instance
 // logger: Mandatory. Reference to the logger service
 // configurationService: Mandatory. Reference to the service that provides required configurations
 // middlewareProviderService: Mandatory. Reference to the service that provides different go-kit middlewares
+// identityService: Mandatory. Reference to the service that extracts the principal from the verified access token
+// projectClientService: Mandatory. Reference to the project client service that owns the shared project gRPC connection
+// edgeClusterClientService: Mandatory. Reference to the edge cluster client service that owns the shared edge cluster gRPC connection
+// healthCheckService: Mandatory. Reference to the service that checks the health of the backend services
+// persistedQueryService: Mandatory. Reference to the service that resolves the query text of the persisted queries
+// rateLimitService: Mandatory. Reference to the service that limits the rate of the requests of each caller
 // Returns the new service or error if something goes wrong
 func NewTransportService(
 	logger *zap.Logger,
 	configurationService configuration.ConfigurationContract,
 	endpointCreatorService endpoint.EndpointCreatorContract,
-	middlewareProviderService middleware.MiddlewareProviderContract) (transport.TransportContract, error) {
+	middlewareProviderService middleware.MiddlewareProviderContract,
+	identityService identity.IdentityContract,
+	projectClientService project.ProjectClientContract,
+	edgeClusterClientService edgecluster.EdgeClusterClientContract,
+	healthCheckService health.HealthCheckContract,
+	persistedQueryService persistedquery.PersistedQueryContract,
+	rateLimitService ratelimit.RateLimitContract) (transport.TransportContract, error) {
 	if logger == nil {
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
@@ -52,17 +98,76 @@ func NewTransportService(
 		return nil, commonErrors.NewArgumentNilError("middlewareProviderService", "middlewareProviderService is required")
 	}
 
+	if identityService == nil {
+		return nil, commonErrors.NewArgumentNilError("identityService", "identityService is required")
+	}
+
+	if projectClientService == nil {
+		return nil, commonErrors.NewArgumentNilError("projectClientService", "projectClientService is required")
+	}
+
+	if edgeClusterClientService == nil {
+		return nil, commonErrors.NewArgumentNilError("edgeClusterClientService", "edgeClusterClientService is required")
+	}
+
+	if healthCheckService == nil {
+		return nil, commonErrors.NewArgumentNilError("healthCheckService", "healthCheckService is required")
+	}
+
+	if persistedQueryService == nil {
+		return nil, commonErrors.NewArgumentNilError("persistedQueryService", "persistedQueryService is required")
+	}
+
+	if rateLimitService == nil {
+		return nil, commonErrors.NewArgumentNilError("rateLimitService", "rateLimitService is required")
+	}
+
 	jwksURL, err := configurationService.GetJwksURL()
 	if err != nil {
 		return nil, err
 	}
 
+	requestTimeout, err := configurationService.GetRequestTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	corsPolicy, err := newCorsPolicy(configurationService)
+	if err != nil {
+		return nil, err
+	}
+
+	trustForwardedFor, err := configurationService.GetRateLimitTrustForwardedFor()
+	if err != nil {
+		return nil, err
+	}
+
+	maxSubscriptions, err := configurationService.GetSubscriptionMaxPerConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	if maxSubscriptions < 1 {
+		return nil, commonErrors.NewArgumentError("maxSubscriptions", "maximum number of subscriptions per connection must be at least 1")
+	}
+
 	return &transportService{
 		logger:                    logger,
 		configurationService:      configurationService,
 		endpointCreatorService:    endpointCreatorService,
 		middlewareProviderService: middlewareProviderService,
+		identityService:           identityService,
+		projectClientService:      projectClientService,
+		edgeClusterClientService:  edgeClusterClientService,
+		healthCheckService:        healthCheckService,
+		persistedQueryService:     persistedQueryService,
+		rateLimitService:          rateLimitService,
+		trustForwardedFor:         trustForwardedFor,
+		maxSubscriptions:          maxSubscriptions,
 		jwksURL:                   jwksURL,
+		requestTimeout:            requestTimeout,
+		corsPolicy:                corsPolicy,
+		requestTracker:            newRequestTracker(),
 	}, nil
 }
 
@@ -71,66 +176,277 @@ func NewTransportService(
 func (service *transportService) Start() error {
 	service.setupHandlers()
 
-	config := atreugo.Config{GracefulShutdown: true}
-	var err error
-
 	host, err := service.configurationService.GetHttpHost()
 	if err != nil {
 		return err
 	}
 
-	port, err := service.configurationService.GetHttpPort()
+	httpPort, err := service.configurationService.GetHttpPort()
 	if err != nil {
 		return err
 	}
 
-	config.Addr = fmt.Sprintf("%s:%d", host, port)
-	server := atreugo.New(config)
+	certFile, err := service.configurationService.GetHttpsCertFile()
+	if err != nil {
+		return err
+	}
 
-	graphiqlHandler, err := graphiql.NewGraphiqlHandler("/graphql")
+	httpAddress := fmt.Sprintf("%s:%d", host, httpPort)
+
+	if strings.Trim(certFile, " ") == "" {
+		server := service.newServer(httpAddress)
+		service.registerOperationalRoutes(server)
+
+		if err = service.registerGraphQLRoutes(server); err != nil {
+			return err
+		}
+
+		listener, err := service.listen(httpAddress, nil)
+		if err != nil {
+			return err
+		}
+
+		service.logger.Info("HTTP service started", zap.String("address", httpAddress))
+
+		return server.Serve(listener)
+	}
+
+	httpsPort, err := service.configurationService.GetHttpsPort()
 	if err != nil {
 		return err
 	}
 
-	server.UseBefore()
+	tlsConfig, err := service.createServerTLSConfig(certFile)
+	if err != nil {
+		return err
+	}
 
-	server.NetHTTPPath("POST", "/graphql", service.graphQLHandler)
-	server.NetHTTPPath("GET", "/graphiql", graphiqlHandler)
+	httpsAddress := fmt.Sprintf("%s:%d", host, httpsPort)
+	httpsServer := service.newServer(httpsAddress)
 
-	server.Path("GET", "/live", service.livenessCheckHandler)
-	server.Path("GET", "/ready", service.readinessCheckHandler)
+	if err = service.registerGraphQLRoutes(httpsServer); err != nil {
+		return err
+	}
 
-	server.NetHTTPPath("GET", "/metrics", promhttp.Handler())
+	httpServer := service.newServer(httpAddress)
+	service.registerOperationalRoutes(httpServer)
 
-	service.logger.Info("HTTPS service started", zap.String("address", config.Addr))
+	httpsListener, err := service.listen(httpsAddress, tlsConfig)
+	if err != nil {
+		return err
+	}
 
-	return server.ListenAndServe()
+	httpListener, err := service.listen(httpAddress, nil)
+	if err != nil {
+		return err
+	}
+
+	service.logger.Info("HTTPS service started", zap.String("address", httpsAddress))
+	service.logger.Info("HTTP service started", zap.String("address", httpAddress))
+
+	serveErr := make(chan error, 2)
+
+	go func() {
+		serveErr <- httpsServer.Serve(httpsListener)
+	}()
+
+	go func() {
+		serveErr <- httpServer.Serve(httpListener)
+	}()
+
+	return <-serveErr
 }
 
-// Stop stops the GraphQL transport service
+// Stop drains and stops the GraphQL transport service. The readiness check starts failing first, then the
+// service stops accepting new connections and waits for the in-flight requests to finish before closing
+// the connections to the backend services.
 // Returns error if something goes wrong
 func (service *transportService) Stop() error {
-	return nil
+	service.requestTracker.startDraining()
+	service.logger.Info("Draining HTTPS transport service", zap.Int("inFlightRequests", service.requestTracker.inFlightCount()))
+
+	readinessDelay, err := service.configurationService.GetShutdownReadinessDelay()
+	if err != nil {
+		return err
+	}
+
+	shutdownTimeout, err := service.configurationService.GetShutdownTimeout()
+	if err != nil {
+		return err
+	}
+
+	time.Sleep(readinessDelay)
+
+	service.closeListeners()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := service.requestTracker.wait(ctx); err != nil {
+		service.logger.Warn(
+			"Shutdown timeout reached before all in-flight requests finished",
+			zap.Int("inFlightRequests", service.requestTracker.inFlightCount()))
+	}
+
+	projectErr := service.projectClientService.Close()
+	if projectErr != nil {
+		service.logger.Error("Failed to close the project service connection", zap.Error(projectErr))
+	}
+
+	edgeClusterErr := service.edgeClusterClientService.Close()
+	if edgeClusterErr != nil {
+		service.logger.Error("Failed to close the edge cluster service connection", zap.Error(edgeClusterErr))
+	}
+
+	if projectErr != nil {
+		return projectErr
+	}
+
+	return edgeClusterErr
+}
+
+func (service *transportService) newServer(address string) *atreugo.Atreugo {
+	server := atreugo.New(atreugo.Config{GracefulShutdown: true, Addr: address})
+	server.UseBefore(func(ctx *atreugo.RequestCtx) error {
+		if service.requestTracker.isDraining() {
+			ctx.SetConnectionClose()
+		}
+
+		return ctx.Next()
+	})
+
+	return server
+}
+
+func (service *transportService) listen(address string, tlsConfig *tls.Config) (net.Listener, error) {
+	listener, err := net.Listen(listenerNetwork, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	service.listenersLock.Lock()
+	defer service.listenersLock.Unlock()
+
+	service.listeners = append(service.listeners, listener)
+
+	return listener, nil
+}
+
+func (service *transportService) closeListeners() {
+	service.listenersLock.Lock()
+	defer service.listenersLock.Unlock()
+
+	for _, listener := range service.listeners {
+		if err := listener.Close(); err != nil {
+			service.logger.Error("Failed to close listener", zap.String("address", listener.Addr().String()), zap.Error(err))
+		}
+	}
+
+	service.listeners = nil
+}
+
+func (service *transportService) trackRequests(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		service.requestTracker.begin()
+		defer service.requestTracker.end()
+
+		handler.ServeHTTP(writer, request)
+	})
 }
 
 func (service *transportService) setupHandlers() {
 	endpoint := service.endpointCreatorService.GraphQLEndpoint()
 	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("GraphQL")(endpoint)
+	endpoint = service.createRequestTimeoutMiddleware()(endpoint)
+	endpoint = service.createRateLimitMiddleware()(endpoint)
 	endpoint = service.createAuthMiddleware("GraphQL")(endpoint)
+	endpoint = service.createAddressRateLimitMiddleware()(endpoint)
 	service.graphQLHandler = httpTransport.NewServer(
 		endpoint,
 		decodeGraphQLRequest,
 		encodeGraphQLResponse,
+		httpTransport.ServerBefore(httpTransport.PopulateRequestContext),
+		httpTransport.ServerErrorEncoder(encodeGraphQLError),
 	)
+	service.graphQLRequestHandler = fasthttpadaptor.NewFastHTTPHandler(
+		service.trackRequests(service.traceRequests(service.graphQLHandler, "/graphql")))
 
+	subscriptionEndpoint := service.endpointCreatorService.GraphQLSubscriptionEndpoint()
+	subscriptionEndpoint = service.middlewareProviderService.CreateLoggingMiddleware("GraphQLSubscription")(subscriptionEndpoint)
+	service.graphQLSubscriptionEndpoint = subscriptionEndpoint
 }
 
-func (service *transportService) readinessCheckHandler(ctx *atreugo.RequestCtx) error {
-	ctx.Response.SetStatusCode(http.StatusOK)
+func (service *transportService) registerGraphQLRoutes(server *atreugo.Atreugo) error {
+	graphiqlHandler, err := graphiql.NewGraphiqlHandler("/graphql")
+	if err != nil {
+		return err
+	}
+
+	server.RequestHandlerPath("POST", "/graphql", service.graphQLRequestHandler).UseBefore(service.corsMiddleware)
+	server.Path("GET", "/graphql", service.graphQLGetHandler).UseBefore(service.corsMiddleware)
+	server.Path("OPTIONS", "/graphql", service.corsPreflightHandler)
+	server.NetHTTPPath("GET", "/graphiql", graphiqlHandler)
+
+	return nil
+}
+
+// graphQLGetHandler upgrades the WebSocket upgrade requests to GraphQL subscription connections and executes the
+// queries sent in the query string of all other requests
+func (service *transportService) graphQLGetHandler(ctx *atreugo.RequestCtx) error {
+	if websocket.FastHTTPIsWebSocketUpgrade(ctx.RequestCtx) {
+		return service.subscriptionHandler(ctx)
+	}
+
+	service.graphQLRequestHandler(ctx.RequestCtx)
 
 	return nil
 }
 
+func (service *transportService) registerOperationalRoutes(server *atreugo.Atreugo) {
+	server.Path("GET", "/live", service.livenessCheckHandler)
+	server.Path("GET", "/ready", service.readinessCheckHandler)
+
+	server.NetHTTPPath("GET", "/metrics", promhttp.Handler())
+}
+
+func (service *transportService) createServerTLSConfig(certFile string) (*tls.Config, error) {
+	keyFile, err := service.configurationService.GetHttpsKeyFile()
+	if err != nil {
+		return nil, err
+	}
+
+	clientCAFile, err := service.configurationService.GetHttpsClientCAFile()
+	if err != nil {
+		return nil, err
+	}
+
+	reloader, err := certificate.NewFileCertificateReloader(certFile, keyFile, clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return certificate.NewServerTLSConfig(reloader)
+}
+
+func (service *transportService) readinessCheckHandler(ctx *atreugo.RequestCtx) error {
+	if service.requestTracker.isDraining() {
+		return ctx.JSONResponse(
+			health.HealthReport{Status: health.StatusDown, Dependencies: map[string]health.DependencyStatus{}},
+			http.StatusServiceUnavailable)
+	}
+
+	report := service.healthCheckService.Check(ctx)
+	if report.Status != health.StatusUp {
+		return ctx.JSONResponse(report, http.StatusServiceUnavailable)
+	}
+
+	return ctx.JSONResponse(report, http.StatusOK)
+}
+
 func (service *transportService) livenessCheckHandler(ctx *atreugo.RequestCtx) error {
 	ctx.Response.SetStatusCode(http.StatusOK)
 
@@ -0,0 +1,33 @@
+// Package warning collects the warnings reported while a GraphQL request is resolved, the warnings are returned to the client in the response extensions
+package warning
+
+import "context"
+
+type warningCollectorContextKey struct{}
+
+// NewContext returns a copy of the parent context that carries the given warning collector
+// ctx: Mandatory. Reference to the parent context
+// collector: Mandatory. The warning collector created for the current request
+// Returns the new context
+func NewContext(ctx context.Context, collector WarningCollectorContract) context.Context {
+	return context.WithValue(ctx, warningCollectorContextKey{}, collector)
+}
+
+// FromContext retrieves the warning collector stored in the context
+// ctx: Mandatory. Reference to the context
+// Returns the warning collector and true if the warning collector exists in the context, otherwise returns nil and false
+func FromContext(ctx context.Context) (WarningCollectorContract, bool) {
+	collector, ok := ctx.Value(warningCollectorContextKey{}).(WarningCollectorContract)
+
+	return collector, ok && collector != nil
+}
+
+// Report adds the warning to the warnings of the request the context belongs to. The warning is dropped if the
+// request does not collect the warnings, such as the subscriptions.
+// ctx: Mandatory. Reference to the context
+// warning: Mandatory. The reported warning
+func Report(ctx context.Context, warning Warning) {
+	if collector, ok := FromContext(ctx); ok {
+		collector.Report(warning)
+	}
+}
@@ -0,0 +1,30 @@
+// Package warning collects the warnings reported while a GraphQL request is resolved, the warnings are returned to the client in the response extensions
+package warning
+
+const (
+	// ExtensionKey is the key of the response extension the warnings are reported in
+	ExtensionKey = "warnings"
+
+	// CodeListTruncated is reported when a list was truncated to the configured maximum number of the listed objects
+	CodeListTruncated = "LIST_TRUNCATED"
+)
+
+// Warning describes the problem that affects the response without failing the request
+type Warning struct {
+	// Message is the human readable description of the warning
+	Message string `json:"message"`
+
+	// Extensions contains the code of the warning and the details the client can act on
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// WarningCollectorContract declares the collector of the warnings reported while a GraphQL request is resolved
+type WarningCollectorContract interface {
+	// Report adds the warning to the warnings of the request
+	// warning: Mandatory. The reported warning
+	Report(warning Warning)
+
+	// Warnings returns the reported warnings in the order they were reported
+	// Returns the reported warnings
+	Warnings() []Warning
+}
@@ -0,0 +1 @@
+package warning_test
@@ -0,0 +1,61 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/warning/contract.go
+
+// Package mock_warning is a generated GoMock package.
+package mock_warning
+
+import (
+	reflect "reflect"
+
+	warning "github.com/decentralized-cloud/api-gateway/services/warning"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWarningCollectorContract is a mock of WarningCollectorContract interface.
+type MockWarningCollectorContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockWarningCollectorContractMockRecorder
+}
+
+// MockWarningCollectorContractMockRecorder is the mock recorder for MockWarningCollectorContract.
+type MockWarningCollectorContractMockRecorder struct {
+	mock *MockWarningCollectorContract
+}
+
+// NewMockWarningCollectorContract creates a new mock instance.
+func NewMockWarningCollectorContract(ctrl *gomock.Controller) *MockWarningCollectorContract {
+	mock := &MockWarningCollectorContract{ctrl: ctrl}
+	mock.recorder = &MockWarningCollectorContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWarningCollectorContract) EXPECT() *MockWarningCollectorContractMockRecorder {
+	return m.recorder
+}
+
+// Report mocks base method.
+func (m *MockWarningCollectorContract) Report(warning warning.Warning) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Report", warning)
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockWarningCollectorContractMockRecorder) Report(warning interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockWarningCollectorContract)(nil).Report), warning)
+}
+
+// Warnings mocks base method.
+func (m *MockWarningCollectorContract) Warnings() []warning.Warning {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Warnings")
+	ret0, _ := ret[0].([]warning.Warning)
+	return ret0
+}
+
+// Warnings indicates an expected call of Warnings.
+func (mr *MockWarningCollectorContractMockRecorder) Warnings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warnings", reflect.TypeOf((*MockWarningCollectorContract)(nil).Warnings))
+}
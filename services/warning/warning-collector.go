@@ -0,0 +1,34 @@
+// Package warning collects the warnings reported while a GraphQL request is resolved, the warnings are returned to the client in the response extensions
+package warning
+
+import "sync"
+
+type warningCollector struct {
+	lock     sync.Mutex
+	warnings []Warning
+}
+
+// NewWarningCollector creates new instance of the warningCollector and returns the instance. The fields are resolved
+// concurrently, so the collector can be used by many resolvers at once.
+// Returns the new collector
+func NewWarningCollector() WarningCollectorContract {
+	return &warningCollector{}
+}
+
+// Report adds the warning to the warnings of the request
+// warning: Mandatory. The reported warning
+func (collector *warningCollector) Report(warning Warning) {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+
+	collector.warnings = append(collector.warnings, warning)
+}
+
+// Warnings returns the reported warnings in the order they were reported
+// Returns the reported warnings
+func (collector *warningCollector) Warnings() []Warning {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+
+	return append([]Warning{}, collector.warnings...)
+}